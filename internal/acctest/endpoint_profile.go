@@ -0,0 +1,177 @@
+package acctest
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"sync"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/envvar"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// Support for running acceptance tests against a local AWS stand-in (emulator) rather than AWS.
+
+const (
+	// Static credentials used with a local AWS stand-in if none are configured.
+	endpointProfileAccessKey = "mock_access_key"
+	endpointProfileSecretKey = "mock_secret_key"
+)
+
+// EndpointProfile configures acceptance testing against a local AWS stand-in.
+// The profile is read from the JSON file named by the TF_ACC_ENDPOINT_PROFILE environment variable.
+type EndpointProfile struct {
+	// Endpoint is the URL used for all services.
+	Endpoint string `json:"endpoint"`
+
+	// Endpoints overrides Endpoint for individual services, keyed by provider `endpoints` argument name.
+	Endpoints map[string]string `json:"endpoints,omitempty"`
+
+	// Services are the AWS SDK endpoint IDs, as passed to ErrorCheck, of the services supported.
+	// Tests using any other service are skipped.
+	Services []string `json:"services"`
+}
+
+var (
+	endpointProfile     *EndpointProfile
+	endpointProfileErr  error
+	endpointProfileOnce sync.Once
+)
+
+// activeEndpointProfile returns the endpoint profile configured by the TF_ACC_ENDPOINT_PROFILE environment variable.
+// A nil profile is returned if the environment variable isn't set.
+func activeEndpointProfile() (*EndpointProfile, error) {
+	endpointProfileOnce.Do(func() {
+		if path := os.Getenv(envvar.EndpointProfile); path != "" {
+			endpointProfile, endpointProfileErr = ReadEndpointProfile(path)
+		}
+	})
+
+	return endpointProfile, endpointProfileErr
+}
+
+// ReadEndpointProfile reads an endpoint profile from the named JSON file.
+func ReadEndpointProfile(path string) (*EndpointProfile, error) {
+	b, err := os.ReadFile(path)
+
+	if err != nil {
+		return nil, fmt.Errorf("reading endpoint profile (%s): %w", path, err)
+	}
+
+	profile := &EndpointProfile{}
+
+	if err := json.Unmarshal(b, profile); err != nil {
+		return nil, fmt.Errorf("parsing endpoint profile (%s): %w", path, err)
+	}
+
+	if profile.Endpoint == "" && len(profile.Endpoints) == 0 {
+		return nil, fmt.Errorf("endpoint profile (%s): no endpoints configured", path)
+	}
+
+	for alias := range profile.Endpoints {
+		if _, err := names.ProviderPackageForAlias(alias); err != nil {
+			return nil, fmt.Errorf("endpoint profile (%s): %w", path, err)
+		}
+	}
+
+	return profile, nil
+}
+
+// Supports returns whether all the services, identified by AWS SDK endpoint ID, are supported.
+func (p *EndpointProfile) Supports(endpointIDs ...string) bool {
+	for _, endpointID := range endpointIDs {
+		supported := false
+
+		for _, v := range p.Services {
+			if v == endpointID {
+				supported = true
+				break
+			}
+		}
+
+		if !supported {
+			return false
+		}
+	}
+
+	return true
+}
+
+// endpoints returns the provider `endpoints` argument values for the profile.
+func (p *EndpointProfile) endpoints() map[string]interface{} {
+	tfMap := make(map[string]interface{})
+
+	for _, alias := range names.Aliases() {
+		if v, ok := p.Endpoints[alias]; ok {
+			tfMap[alias] = v
+		} else {
+			tfMap[alias] = p.Endpoint
+		}
+	}
+
+	return tfMap
+}
+
+// configureEndpointProfile wraps the provider's configuration so that, when an endpoint profile is active,
+// every service uses the profile's endpoints and no requests are made to validate credentials or determine the account ID.
+func configureEndpointProfile(p *schema.Provider) {
+	configureFunc := p.ConfigureContextFunc
+
+	p.ConfigureContextFunc = func(ctx context.Context, d *schema.ResourceData) (interface{}, diag.Diagnostics) {
+		profile, err := activeEndpointProfile()
+
+		if err != nil {
+			return nil, diag.FromErr(err)
+		}
+
+		if profile != nil {
+			if err := applyEndpointProfile(d, profile); err != nil {
+				return nil, diag.FromErr(err)
+			}
+		}
+
+		return configureFunc(ctx, d)
+	}
+}
+
+func applyEndpointProfile(d *schema.ResourceData, profile *EndpointProfile) error {
+	values := map[string]interface{}{
+		"endpoints":                   []interface{}{profile.endpoints()},
+		"s3_use_path_style":           true,
+		"skip_credentials_validation": true,
+		"skip_metadata_api_check":     "true",
+		"skip_region_validation":      true,
+		"skip_requesting_account_id":  true,
+	}
+
+	// A local AWS stand-in accepts any credentials.
+	if d.Get("access_key").(string) == "" && d.Get("profile").(string) == "" && os.Getenv(envvar.AccessKeyId) == "" && os.Getenv(envvar.Profile) == "" && os.Getenv(envvar.ContainerCredentialsFullURI) == "" {
+		values["access_key"] = endpointProfileAccessKey
+		values["secret_key"] = endpointProfileSecretKey
+	}
+
+	for k, v := range values {
+		if err := d.Set(k, v); err != nil {
+			return fmt.Errorf("applying endpoint profile: setting %s: %w", k, err)
+		}
+	}
+
+	return nil
+}
+
+// preCheckEndpointProfile skips the test if an endpoint profile is active and any of the services is unsupported.
+func preCheckEndpointProfile(t *testing.T, endpointIDs ...string) {
+	profile, err := activeEndpointProfile()
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if profile != nil && !profile.Supports(endpointIDs...) {
+		t.Skipf("skipping test: services %v not all supported by endpoint profile (%s)", endpointIDs, os.Getenv(envvar.EndpointProfile))
+	}
+}
@@ -0,0 +1,125 @@
+package acctest
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/envvar"
+	"github.com/hashicorp/terraform-provider-aws/internal/provider"
+)
+
+func TestReadEndpointProfile(t *testing.T) {
+	testCases := []struct {
+		Name          string
+		Contents      string
+		ExpectedError bool
+	}{
+		{
+			Name:     "valid",
+			Contents: `{"endpoint": "http://localhost:4566", "endpoints": {"s3": "http://localhost:4572"}, "services": ["s3", "sqs"]}`,
+		},
+		{
+			Name:          "invalid JSON",
+			Contents:      `{"endpoint": `,
+			ExpectedError: true,
+		},
+		{
+			Name:          "no endpoints",
+			Contents:      `{"services": ["s3"]}`,
+			ExpectedError: true,
+		},
+		{
+			Name:          "unknown service",
+			Contents:      `{"endpoints": {"notaservice": "http://localhost:4566"}}`,
+			ExpectedError: true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			path := filepath.Join(t.TempDir(), "profile.json")
+
+			if err := os.WriteFile(path, []byte(testCase.Contents), 0600); err != nil {
+				t.Fatal(err)
+			}
+
+			_, err := ReadEndpointProfile(path)
+
+			if err == nil && testCase.ExpectedError {
+				t.Fatalf("expected error")
+			}
+
+			if err != nil && !testCase.ExpectedError {
+				t.Fatalf("unexpected error: %s", err)
+			}
+		})
+	}
+}
+
+func TestEndpointProfileSupports(t *testing.T) {
+	profile := &EndpointProfile{
+		Endpoint: "http://localhost:4566",
+		Services: []string{"s3", "sqs"},
+	}
+
+	if !profile.Supports() {
+		t.Errorf("expected no services to be supported")
+	}
+
+	if !profile.Supports("s3", "sqs") {
+		t.Errorf("expected s3 and sqs to be supported")
+	}
+
+	if profile.Supports("s3", "ec2") {
+		t.Errorf("expected ec2 not to be supported")
+	}
+}
+
+func TestApplyEndpointProfile(t *testing.T) {
+	for _, k := range []string{envvar.AccessKeyId, envvar.ContainerCredentialsFullURI, envvar.Profile} {
+		t.Setenv(k, "")
+	}
+
+	p, err := provider.New(context.Background())
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	d := schema.TestResourceDataRaw(t, p.Schema, map[string]interface{}{})
+	profile := &EndpointProfile{
+		Endpoint:  "http://localhost:4566",
+		Endpoints: map[string]string{"sqs": "http://localhost:4576"},
+	}
+
+	if err := applyEndpointProfile(d, profile); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	for _, k := range []string{"skip_credentials_validation", "skip_requesting_account_id", "s3_use_path_style"} {
+		if !d.Get(k).(bool) {
+			t.Errorf("expected %s to be set", k)
+		}
+	}
+
+	if got, expected := d.Get("access_key").(string), endpointProfileAccessKey; got != expected {
+		t.Errorf("got access_key %q, expected %q", got, expected)
+	}
+
+	endpoints := d.Get("endpoints").(*schema.Set).List()
+
+	if len(endpoints) != 1 {
+		t.Fatalf("got %d endpoints blocks, expected 1", len(endpoints))
+	}
+
+	tfMap := endpoints[0].(map[string]interface{})
+
+	for k, expected := range map[string]string{"s3": "http://localhost:4566", "sqs": "http://localhost:4576"} {
+		if got := tfMap[k].(string); got != expected {
+			t.Errorf("got %s endpoint %q, expected %q", k, got, expected)
+		}
+	}
+}
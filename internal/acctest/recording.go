@@ -0,0 +1,261 @@
+package acctest
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io/fs"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/envvar"
+	"github.com/hashicorp/terraform-provider-aws/internal/recorder"
+)
+
+// Support for recording the AWS API requests of acceptance tests and replaying them without access to AWS.
+
+const (
+	defaultCassetteDir = "testdata/cassettes"
+
+	// Static credentials used when replaying recorded requests.
+	replayAccessKey = "mock_access_key"
+	replaySecretKey = "mock_secret_key"
+)
+
+var (
+	recordMode     recorder.Mode
+	recordModeErr  error
+	recordModeOnce sync.Once
+
+	// recordingMu serializes recorded tests once they start running, after any call to t.Parallel.
+	// The requests of every provider instance, including the shared Provider used by test check functions,
+	// are routed to the recorder of the single running recorded test.
+	recordingMu sync.Mutex
+
+	activeRecorder   *recorder.Recorder
+	activeRecorderMu sync.RWMutex
+
+	// pendingRecordings are the recordings of tests that have called Record but not yet PreCheck.
+	pendingRecordings   = make(map[*testing.T]*Recording)
+	pendingRecordingsMu sync.Mutex
+)
+
+// activeRecordMode returns the recorder mode configured by the TF_ACC_RECORD_MODE environment variable.
+// The empty mode is returned if recording is disabled.
+func activeRecordMode() (recorder.Mode, error) {
+	recordModeOnce.Do(func() {
+		recordMode, recordModeErr = recorder.ParseMode(os.Getenv(envvar.RecordMode))
+	})
+
+	return recordMode, recordModeErr
+}
+
+func getActiveRecorder() *recorder.Recorder {
+	activeRecorderMu.RLock()
+	defer activeRecorderMu.RUnlock()
+
+	return activeRecorder
+}
+
+func setActiveRecorder(r *recorder.Recorder) {
+	activeRecorderMu.Lock()
+	defer activeRecorderMu.Unlock()
+
+	activeRecorder = r
+}
+
+// Recording is the recording of an acceptance test's AWS API requests.
+// A nil *Recording is valid and is used when recording is disabled.
+type Recording struct {
+	recorder *recorder.Recorder
+	t        *testing.T
+	started  bool
+}
+
+// Record records the test's AWS API requests, or replays them, as configured by the TF_ACC_RECORD_MODE environment variable.
+// Requests are recorded in the cassette file named for the test in the directory named by TF_ACC_CASSETTE_DIR.
+// Record must be called at the start of the test, before any random names are generated.
+// Recording starts when the test calls PreCheck, which resource.Test and resource.ParallelTest do after any call to t.Parallel,
+// and recorded tests then run one at a time.
+// A nil Recording is returned if recording is disabled. In replay mode, tests without a cassette are skipped.
+func Record(t *testing.T) *Recording {
+	t.Helper()
+
+	mode, err := activeRecordMode()
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if mode == "" {
+		return nil
+	}
+
+	path := filepath.Join(envvar.GetWithDefault(envvar.CassetteDir, defaultCassetteDir), cassetteName(t.Name()))
+
+	if mode == recorder.ModeReplay {
+		if _, err := os.Stat(path); errors.Is(err, fs.ErrNotExist) {
+			t.Skipf("skipping test: no cassette (%s) recorded", path)
+		}
+	}
+
+	r, err := recorder.New(path, mode)
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	rec := &Recording{
+		recorder: r,
+		t:        t,
+	}
+
+	pendingRecordingsMu.Lock()
+	pendingRecordings[t] = rec
+	pendingRecordingsMu.Unlock()
+
+	t.Cleanup(func() {
+		pendingRecordingsMu.Lock()
+		delete(pendingRecordings, t)
+		pendingRecordingsMu.Unlock()
+
+		if !rec.started && !t.Skipped() {
+			t.Error("recorded test didn't call acctest.PreCheck")
+		}
+	})
+
+	return rec
+}
+
+// startRecording starts the recording of the test, if any, waiting for any other running recorded test to finish.
+func startRecording(t *testing.T) {
+	pendingRecordingsMu.Lock()
+	rec, ok := pendingRecordings[t]
+	delete(pendingRecordings, t)
+	pendingRecordingsMu.Unlock()
+
+	if !ok {
+		return
+	}
+
+	recordingMu.Lock()
+
+	rec.started = true
+	setActiveRecorder(rec.recorder)
+
+	t.Cleanup(func() {
+		setActiveRecorder(nil)
+
+		// Don't overwrite a cassette with the recording of a failed test.
+		if !t.Failed() && !t.Skipped() {
+			if err := rec.recorder.Save(); err != nil {
+				t.Error(err)
+			}
+		}
+
+		recordingMu.Unlock()
+	})
+
+	// (Re)configure the shared Provider used by test check functions so that its configuration requests are in this test's cassette.
+	if diags := Provider.Configure(context.Background(), terraform.NewResourceConfigRaw(map[string]interface{}{"region": Region()})); diags.HasError() {
+		t.Fatalf("configuring provider: %v", diags)
+	}
+}
+
+// RandomWithPrefix returns a random name with the specified prefix.
+// The name is recorded so that it's the same when requests are replayed.
+func (r *Recording) RandomWithPrefix(prefix string) string {
+	if r == nil {
+		return sdkacctest.RandomWithPrefix(prefix)
+	}
+
+	v, err := r.recorder.Value(func() string {
+		return sdkacctest.RandomWithPrefix(prefix)
+	})
+
+	if err != nil {
+		r.t.Fatal(err)
+	}
+
+	return v
+}
+
+// cassetteName returns the cassette file name for the named test.
+func cassetteName(testName string) string {
+	return strings.ReplaceAll(testName, "/", "_") + ".json"
+}
+
+// configureRecording wraps the provider's configuration so that, when recording is enabled,
+// the provider's AWS API requests are recorded or replayed by the running recorded test.
+func configureRecording(p *schema.Provider) {
+	configureFunc := p.ConfigureContextFunc
+
+	p.ConfigureContextFunc = func(ctx context.Context, d *schema.ResourceData) (interface{}, diag.Diagnostics) {
+		mode, err := activeRecordMode()
+
+		if err != nil {
+			return nil, diag.FromErr(err)
+		}
+
+		if mode == recorder.ModeReplay {
+			if err := applyReplay(d); err != nil {
+				return nil, diag.FromErr(err)
+			}
+		}
+
+		if mode != "" {
+			ctx = conns.NewHTTPTransportContext(ctx, recordingTransport(mode))
+		}
+
+		return configureFunc(ctx, d)
+	}
+}
+
+// applyReplay configures the provider so that no requests are made, other than those replayed, to obtain or validate credentials.
+func applyReplay(d *schema.ResourceData) error {
+	values := map[string]interface{}{
+		"access_key":                  replayAccessKey,
+		"profile":                     "",
+		"secret_key":                  replaySecretKey,
+		"skip_credentials_validation": true,
+		"skip_metadata_api_check":     "true",
+		"token":                       "",
+	}
+
+	for k, v := range values {
+		if err := d.Set(k, v); err != nil {
+			return fmt.Errorf("configuring replay: setting %s: %w", k, err)
+		}
+	}
+
+	return nil
+}
+
+// recordingTransport returns an HTTP transport wrapper that routes requests to the running recorded test's recorder.
+// When no recorded test is running, requests are sent unrecorded in record mode and fail in replay mode.
+func recordingTransport(mode recorder.Mode) conns.HTTPTransportFunc {
+	return func(next http.RoundTripper) http.RoundTripper {
+		return recorder.RoundTripperFunc(func(req *http.Request) (*http.Response, error) {
+			r := getActiveRecorder()
+
+			if r == nil {
+				if mode == recorder.ModeReplay {
+					return nil, fmt.Errorf("%s %s: no recorded test running: %w", req.Method, req.URL, recorder.ErrNoInteraction)
+				}
+
+				return next.RoundTrip(req)
+			}
+
+			return r.RoundTrip(req, next)
+		})
+	}
+}
@@ -0,0 +1,77 @@
+package acctest
+
+import (
+	"errors"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-aws/internal/recorder"
+)
+
+func TestCassetteName(t *testing.T) {
+	if got, expected := cassetteName("TestAccSQSQueue_basic/subtest"), "TestAccSQSQueue_basic_subtest.json"; got != expected {
+		t.Errorf("got %s, expected %s", got, expected)
+	}
+}
+
+func TestRecordingTransport(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "test.json")
+	contents := `{"interactions": [{"request": {"method": "POST", "url": "https://sqs.us-west-2.amazonaws.com/", "operation": "GetQueueUrl"}, "response": {"status_code": 200, "body": "recorded"}}]}`
+
+	if err := os.WriteFile(path, []byte(contents), 0600); err != nil {
+		t.Fatal(err)
+	}
+
+	r, err := recorder.New(path, recorder.ModeReplay)
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	transport := recordingTransport(recorder.ModeReplay)(recorder.RoundTripperFunc(func(req *http.Request) (*http.Response, error) {
+		t.Fatalf("request sent in replay mode")
+
+		return nil, nil
+	}))
+
+	newRequest := func() *http.Request {
+		req, err := http.NewRequest(http.MethodPost, "https://sqs.us-west-2.amazonaws.com/", strings.NewReader("Action=GetQueueUrl&QueueName=test"))
+
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		req.Header.Set("Content-Type", "application/x-www-form-urlencoded; charset=utf-8")
+
+		return req
+	}
+
+	if _, err := transport.RoundTrip(newRequest()); !errors.Is(err, recorder.ErrNoInteraction) {
+		t.Errorf("no recorded test running: got error %v, expected %s", err, recorder.ErrNoInteraction)
+	}
+
+	setActiveRecorder(r)
+	defer setActiveRecorder(nil)
+
+	resp, err := transport.RoundTrip(newRequest())
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if got, expected := string(body), "recorded"; got != expected {
+		t.Errorf("got response %s, expected %s", got, expected)
+	}
+}
@@ -2,6 +2,14 @@ package conns
 
 import (
 	"fmt"
+	"log"
+	"sync"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/endpoints"
+	"github.com/aws/aws-sdk-go/service/s3"
+	awsbase "github.com/hashicorp/aws-sdk-go-base/v2"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
 )
 
 // PartitionHostname returns a hostname with the provider domain suffix for the partition
@@ -17,3 +25,236 @@ func (client *AWSClient) PartitionHostname(prefix string) string {
 func (client *AWSClient) RegionalHostname(prefix string) string {
 	return fmt.Sprintf("%s.%s.%s", prefix, client.Region, client.DNSSuffix)
 }
+
+// S3ConnURICleaningDisabled returns an S3 client with REST protocol URI cleaning disabled.
+func (client *AWSClient) S3ConnURICleaningDisabled() *s3.S3 {
+	return conn[*s3.S3](client, s3URICleaningDisabled)
+}
+
+// LintIAMPolicies returns whether IAM policy documents are to be checked offline during plan.
+func (client *AWSClient) LintIAMPolicies() bool {
+	return client.providerConfig != nil && client.providerConfig.LintIAMPolicies
+}
+
+// ValidateWithAccessAnalyzer returns the Access Analyzer policy validation finding types that fail the plan of IAM resources with policy documents.
+func (client *AWSClient) ValidateWithAccessAnalyzer() []string {
+	if client.providerConfig == nil {
+		return nil
+	}
+
+	return client.providerConfig.ValidateWithAccessAnalyzer
+}
+
+// RegionalClient returns an AWSClient whose service clients operate in the specified Region.
+// An empty Region or the client's own Region returns the client itself.
+// Clients for other Regions share the provider's credentials and configuration,
+// are created on first use and are cached for the lifetime of the provider.
+func (client *AWSClient) RegionalClient(region string) (*AWSClient, error) {
+	if region == "" || region == client.Region {
+		return client, nil
+	}
+
+	if client.regionalClients == nil || client.providerConfig == nil {
+		return nil, fmt.Errorf("creating AWS client for Region (%s): provider not configured", region)
+	}
+
+	return client.regionalClients.get(region, func() (*AWSClient, error) {
+		return client.newRegionalClient(region)
+	})
+}
+
+func (client *AWSClient) newRegionalClient(region string) (*AWSClient, error) {
+	c := client.providerConfig
+
+	if !c.SkipRegionValidation {
+		if err := awsbase.ValidateRegion(region); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := c.verifyRegion(region); err != nil {
+		return nil, err
+	}
+
+	if p, ok := endpoints.PartitionForRegion(endpoints.DefaultPartitions(), region); ok && p.ID() != client.Partition {
+		return nil, fmt.Errorf("partition (%s) of Region (%s) does not match the provider's partition (%s)", p.ID(), region, client.Partition)
+	}
+
+	log.Printf("[DEBUG] Creating AWS client for Region (%s)", region)
+
+	cfg := client.Config.Copy()
+	cfg.Region = region
+	sess := client.Session.Copy(&aws.Config{Region: aws.String(region)})
+
+	regionalClient := &AWSClient{
+		AccountID:         client.AccountID,
+		DefaultTagsConfig: client.DefaultTagsConfig,
+		IgnoreTagsConfig:  client.IgnoreTagsConfig,
+		Partition:         client.Partition,
+		ServicePackages:   client.ServicePackages,
+		TerraformVersion:  client.TerraformVersion,
+		providerConfig:    c,
+		regionalClients:   client.regionalClients,
+	}
+
+	c.configureServiceClients(regionalClient, cfg, sess)
+
+	if !c.SkipGetEC2Platforms {
+		supportedPlatforms, err := GetSupportedEC2Platforms(regionalClient.EC2Conn())
+		if err != nil {
+			log.Printf("[WARN] Unable to get supported EC2 platforms in Region (%s): %s", region, err)
+		} else {
+			regionalClient.SupportedPlatforms = supportedPlatforms
+		}
+	}
+
+	return regionalClient, nil
+}
+
+// ResourceTypeClient returns an AWSClient whose DefaultTagsConfig contains the default tags for the specified resource type.
+// If the default tags are the same for all resource types the client itself is returned.
+// Clients share the service clients of the AWSClient from which they are derived and are cached for its lifetime.
+func (client *AWSClient) ResourceTypeClient(typeName string) *AWSClient {
+	if client.DefaultTagsConfig.IsStatic() {
+		return client
+	}
+
+	if v, ok := client.resourceTypeClients.Load(typeName); ok {
+		return v.(*AWSClient)
+	}
+
+	defaultTagsConfig := client.DefaultTagsConfig.ForResourceType(typeName, tftags.DefaultValues{
+		AccountID: client.AccountID,
+		Partition: client.Partition,
+		Region:    client.Region,
+	})
+
+	v, _ := client.resourceTypeClients.LoadOrStore(typeName, &AWSClient{
+		AccountID:          client.AccountID,
+		Config:             client.Config,
+		DefaultTagsConfig:  defaultTagsConfig,
+		DNSSuffix:          client.DNSSuffix,
+		IgnoreTagsConfig:   client.IgnoreTagsConfig,
+		Partition:          client.Partition,
+		Region:             client.Region,
+		ReverseDNSPrefix:   client.ReverseDNSPrefix,
+		ServicePackages:    client.ServicePackages,
+		Session:            client.Session,
+		SupportedPlatforms: client.SupportedPlatforms,
+		TerraformVersion:   client.TerraformVersion,
+		parent:             client,
+		providerConfig:     client.providerConfig,
+		regionalClients:    client.regionalClients,
+	})
+
+	return v.(*AWSClient)
+}
+
+// Base returns the AWSClient from which a client returned by ResourceTypeClient is derived, or the client itself.
+// State cached on an AWSClient, such as the MediaConvert account client, should be cached on the base client.
+func (client *AWSClient) Base() *AWSClient {
+	if client.parent != nil {
+		return client.parent
+	}
+
+	return client
+}
+
+// s3URICleaningDisabled is the key of the S3 client with REST protocol URI cleaning disabled.
+const s3URICleaningDisabled = "s3_uri_cleaning_disabled"
+
+// conn returns the client for the specified service.
+// Clients are created on first use and cached for the lifetime of the AWSClient.
+// AWSClients derived for a resource type use the service clients of the AWSClient from which they are derived.
+func conn[T any](client *AWSClient, serviceName string) T {
+	if client.parent != nil {
+		return conn[T](client.parent, serviceName)
+	}
+
+	client.connsLock.Lock()
+	defer client.connsLock.Unlock()
+
+	if v, ok := client.conns[serviceName]; ok {
+		return v.(T)
+	}
+
+	if client.providerConfig == nil {
+		var zero T
+		return zero
+	}
+
+	v := client.providerConfig.newConn(client, serviceName)
+
+	if client.conns == nil {
+		client.conns = make(map[string]any)
+	}
+	client.conns[serviceName] = v
+
+	return v.(T)
+}
+
+// regionalClientCache caches AWSClients by Region.
+// Each Region's client is created at most once, without blocking lookups for other Regions.
+type regionalClientCache struct {
+	entries map[string]*regionalClientCacheEntry
+	mu      sync.Mutex
+}
+
+type regionalClientCacheEntry struct {
+	client *AWSClient
+	mu     sync.Mutex
+}
+
+func newRegionalClientCache() *regionalClientCache {
+	return &regionalClientCache{
+		entries: make(map[string]*regionalClientCacheEntry),
+	}
+}
+
+func (c *regionalClientCache) add(client *AWSClient) {
+	c.entry(client.Region).set(client)
+}
+
+func (c *regionalClientCache) get(region string, create func() (*AWSClient, error)) (*AWSClient, error) {
+	e := c.entry(region)
+
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	if e.client != nil {
+		return e.client, nil
+	}
+
+	// Errors aren't cached so that a subsequent lookup retries creation.
+	client, err := create()
+
+	if err != nil {
+		return nil, err
+	}
+
+	e.client = client
+
+	return client, nil
+}
+
+// entry returns the cache entry for the specified Region, adding an empty entry if none exists.
+func (c *regionalClientCache) entry(region string) *regionalClientCacheEntry {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	e, ok := c.entries[region]
+
+	if !ok {
+		e = &regionalClientCacheEntry{}
+		c.entries[region] = e
+	}
+
+	return e
+}
+
+func (e *regionalClientCacheEntry) set(client *AWSClient) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	e.client = client
+}
@@ -2,6 +2,8 @@
 package conns
 
 import (
+	"sync"
+
 	awsv2 "github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/comprehend"
 	"github.com/aws/aws-sdk-go-v2/service/computeoptimizer"
@@ -309,325 +311,1241 @@ import (
 	"github.com/aws/aws-sdk-go/service/xray"
 	"github.com/hashicorp/terraform-provider-aws/internal/experimental/intf"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/names"
 )
 
 type AWSClient struct {
-	AccountID                 string
-	Config                    *awsv2.Config
-	DefaultTagsConfig         *tftags.DefaultConfig
-	DNSSuffix                 string
-	IgnoreTagsConfig          *tftags.IgnoreConfig
-	MediaConvertAccountConn   *mediaconvert.MediaConvert
-	Partition                 string
-	Region                    string
-	ReverseDNSPrefix          string
-	S3ConnURICleaningDisabled *s3.S3
-	ServicePackages           []intf.ServicePackageData
-	Session                   *session.Session
-	SupportedPlatforms        []string
-	TerraformVersion          string
-
-	ACMConn                          *acm.ACM
-	ACMPCAConn                       *acmpca.ACMPCA
-	AMPConn                          *prometheusservice.PrometheusService
-	APIGatewayConn                   *apigateway.APIGateway
-	APIGatewayManagementAPIConn      *apigatewaymanagementapi.ApiGatewayManagementApi
-	APIGatewayV2Conn                 *apigatewayv2.ApiGatewayV2
-	AccessAnalyzerConn               *accessanalyzer.AccessAnalyzer
-	AccountConn                      *account.Account
-	AlexaForBusinessConn             *alexaforbusiness.AlexaForBusiness
-	AmplifyConn                      *amplify.Amplify
-	AmplifyBackendConn               *amplifybackend.AmplifyBackend
-	AmplifyUIBuilderConn             *amplifyuibuilder.AmplifyUIBuilder
-	AppAutoScalingConn               *applicationautoscaling.ApplicationAutoScaling
-	AppConfigConn                    *appconfig.AppConfig
-	AppConfigDataConn                *appconfigdata.AppConfigData
-	AppFlowConn                      *appflow.Appflow
-	AppIntegrationsConn              *appintegrationsservice.AppIntegrationsService
-	AppMeshConn                      *appmesh.AppMesh
-	AppRunnerConn                    *apprunner.AppRunner
-	AppStreamConn                    *appstream.AppStream
-	AppSyncConn                      *appsync.AppSync
-	ApplicationCostProfilerConn      *applicationcostprofiler.ApplicationCostProfiler
-	ApplicationInsightsConn          *applicationinsights.ApplicationInsights
-	AthenaConn                       *athena.Athena
-	AuditManagerConn                 *auditmanager.AuditManager
-	AutoScalingConn                  *autoscaling.AutoScaling
-	AutoScalingPlansConn             *autoscalingplans.AutoScalingPlans
-	BackupConn                       *backup.Backup
-	BackupGatewayConn                *backupgateway.BackupGateway
-	BatchConn                        *batch.Batch
-	BillingConductorConn             *billingconductor.BillingConductor
-	BraketConn                       *braket.Braket
-	BudgetsConn                      *budgets.Budgets
-	CEConn                           *costexplorer.CostExplorer
-	CURConn                          *costandusagereportservice.CostandUsageReportService
-	ChimeConn                        *chime.Chime
-	ChimeSDKIdentityConn             *chimesdkidentity.ChimeSDKIdentity
-	ChimeSDKMeetingsConn             *chimesdkmeetings.ChimeSDKMeetings
-	ChimeSDKMessagingConn            *chimesdkmessaging.ChimeSDKMessaging
-	Cloud9Conn                       *cloud9.Cloud9
-	CloudControlConn                 *cloudcontrolapi.CloudControlApi
-	CloudDirectoryConn               *clouddirectory.CloudDirectory
-	CloudFormationConn               *cloudformation.CloudFormation
-	CloudFrontConn                   *cloudfront.CloudFront
-	CloudHSMV2Conn                   *cloudhsmv2.CloudHSMV2
-	CloudSearchConn                  *cloudsearch.CloudSearch
-	CloudSearchDomainConn            *cloudsearchdomain.CloudSearchDomain
-	CloudTrailConn                   *cloudtrail.CloudTrail
-	CloudWatchConn                   *cloudwatch.CloudWatch
-	CodeArtifactConn                 *codeartifact.CodeArtifact
-	CodeBuildConn                    *codebuild.CodeBuild
-	CodeCommitConn                   *codecommit.CodeCommit
-	CodeGuruProfilerConn             *codeguruprofiler.CodeGuruProfiler
-	CodeGuruReviewerConn             *codegurureviewer.CodeGuruReviewer
-	CodePipelineConn                 *codepipeline.CodePipeline
-	CodeStarConn                     *codestar.CodeStar
-	CodeStarConnectionsConn          *codestarconnections.CodeStarConnections
-	CodeStarNotificationsConn        *codestarnotifications.CodeStarNotifications
-	CognitoIDPConn                   *cognitoidentityprovider.CognitoIdentityProvider
-	CognitoIdentityConn              *cognitoidentity.CognitoIdentity
-	CognitoSyncConn                  *cognitosync.CognitoSync
-	ComprehendConn                   *comprehend.Client
-	ComprehendMedicalConn            *comprehendmedical.ComprehendMedical
-	ComputeOptimizerConn             *computeoptimizer.Client
-	ConfigServiceConn                *configservice.ConfigService
-	ConnectConn                      *connect.Connect
-	ConnectContactLensConn           *connectcontactlens.ConnectContactLens
-	ConnectParticipantConn           *connectparticipant.ConnectParticipant
-	ControlTowerConn                 *controltower.ControlTower
-	CustomerProfilesConn             *customerprofiles.CustomerProfiles
-	DAXConn                          *dax.DAX
-	DLMConn                          *dlm.DLM
-	DMSConn                          *databasemigrationservice.DatabaseMigrationService
-	DRSConn                          *drs.Drs
-	DSConn                           *directoryservice.DirectoryService
-	DataBrewConn                     *gluedatabrew.GlueDataBrew
-	DataExchangeConn                 *dataexchange.DataExchange
-	DataPipelineConn                 *datapipeline.DataPipeline
-	DataSyncConn                     *datasync.DataSync
-	DeployConn                       *codedeploy.CodeDeploy
-	DetectiveConn                    *detective.Detective
-	DevOpsGuruConn                   *devopsguru.DevOpsGuru
-	DeviceFarmConn                   *devicefarm.DeviceFarm
-	DirectConnectConn                *directconnect.DirectConnect
-	DiscoveryConn                    *applicationdiscoveryservice.ApplicationDiscoveryService
-	DocDBConn                        *docdb.DocDB
-	DynamoDBConn                     *dynamodb.DynamoDB
-	DynamoDBStreamsConn              *dynamodbstreams.DynamoDBStreams
-	EBSConn                          *ebs.EBS
-	EC2Conn                          *ec2.EC2
-	EC2InstanceConnectConn           *ec2instanceconnect.EC2InstanceConnect
-	ECRConn                          *ecr.ECR
-	ECRPublicConn                    *ecrpublic.ECRPublic
-	ECSConn                          *ecs.ECS
-	EFSConn                          *efs.EFS
-	EKSConn                          *eks.EKS
-	ELBConn                          *elb.ELB
-	ELBV2Conn                        *elbv2.ELBV2
-	EMRConn                          *emr.EMR
-	EMRContainersConn                *emrcontainers.EMRContainers
-	EMRServerlessConn                *emrserverless.EMRServerless
-	ElastiCacheConn                  *elasticache.ElastiCache
-	ElasticBeanstalkConn             *elasticbeanstalk.ElasticBeanstalk
-	ElasticInferenceConn             *elasticinference.ElasticInference
-	ElasticTranscoderConn            *elastictranscoder.ElasticTranscoder
-	ElasticsearchConn                *elasticsearchservice.ElasticsearchService
-	EventsConn                       *eventbridge.EventBridge
-	EvidentlyConn                    *cloudwatchevidently.CloudWatchEvidently
-	FISConn                          *fis.Client
-	FMSConn                          *fms.FMS
-	FSxConn                          *fsx.FSx
-	FinSpaceConn                     *finspace.Finspace
-	FinSpaceDataConn                 *finspacedata.FinSpaceData
-	FirehoseConn                     *firehose.Firehose
-	ForecastConn                     *forecastservice.ForecastService
-	ForecastQueryConn                *forecastqueryservice.ForecastQueryService
-	FraudDetectorConn                *frauddetector.FraudDetector
-	GameLiftConn                     *gamelift.GameLift
-	GlacierConn                      *glacier.Glacier
-	GlobalAcceleratorConn            *globalaccelerator.GlobalAccelerator
-	GlueConn                         *glue.Glue
-	GrafanaConn                      *managedgrafana.ManagedGrafana
-	GreengrassConn                   *greengrass.Greengrass
-	GreengrassV2Conn                 *greengrassv2.GreengrassV2
-	GroundStationConn                *groundstation.GroundStation
-	GuardDutyConn                    *guardduty.GuardDuty
-	HealthConn                       *health.Health
-	HealthLakeConn                   *healthlake.HealthLake
-	HoneycodeConn                    *honeycode.Honeycode
-	IAMConn                          *iam.IAM
-	IVSConn                          *ivs.IVS
-	IdentityStoreConn                *identitystore.Client
-	ImageBuilderConn                 *imagebuilder.Imagebuilder
-	InspectorConn                    *inspector.Inspector
-	Inspector2Conn                   *inspector2.Client
-	IoTConn                          *iot.IoT
-	IoT1ClickDevicesConn             *iot1clickdevicesservice.IoT1ClickDevicesService
-	IoT1ClickProjectsConn            *iot1clickprojects.IoT1ClickProjects
-	IoTAnalyticsConn                 *iotanalytics.IoTAnalytics
-	IoTDataConn                      *iotdataplane.IoTDataPlane
-	IoTDeviceAdvisorConn             *iotdeviceadvisor.IoTDeviceAdvisor
-	IoTEventsConn                    *iotevents.IoTEvents
-	IoTEventsDataConn                *ioteventsdata.IoTEventsData
-	IoTFleetHubConn                  *iotfleethub.IoTFleetHub
-	IoTJobsDataConn                  *iotjobsdataplane.IoTJobsDataPlane
-	IoTSecureTunnelingConn           *iotsecuretunneling.IoTSecureTunneling
-	IoTSiteWiseConn                  *iotsitewise.IoTSiteWise
-	IoTThingsGraphConn               *iotthingsgraph.IoTThingsGraph
-	IoTTwinMakerConn                 *iottwinmaker.IoTTwinMaker
-	IoTWirelessConn                  *iotwireless.IoTWireless
-	KMSConn                          *kms.KMS
-	KafkaConn                        *kafka.Kafka
-	KafkaConnectConn                 *kafkaconnect.KafkaConnect
-	KendraConn                       *kendra.Client
-	KeyspacesConn                    *keyspaces.Keyspaces
-	KinesisConn                      *kinesis.Kinesis
-	KinesisAnalyticsConn             *kinesisanalytics.KinesisAnalytics
-	KinesisAnalyticsV2Conn           *kinesisanalyticsv2.KinesisAnalyticsV2
-	KinesisVideoConn                 *kinesisvideo.KinesisVideo
-	KinesisVideoArchivedMediaConn    *kinesisvideoarchivedmedia.KinesisVideoArchivedMedia
-	KinesisVideoMediaConn            *kinesisvideomedia.KinesisVideoMedia
-	KinesisVideoSignalingConn        *kinesisvideosignalingchannels.KinesisVideoSignalingChannels
-	LakeFormationConn                *lakeformation.LakeFormation
-	LambdaConn                       *lambda.Lambda
-	LexModelsConn                    *lexmodelbuildingservice.LexModelBuildingService
-	LexModelsV2Conn                  *lexmodelsv2.LexModelsV2
-	LexRuntimeConn                   *lexruntimeservice.LexRuntimeService
-	LexRuntimeV2Conn                 *lexruntimev2.LexRuntimeV2
-	LicenseManagerConn               *licensemanager.LicenseManager
-	LightsailConn                    *lightsail.Lightsail
-	LocationConn                     *locationservice.LocationService
-	LogsConn                         *cloudwatchlogs.CloudWatchLogs
-	LookoutEquipmentConn             *lookoutequipment.LookoutEquipment
-	LookoutMetricsConn               *lookoutmetrics.LookoutMetrics
-	LookoutVisionConn                *lookoutforvision.LookoutForVision
-	MQConn                           *mq.MQ
-	MTurkConn                        *mturk.MTurk
-	MWAAConn                         *mwaa.MWAA
-	MachineLearningConn              *machinelearning.MachineLearning
-	MacieConn                        *macie.Macie
-	Macie2Conn                       *macie2.Macie2
-	ManagedBlockchainConn            *managedblockchain.ManagedBlockchain
-	MarketplaceCatalogConn           *marketplacecatalog.MarketplaceCatalog
-	MarketplaceCommerceAnalyticsConn *marketplacecommerceanalytics.MarketplaceCommerceAnalytics
-	MarketplaceEntitlementConn       *marketplaceentitlementservice.MarketplaceEntitlementService
-	MarketplaceMeteringConn          *marketplacemetering.MarketplaceMetering
-	MediaConnectConn                 *mediaconnect.MediaConnect
-	MediaConvertConn                 *mediaconvert.MediaConvert
-	MediaLiveConn                    *medialive.Client
-	MediaPackageConn                 *mediapackage.MediaPackage
-	MediaPackageVODConn              *mediapackagevod.MediaPackageVod
-	MediaStoreConn                   *mediastore.MediaStore
-	MediaStoreDataConn               *mediastoredata.MediaStoreData
-	MediaTailorConn                  *mediatailor.MediaTailor
-	MemoryDBConn                     *memorydb.MemoryDB
-	MgHConn                          *migrationhub.MigrationHub
-	MgnConn                          *mgn.Mgn
-	MigrationHubConfigConn           *migrationhubconfig.MigrationHubConfig
-	MigrationHubRefactorSpacesConn   *migrationhubrefactorspaces.MigrationHubRefactorSpaces
-	MigrationHubStrategyConn         *migrationhubstrategyrecommendations.MigrationHubStrategyRecommendations
-	MobileConn                       *mobile.Mobile
-	NeptuneConn                      *neptune.Neptune
-	NetworkFirewallConn              *networkfirewall.NetworkFirewall
-	NetworkManagerConn               *networkmanager.NetworkManager
-	NimbleConn                       *nimblestudio.NimbleStudio
-	OpenSearchConn                   *opensearchservice.OpenSearchService
-	OpsWorksConn                     *opsworks.OpsWorks
-	OpsWorksCMConn                   *opsworkscm.OpsWorksCM
-	OrganizationsConn                *organizations.Organizations
-	OutpostsConn                     *outposts.Outposts
-	PIConn                           *pi.PI
-	PanoramaConn                     *panorama.Panorama
-	PersonalizeConn                  *personalize.Personalize
-	PersonalizeEventsConn            *personalizeevents.PersonalizeEvents
-	PersonalizeRuntimeConn           *personalizeruntime.PersonalizeRuntime
-	PinpointConn                     *pinpoint.Pinpoint
-	PinpointEmailConn                *pinpointemail.PinpointEmail
-	PinpointSMSVoiceConn             *pinpointsmsvoice.PinpointSMSVoice
-	PollyConn                        *polly.Polly
-	PricingConn                      *pricing.Pricing
-	ProtonConn                       *proton.Proton
-	QLDBConn                         *qldb.QLDB
-	QLDBSessionConn                  *qldbsession.QLDBSession
-	QuickSightConn                   *quicksight.QuickSight
-	RAMConn                          *ram.RAM
-	RBinConn                         *recyclebin.RecycleBin
-	RDSConn                          *rds.RDS
-	RDSDataConn                      *rdsdataservice.RDSDataService
-	RUMConn                          *cloudwatchrum.CloudWatchRUM
-	RedshiftConn                     *redshift.Redshift
-	RedshiftDataConn                 *redshiftdataapiservice.RedshiftDataAPIService
-	RedshiftServerlessConn           *redshiftserverless.RedshiftServerless
-	RekognitionConn                  *rekognition.Rekognition
-	ResilienceHubConn                *resiliencehub.ResilienceHub
-	ResourceGroupsConn               *resourcegroups.ResourceGroups
-	ResourceGroupsTaggingAPIConn     *resourcegroupstaggingapi.ResourceGroupsTaggingAPI
-	RoboMakerConn                    *robomaker.RoboMaker
-	RolesAnywhereConn                *rolesanywhere.Client
-	Route53Conn                      *route53.Route53
-	Route53DomainsConn               *route53domains.Client
-	Route53RecoveryClusterConn       *route53recoverycluster.Route53RecoveryCluster
-	Route53RecoveryControlConfigConn *route53recoverycontrolconfig.Route53RecoveryControlConfig
-	Route53RecoveryReadinessConn     *route53recoveryreadiness.Route53RecoveryReadiness
-	Route53ResolverConn              *route53resolver.Route53Resolver
-	S3Conn                           *s3.S3
-	S3ControlConn                    *s3control.S3Control
-	S3OutpostsConn                   *s3outposts.S3Outposts
-	SESConn                          *ses.SES
-	SESV2Conn                        *sesv2.Client
-	SFNConn                          *sfn.SFN
-	SMSConn                          *sms.SMS
-	SNSConn                          *sns.SNS
-	SQSConn                          *sqs.SQS
-	SSMConn                          *ssm.SSM
-	SSMContactsConn                  *ssmcontacts.SSMContacts
-	SSMIncidentsConn                 *ssmincidents.SSMIncidents
-	SSOConn                          *sso.SSO
-	SSOAdminConn                     *ssoadmin.SSOAdmin
-	SSOOIDCConn                      *ssooidc.SSOOIDC
-	STSConn                          *sts.STS
-	SWFConn                          *swf.SWF
-	SageMakerConn                    *sagemaker.SageMaker
-	SageMakerA2IRuntimeConn          *augmentedairuntime.AugmentedAIRuntime
-	SageMakerEdgeConn                *sagemakeredgemanager.SagemakerEdgeManager
-	SageMakerFeatureStoreRuntimeConn *sagemakerfeaturestoreruntime.SageMakerFeatureStoreRuntime
-	SageMakerRuntimeConn             *sagemakerruntime.SageMakerRuntime
-	SavingsPlansConn                 *savingsplans.SavingsPlans
-	SchemasConn                      *schemas.Schemas
-	SecretsManagerConn               *secretsmanager.SecretsManager
-	SecurityHubConn                  *securityhub.SecurityHub
-	ServerlessRepoConn               *serverlessapplicationrepository.ServerlessApplicationRepository
-	ServiceCatalogConn               *servicecatalog.ServiceCatalog
-	ServiceCatalogAppRegistryConn    *appregistry.AppRegistry
-	ServiceDiscoveryConn             *servicediscovery.ServiceDiscovery
-	ServiceQuotasConn                *servicequotas.ServiceQuotas
-	ShieldConn                       *shield.Shield
-	SignerConn                       *signer.Signer
-	SimpleDBConn                     *simpledb.SimpleDB
-	SnowDeviceManagementConn         *snowdevicemanagement.SnowDeviceManagement
-	SnowballConn                     *snowball.Snowball
-	StorageGatewayConn               *storagegateway.StorageGateway
-	SupportConn                      *support.Support
-	SyntheticsConn                   *synthetics.Synthetics
-	TextractConn                     *textract.Textract
-	TimestreamQueryConn              *timestreamquery.TimestreamQuery
-	TimestreamWriteConn              *timestreamwrite.TimestreamWrite
-	TranscribeConn                   *transcribe.Client
-	TranscribeStreamingConn          *transcribestreamingservice.TranscribeStreamingService
-	TransferConn                     *transfer.Transfer
-	TranslateConn                    *translate.Translate
-	VoiceIDConn                      *voiceid.VoiceID
-	WAFConn                          *waf.WAF
-	WAFRegionalConn                  *wafregional.WAFRegional
-	WAFV2Conn                        *wafv2.WAFV2
-	WellArchitectedConn              *wellarchitected.WellArchitected
-	WisdomConn                       *connectwisdomservice.ConnectWisdomService
-	WorkDocsConn                     *workdocs.WorkDocs
-	WorkLinkConn                     *worklink.WorkLink
-	WorkMailConn                     *workmail.WorkMail
-	WorkMailMessageFlowConn          *workmailmessageflow.WorkMailMessageFlow
-	WorkSpacesConn                   *workspaces.WorkSpaces
-	WorkSpacesWebConn                *workspacesweb.WorkSpacesWeb
-	XRayConn                         *xray.XRay
+	AccountID               string
+	Config                  *awsv2.Config
+	DefaultTagsConfig       *tftags.DefaultConfig
+	DNSSuffix               string
+	IgnoreTagsConfig        *tftags.IgnoreConfig
+	MediaConvertAccountConn *mediaconvert.MediaConvert
+	Partition               string
+	Region                  string
+	ReverseDNSPrefix        string
+	ServicePackages         []intf.ServicePackageData
+	Session                 *session.Session
+	SupportedPlatforms      []string
+	TerraformVersion        string
+
+	conns               map[string]any
+	connsLock           sync.Mutex
+	parent              *AWSClient
+	providerConfig      *Config
+	rateLimiters        map[string]*rateLimiter
+	regionalClients     *regionalClientCache
+	resourceTypeClients sync.Map
+}
+
+func (client *AWSClient) ACMConn() *acm.ACM {
+	return conn[*acm.ACM](client, names.ACM)
+}
+
+func (client *AWSClient) ACMPCAConn() *acmpca.ACMPCA {
+	return conn[*acmpca.ACMPCA](client, names.ACMPCA)
+}
+
+func (client *AWSClient) AMPConn() *prometheusservice.PrometheusService {
+	return conn[*prometheusservice.PrometheusService](client, names.AMP)
+}
+
+func (client *AWSClient) APIGatewayConn() *apigateway.APIGateway {
+	return conn[*apigateway.APIGateway](client, names.APIGateway)
+}
+
+func (client *AWSClient) APIGatewayManagementAPIConn() *apigatewaymanagementapi.ApiGatewayManagementApi {
+	return conn[*apigatewaymanagementapi.ApiGatewayManagementApi](client, names.APIGatewayManagementAPI)
+}
+
+func (client *AWSClient) APIGatewayV2Conn() *apigatewayv2.ApiGatewayV2 {
+	return conn[*apigatewayv2.ApiGatewayV2](client, names.APIGatewayV2)
+}
+
+func (client *AWSClient) AccessAnalyzerConn() *accessanalyzer.AccessAnalyzer {
+	return conn[*accessanalyzer.AccessAnalyzer](client, names.AccessAnalyzer)
+}
+
+func (client *AWSClient) AccountConn() *account.Account {
+	return conn[*account.Account](client, names.Account)
+}
+
+func (client *AWSClient) AlexaForBusinessConn() *alexaforbusiness.AlexaForBusiness {
+	return conn[*alexaforbusiness.AlexaForBusiness](client, names.AlexaForBusiness)
+}
+
+func (client *AWSClient) AmplifyConn() *amplify.Amplify {
+	return conn[*amplify.Amplify](client, names.Amplify)
+}
+
+func (client *AWSClient) AmplifyBackendConn() *amplifybackend.AmplifyBackend {
+	return conn[*amplifybackend.AmplifyBackend](client, names.AmplifyBackend)
+}
+
+func (client *AWSClient) AmplifyUIBuilderConn() *amplifyuibuilder.AmplifyUIBuilder {
+	return conn[*amplifyuibuilder.AmplifyUIBuilder](client, names.AmplifyUIBuilder)
+}
+
+func (client *AWSClient) AppAutoScalingConn() *applicationautoscaling.ApplicationAutoScaling {
+	return conn[*applicationautoscaling.ApplicationAutoScaling](client, names.AppAutoScaling)
+}
+
+func (client *AWSClient) AppConfigConn() *appconfig.AppConfig {
+	return conn[*appconfig.AppConfig](client, names.AppConfig)
+}
+
+func (client *AWSClient) AppConfigDataConn() *appconfigdata.AppConfigData {
+	return conn[*appconfigdata.AppConfigData](client, names.AppConfigData)
+}
+
+func (client *AWSClient) AppFlowConn() *appflow.Appflow {
+	return conn[*appflow.Appflow](client, names.AppFlow)
+}
+
+func (client *AWSClient) AppIntegrationsConn() *appintegrationsservice.AppIntegrationsService {
+	return conn[*appintegrationsservice.AppIntegrationsService](client, names.AppIntegrations)
+}
+
+func (client *AWSClient) AppMeshConn() *appmesh.AppMesh {
+	return conn[*appmesh.AppMesh](client, names.AppMesh)
+}
+
+func (client *AWSClient) AppRunnerConn() *apprunner.AppRunner {
+	return conn[*apprunner.AppRunner](client, names.AppRunner)
+}
+
+func (client *AWSClient) AppStreamConn() *appstream.AppStream {
+	return conn[*appstream.AppStream](client, names.AppStream)
+}
+
+func (client *AWSClient) AppSyncConn() *appsync.AppSync {
+	return conn[*appsync.AppSync](client, names.AppSync)
+}
+
+func (client *AWSClient) ApplicationCostProfilerConn() *applicationcostprofiler.ApplicationCostProfiler {
+	return conn[*applicationcostprofiler.ApplicationCostProfiler](client, names.ApplicationCostProfiler)
+}
+
+func (client *AWSClient) ApplicationInsightsConn() *applicationinsights.ApplicationInsights {
+	return conn[*applicationinsights.ApplicationInsights](client, names.ApplicationInsights)
+}
+
+func (client *AWSClient) AthenaConn() *athena.Athena {
+	return conn[*athena.Athena](client, names.Athena)
+}
+
+func (client *AWSClient) AuditManagerConn() *auditmanager.AuditManager {
+	return conn[*auditmanager.AuditManager](client, names.AuditManager)
+}
+
+func (client *AWSClient) AutoScalingConn() *autoscaling.AutoScaling {
+	return conn[*autoscaling.AutoScaling](client, names.AutoScaling)
+}
+
+func (client *AWSClient) AutoScalingPlansConn() *autoscalingplans.AutoScalingPlans {
+	return conn[*autoscalingplans.AutoScalingPlans](client, names.AutoScalingPlans)
+}
+
+func (client *AWSClient) BackupConn() *backup.Backup {
+	return conn[*backup.Backup](client, names.Backup)
+}
+
+func (client *AWSClient) BackupGatewayConn() *backupgateway.BackupGateway {
+	return conn[*backupgateway.BackupGateway](client, names.BackupGateway)
+}
+
+func (client *AWSClient) BatchConn() *batch.Batch {
+	return conn[*batch.Batch](client, names.Batch)
+}
+
+func (client *AWSClient) BillingConductorConn() *billingconductor.BillingConductor {
+	return conn[*billingconductor.BillingConductor](client, names.BillingConductor)
+}
+
+func (client *AWSClient) BraketConn() *braket.Braket {
+	return conn[*braket.Braket](client, names.Braket)
+}
+
+func (client *AWSClient) BudgetsConn() *budgets.Budgets {
+	return conn[*budgets.Budgets](client, names.Budgets)
+}
+
+func (client *AWSClient) CEConn() *costexplorer.CostExplorer {
+	return conn[*costexplorer.CostExplorer](client, names.CE)
+}
+
+func (client *AWSClient) CURConn() *costandusagereportservice.CostandUsageReportService {
+	return conn[*costandusagereportservice.CostandUsageReportService](client, names.CUR)
+}
+
+func (client *AWSClient) ChimeConn() *chime.Chime {
+	return conn[*chime.Chime](client, names.Chime)
+}
+
+func (client *AWSClient) ChimeSDKIdentityConn() *chimesdkidentity.ChimeSDKIdentity {
+	return conn[*chimesdkidentity.ChimeSDKIdentity](client, names.ChimeSDKIdentity)
+}
+
+func (client *AWSClient) ChimeSDKMeetingsConn() *chimesdkmeetings.ChimeSDKMeetings {
+	return conn[*chimesdkmeetings.ChimeSDKMeetings](client, names.ChimeSDKMeetings)
+}
+
+func (client *AWSClient) ChimeSDKMessagingConn() *chimesdkmessaging.ChimeSDKMessaging {
+	return conn[*chimesdkmessaging.ChimeSDKMessaging](client, names.ChimeSDKMessaging)
+}
+
+func (client *AWSClient) Cloud9Conn() *cloud9.Cloud9 {
+	return conn[*cloud9.Cloud9](client, names.Cloud9)
+}
+
+func (client *AWSClient) CloudControlConn() *cloudcontrolapi.CloudControlApi {
+	return conn[*cloudcontrolapi.CloudControlApi](client, names.CloudControl)
+}
+
+func (client *AWSClient) CloudDirectoryConn() *clouddirectory.CloudDirectory {
+	return conn[*clouddirectory.CloudDirectory](client, names.CloudDirectory)
+}
+
+func (client *AWSClient) CloudFormationConn() *cloudformation.CloudFormation {
+	return conn[*cloudformation.CloudFormation](client, names.CloudFormation)
+}
+
+func (client *AWSClient) CloudFrontConn() *cloudfront.CloudFront {
+	return conn[*cloudfront.CloudFront](client, names.CloudFront)
+}
+
+func (client *AWSClient) CloudHSMV2Conn() *cloudhsmv2.CloudHSMV2 {
+	return conn[*cloudhsmv2.CloudHSMV2](client, names.CloudHSMV2)
+}
+
+func (client *AWSClient) CloudSearchConn() *cloudsearch.CloudSearch {
+	return conn[*cloudsearch.CloudSearch](client, names.CloudSearch)
+}
+
+func (client *AWSClient) CloudSearchDomainConn() *cloudsearchdomain.CloudSearchDomain {
+	return conn[*cloudsearchdomain.CloudSearchDomain](client, names.CloudSearchDomain)
+}
+
+func (client *AWSClient) CloudTrailConn() *cloudtrail.CloudTrail {
+	return conn[*cloudtrail.CloudTrail](client, names.CloudTrail)
+}
+
+func (client *AWSClient) CloudWatchConn() *cloudwatch.CloudWatch {
+	return conn[*cloudwatch.CloudWatch](client, names.CloudWatch)
+}
+
+func (client *AWSClient) CodeArtifactConn() *codeartifact.CodeArtifact {
+	return conn[*codeartifact.CodeArtifact](client, names.CodeArtifact)
+}
+
+func (client *AWSClient) CodeBuildConn() *codebuild.CodeBuild {
+	return conn[*codebuild.CodeBuild](client, names.CodeBuild)
+}
+
+func (client *AWSClient) CodeCommitConn() *codecommit.CodeCommit {
+	return conn[*codecommit.CodeCommit](client, names.CodeCommit)
+}
+
+func (client *AWSClient) CodeGuruProfilerConn() *codeguruprofiler.CodeGuruProfiler {
+	return conn[*codeguruprofiler.CodeGuruProfiler](client, names.CodeGuruProfiler)
+}
+
+func (client *AWSClient) CodeGuruReviewerConn() *codegurureviewer.CodeGuruReviewer {
+	return conn[*codegurureviewer.CodeGuruReviewer](client, names.CodeGuruReviewer)
+}
+
+func (client *AWSClient) CodePipelineConn() *codepipeline.CodePipeline {
+	return conn[*codepipeline.CodePipeline](client, names.CodePipeline)
+}
+
+func (client *AWSClient) CodeStarConn() *codestar.CodeStar {
+	return conn[*codestar.CodeStar](client, names.CodeStar)
+}
+
+func (client *AWSClient) CodeStarConnectionsConn() *codestarconnections.CodeStarConnections {
+	return conn[*codestarconnections.CodeStarConnections](client, names.CodeStarConnections)
+}
+
+func (client *AWSClient) CodeStarNotificationsConn() *codestarnotifications.CodeStarNotifications {
+	return conn[*codestarnotifications.CodeStarNotifications](client, names.CodeStarNotifications)
+}
+
+func (client *AWSClient) CognitoIDPConn() *cognitoidentityprovider.CognitoIdentityProvider {
+	return conn[*cognitoidentityprovider.CognitoIdentityProvider](client, names.CognitoIDP)
+}
+
+func (client *AWSClient) CognitoIdentityConn() *cognitoidentity.CognitoIdentity {
+	return conn[*cognitoidentity.CognitoIdentity](client, names.CognitoIdentity)
+}
+
+func (client *AWSClient) CognitoSyncConn() *cognitosync.CognitoSync {
+	return conn[*cognitosync.CognitoSync](client, names.CognitoSync)
+}
+
+func (client *AWSClient) ComprehendConn() *comprehend.Client {
+	return conn[*comprehend.Client](client, names.Comprehend)
+}
+
+func (client *AWSClient) ComprehendMedicalConn() *comprehendmedical.ComprehendMedical {
+	return conn[*comprehendmedical.ComprehendMedical](client, names.ComprehendMedical)
+}
+
+func (client *AWSClient) ComputeOptimizerConn() *computeoptimizer.Client {
+	return conn[*computeoptimizer.Client](client, names.ComputeOptimizer)
+}
+
+func (client *AWSClient) ConfigServiceConn() *configservice.ConfigService {
+	return conn[*configservice.ConfigService](client, names.ConfigService)
+}
+
+func (client *AWSClient) ConnectConn() *connect.Connect {
+	return conn[*connect.Connect](client, names.Connect)
+}
+
+func (client *AWSClient) ConnectContactLensConn() *connectcontactlens.ConnectContactLens {
+	return conn[*connectcontactlens.ConnectContactLens](client, names.ConnectContactLens)
+}
+
+func (client *AWSClient) ConnectParticipantConn() *connectparticipant.ConnectParticipant {
+	return conn[*connectparticipant.ConnectParticipant](client, names.ConnectParticipant)
+}
+
+func (client *AWSClient) ControlTowerConn() *controltower.ControlTower {
+	return conn[*controltower.ControlTower](client, names.ControlTower)
+}
+
+func (client *AWSClient) CustomerProfilesConn() *customerprofiles.CustomerProfiles {
+	return conn[*customerprofiles.CustomerProfiles](client, names.CustomerProfiles)
+}
+
+func (client *AWSClient) DAXConn() *dax.DAX {
+	return conn[*dax.DAX](client, names.DAX)
+}
+
+func (client *AWSClient) DLMConn() *dlm.DLM {
+	return conn[*dlm.DLM](client, names.DLM)
+}
+
+func (client *AWSClient) DMSConn() *databasemigrationservice.DatabaseMigrationService {
+	return conn[*databasemigrationservice.DatabaseMigrationService](client, names.DMS)
+}
+
+func (client *AWSClient) DRSConn() *drs.Drs {
+	return conn[*drs.Drs](client, names.DRS)
+}
+
+func (client *AWSClient) DSConn() *directoryservice.DirectoryService {
+	return conn[*directoryservice.DirectoryService](client, names.DS)
+}
+
+func (client *AWSClient) DataBrewConn() *gluedatabrew.GlueDataBrew {
+	return conn[*gluedatabrew.GlueDataBrew](client, names.DataBrew)
+}
+
+func (client *AWSClient) DataExchangeConn() *dataexchange.DataExchange {
+	return conn[*dataexchange.DataExchange](client, names.DataExchange)
+}
+
+func (client *AWSClient) DataPipelineConn() *datapipeline.DataPipeline {
+	return conn[*datapipeline.DataPipeline](client, names.DataPipeline)
+}
+
+func (client *AWSClient) DataSyncConn() *datasync.DataSync {
+	return conn[*datasync.DataSync](client, names.DataSync)
+}
+
+func (client *AWSClient) DeployConn() *codedeploy.CodeDeploy {
+	return conn[*codedeploy.CodeDeploy](client, names.Deploy)
+}
+
+func (client *AWSClient) DetectiveConn() *detective.Detective {
+	return conn[*detective.Detective](client, names.Detective)
+}
+
+func (client *AWSClient) DevOpsGuruConn() *devopsguru.DevOpsGuru {
+	return conn[*devopsguru.DevOpsGuru](client, names.DevOpsGuru)
+}
+
+func (client *AWSClient) DeviceFarmConn() *devicefarm.DeviceFarm {
+	return conn[*devicefarm.DeviceFarm](client, names.DeviceFarm)
+}
+
+func (client *AWSClient) DirectConnectConn() *directconnect.DirectConnect {
+	return conn[*directconnect.DirectConnect](client, names.DirectConnect)
+}
+
+func (client *AWSClient) DiscoveryConn() *applicationdiscoveryservice.ApplicationDiscoveryService {
+	return conn[*applicationdiscoveryservice.ApplicationDiscoveryService](client, names.Discovery)
+}
+
+func (client *AWSClient) DocDBConn() *docdb.DocDB {
+	return conn[*docdb.DocDB](client, names.DocDB)
+}
+
+func (client *AWSClient) DynamoDBConn() *dynamodb.DynamoDB {
+	return conn[*dynamodb.DynamoDB](client, names.DynamoDB)
+}
+
+func (client *AWSClient) DynamoDBStreamsConn() *dynamodbstreams.DynamoDBStreams {
+	return conn[*dynamodbstreams.DynamoDBStreams](client, names.DynamoDBStreams)
+}
+
+func (client *AWSClient) EBSConn() *ebs.EBS {
+	return conn[*ebs.EBS](client, names.EBS)
+}
+
+func (client *AWSClient) EC2Conn() *ec2.EC2 {
+	return conn[*ec2.EC2](client, names.EC2)
+}
+
+func (client *AWSClient) EC2InstanceConnectConn() *ec2instanceconnect.EC2InstanceConnect {
+	return conn[*ec2instanceconnect.EC2InstanceConnect](client, names.EC2InstanceConnect)
+}
+
+func (client *AWSClient) ECRConn() *ecr.ECR {
+	return conn[*ecr.ECR](client, names.ECR)
+}
+
+func (client *AWSClient) ECRPublicConn() *ecrpublic.ECRPublic {
+	return conn[*ecrpublic.ECRPublic](client, names.ECRPublic)
+}
+
+func (client *AWSClient) ECSConn() *ecs.ECS {
+	return conn[*ecs.ECS](client, names.ECS)
+}
+
+func (client *AWSClient) EFSConn() *efs.EFS {
+	return conn[*efs.EFS](client, names.EFS)
+}
+
+func (client *AWSClient) EKSConn() *eks.EKS {
+	return conn[*eks.EKS](client, names.EKS)
+}
+
+func (client *AWSClient) ELBConn() *elb.ELB {
+	return conn[*elb.ELB](client, names.ELB)
+}
+
+func (client *AWSClient) ELBV2Conn() *elbv2.ELBV2 {
+	return conn[*elbv2.ELBV2](client, names.ELBV2)
+}
+
+func (client *AWSClient) EMRConn() *emr.EMR {
+	return conn[*emr.EMR](client, names.EMR)
+}
+
+func (client *AWSClient) EMRContainersConn() *emrcontainers.EMRContainers {
+	return conn[*emrcontainers.EMRContainers](client, names.EMRContainers)
+}
+
+func (client *AWSClient) EMRServerlessConn() *emrserverless.EMRServerless {
+	return conn[*emrserverless.EMRServerless](client, names.EMRServerless)
+}
+
+func (client *AWSClient) ElastiCacheConn() *elasticache.ElastiCache {
+	return conn[*elasticache.ElastiCache](client, names.ElastiCache)
+}
+
+func (client *AWSClient) ElasticBeanstalkConn() *elasticbeanstalk.ElasticBeanstalk {
+	return conn[*elasticbeanstalk.ElasticBeanstalk](client, names.ElasticBeanstalk)
+}
+
+func (client *AWSClient) ElasticInferenceConn() *elasticinference.ElasticInference {
+	return conn[*elasticinference.ElasticInference](client, names.ElasticInference)
+}
+
+func (client *AWSClient) ElasticTranscoderConn() *elastictranscoder.ElasticTranscoder {
+	return conn[*elastictranscoder.ElasticTranscoder](client, names.ElasticTranscoder)
+}
+
+func (client *AWSClient) ElasticsearchConn() *elasticsearchservice.ElasticsearchService {
+	return conn[*elasticsearchservice.ElasticsearchService](client, names.Elasticsearch)
+}
+
+func (client *AWSClient) EventsConn() *eventbridge.EventBridge {
+	return conn[*eventbridge.EventBridge](client, names.Events)
+}
+
+func (client *AWSClient) EvidentlyConn() *cloudwatchevidently.CloudWatchEvidently {
+	return conn[*cloudwatchevidently.CloudWatchEvidently](client, names.Evidently)
+}
+
+func (client *AWSClient) FISConn() *fis.Client {
+	return conn[*fis.Client](client, names.FIS)
+}
+
+func (client *AWSClient) FMSConn() *fms.FMS {
+	return conn[*fms.FMS](client, names.FMS)
+}
+
+func (client *AWSClient) FSxConn() *fsx.FSx {
+	return conn[*fsx.FSx](client, names.FSx)
+}
+
+func (client *AWSClient) FinSpaceConn() *finspace.Finspace {
+	return conn[*finspace.Finspace](client, names.FinSpace)
+}
+
+func (client *AWSClient) FinSpaceDataConn() *finspacedata.FinSpaceData {
+	return conn[*finspacedata.FinSpaceData](client, names.FinSpaceData)
+}
+
+func (client *AWSClient) FirehoseConn() *firehose.Firehose {
+	return conn[*firehose.Firehose](client, names.Firehose)
+}
+
+func (client *AWSClient) ForecastConn() *forecastservice.ForecastService {
+	return conn[*forecastservice.ForecastService](client, names.Forecast)
+}
+
+func (client *AWSClient) ForecastQueryConn() *forecastqueryservice.ForecastQueryService {
+	return conn[*forecastqueryservice.ForecastQueryService](client, names.ForecastQuery)
+}
+
+func (client *AWSClient) FraudDetectorConn() *frauddetector.FraudDetector {
+	return conn[*frauddetector.FraudDetector](client, names.FraudDetector)
+}
+
+func (client *AWSClient) GameLiftConn() *gamelift.GameLift {
+	return conn[*gamelift.GameLift](client, names.GameLift)
+}
+
+func (client *AWSClient) GlacierConn() *glacier.Glacier {
+	return conn[*glacier.Glacier](client, names.Glacier)
+}
+
+func (client *AWSClient) GlobalAcceleratorConn() *globalaccelerator.GlobalAccelerator {
+	return conn[*globalaccelerator.GlobalAccelerator](client, names.GlobalAccelerator)
+}
+
+func (client *AWSClient) GlueConn() *glue.Glue {
+	return conn[*glue.Glue](client, names.Glue)
+}
+
+func (client *AWSClient) GrafanaConn() *managedgrafana.ManagedGrafana {
+	return conn[*managedgrafana.ManagedGrafana](client, names.Grafana)
+}
+
+func (client *AWSClient) GreengrassConn() *greengrass.Greengrass {
+	return conn[*greengrass.Greengrass](client, names.Greengrass)
+}
+
+func (client *AWSClient) GreengrassV2Conn() *greengrassv2.GreengrassV2 {
+	return conn[*greengrassv2.GreengrassV2](client, names.GreengrassV2)
+}
+
+func (client *AWSClient) GroundStationConn() *groundstation.GroundStation {
+	return conn[*groundstation.GroundStation](client, names.GroundStation)
+}
+
+func (client *AWSClient) GuardDutyConn() *guardduty.GuardDuty {
+	return conn[*guardduty.GuardDuty](client, names.GuardDuty)
+}
+
+func (client *AWSClient) HealthConn() *health.Health {
+	return conn[*health.Health](client, names.Health)
+}
+
+func (client *AWSClient) HealthLakeConn() *healthlake.HealthLake {
+	return conn[*healthlake.HealthLake](client, names.HealthLake)
+}
+
+func (client *AWSClient) HoneycodeConn() *honeycode.Honeycode {
+	return conn[*honeycode.Honeycode](client, names.Honeycode)
+}
+
+func (client *AWSClient) IAMConn() *iam.IAM {
+	return conn[*iam.IAM](client, names.IAM)
+}
+
+func (client *AWSClient) IVSConn() *ivs.IVS {
+	return conn[*ivs.IVS](client, names.IVS)
+}
+
+func (client *AWSClient) IdentityStoreConn() *identitystore.Client {
+	return conn[*identitystore.Client](client, names.IdentityStore)
+}
+
+func (client *AWSClient) ImageBuilderConn() *imagebuilder.Imagebuilder {
+	return conn[*imagebuilder.Imagebuilder](client, names.ImageBuilder)
+}
+
+func (client *AWSClient) InspectorConn() *inspector.Inspector {
+	return conn[*inspector.Inspector](client, names.Inspector)
+}
+
+func (client *AWSClient) Inspector2Conn() *inspector2.Client {
+	return conn[*inspector2.Client](client, names.Inspector2)
+}
+
+func (client *AWSClient) IoTConn() *iot.IoT {
+	return conn[*iot.IoT](client, names.IoT)
+}
+
+func (client *AWSClient) IoT1ClickDevicesConn() *iot1clickdevicesservice.IoT1ClickDevicesService {
+	return conn[*iot1clickdevicesservice.IoT1ClickDevicesService](client, names.IoT1ClickDevices)
+}
+
+func (client *AWSClient) IoT1ClickProjectsConn() *iot1clickprojects.IoT1ClickProjects {
+	return conn[*iot1clickprojects.IoT1ClickProjects](client, names.IoT1ClickProjects)
+}
+
+func (client *AWSClient) IoTAnalyticsConn() *iotanalytics.IoTAnalytics {
+	return conn[*iotanalytics.IoTAnalytics](client, names.IoTAnalytics)
+}
+
+func (client *AWSClient) IoTDataConn() *iotdataplane.IoTDataPlane {
+	return conn[*iotdataplane.IoTDataPlane](client, names.IoTData)
+}
+
+func (client *AWSClient) IoTDeviceAdvisorConn() *iotdeviceadvisor.IoTDeviceAdvisor {
+	return conn[*iotdeviceadvisor.IoTDeviceAdvisor](client, names.IoTDeviceAdvisor)
+}
+
+func (client *AWSClient) IoTEventsConn() *iotevents.IoTEvents {
+	return conn[*iotevents.IoTEvents](client, names.IoTEvents)
+}
+
+func (client *AWSClient) IoTEventsDataConn() *ioteventsdata.IoTEventsData {
+	return conn[*ioteventsdata.IoTEventsData](client, names.IoTEventsData)
+}
+
+func (client *AWSClient) IoTFleetHubConn() *iotfleethub.IoTFleetHub {
+	return conn[*iotfleethub.IoTFleetHub](client, names.IoTFleetHub)
+}
+
+func (client *AWSClient) IoTJobsDataConn() *iotjobsdataplane.IoTJobsDataPlane {
+	return conn[*iotjobsdataplane.IoTJobsDataPlane](client, names.IoTJobsData)
+}
+
+func (client *AWSClient) IoTSecureTunnelingConn() *iotsecuretunneling.IoTSecureTunneling {
+	return conn[*iotsecuretunneling.IoTSecureTunneling](client, names.IoTSecureTunneling)
+}
+
+func (client *AWSClient) IoTSiteWiseConn() *iotsitewise.IoTSiteWise {
+	return conn[*iotsitewise.IoTSiteWise](client, names.IoTSiteWise)
+}
+
+func (client *AWSClient) IoTThingsGraphConn() *iotthingsgraph.IoTThingsGraph {
+	return conn[*iotthingsgraph.IoTThingsGraph](client, names.IoTThingsGraph)
+}
+
+func (client *AWSClient) IoTTwinMakerConn() *iottwinmaker.IoTTwinMaker {
+	return conn[*iottwinmaker.IoTTwinMaker](client, names.IoTTwinMaker)
+}
+
+func (client *AWSClient) IoTWirelessConn() *iotwireless.IoTWireless {
+	return conn[*iotwireless.IoTWireless](client, names.IoTWireless)
+}
+
+func (client *AWSClient) KMSConn() *kms.KMS {
+	return conn[*kms.KMS](client, names.KMS)
+}
+
+func (client *AWSClient) KafkaConn() *kafka.Kafka {
+	return conn[*kafka.Kafka](client, names.Kafka)
+}
+
+func (client *AWSClient) KafkaConnectConn() *kafkaconnect.KafkaConnect {
+	return conn[*kafkaconnect.KafkaConnect](client, names.KafkaConnect)
+}
+
+func (client *AWSClient) KendraConn() *kendra.Client {
+	return conn[*kendra.Client](client, names.Kendra)
+}
+
+func (client *AWSClient) KeyspacesConn() *keyspaces.Keyspaces {
+	return conn[*keyspaces.Keyspaces](client, names.Keyspaces)
+}
+
+func (client *AWSClient) KinesisConn() *kinesis.Kinesis {
+	return conn[*kinesis.Kinesis](client, names.Kinesis)
+}
+
+func (client *AWSClient) KinesisAnalyticsConn() *kinesisanalytics.KinesisAnalytics {
+	return conn[*kinesisanalytics.KinesisAnalytics](client, names.KinesisAnalytics)
+}
+
+func (client *AWSClient) KinesisAnalyticsV2Conn() *kinesisanalyticsv2.KinesisAnalyticsV2 {
+	return conn[*kinesisanalyticsv2.KinesisAnalyticsV2](client, names.KinesisAnalyticsV2)
+}
+
+func (client *AWSClient) KinesisVideoConn() *kinesisvideo.KinesisVideo {
+	return conn[*kinesisvideo.KinesisVideo](client, names.KinesisVideo)
+}
+
+func (client *AWSClient) KinesisVideoArchivedMediaConn() *kinesisvideoarchivedmedia.KinesisVideoArchivedMedia {
+	return conn[*kinesisvideoarchivedmedia.KinesisVideoArchivedMedia](client, names.KinesisVideoArchivedMedia)
+}
+
+func (client *AWSClient) KinesisVideoMediaConn() *kinesisvideomedia.KinesisVideoMedia {
+	return conn[*kinesisvideomedia.KinesisVideoMedia](client, names.KinesisVideoMedia)
+}
+
+func (client *AWSClient) KinesisVideoSignalingConn() *kinesisvideosignalingchannels.KinesisVideoSignalingChannels {
+	return conn[*kinesisvideosignalingchannels.KinesisVideoSignalingChannels](client, names.KinesisVideoSignaling)
+}
+
+func (client *AWSClient) LakeFormationConn() *lakeformation.LakeFormation {
+	return conn[*lakeformation.LakeFormation](client, names.LakeFormation)
+}
+
+func (client *AWSClient) LambdaConn() *lambda.Lambda {
+	return conn[*lambda.Lambda](client, names.Lambda)
+}
+
+func (client *AWSClient) LexModelsConn() *lexmodelbuildingservice.LexModelBuildingService {
+	return conn[*lexmodelbuildingservice.LexModelBuildingService](client, names.LexModels)
+}
+
+func (client *AWSClient) LexModelsV2Conn() *lexmodelsv2.LexModelsV2 {
+	return conn[*lexmodelsv2.LexModelsV2](client, names.LexModelsV2)
+}
+
+func (client *AWSClient) LexRuntimeConn() *lexruntimeservice.LexRuntimeService {
+	return conn[*lexruntimeservice.LexRuntimeService](client, names.LexRuntime)
+}
+
+func (client *AWSClient) LexRuntimeV2Conn() *lexruntimev2.LexRuntimeV2 {
+	return conn[*lexruntimev2.LexRuntimeV2](client, names.LexRuntimeV2)
+}
+
+func (client *AWSClient) LicenseManagerConn() *licensemanager.LicenseManager {
+	return conn[*licensemanager.LicenseManager](client, names.LicenseManager)
+}
+
+func (client *AWSClient) LightsailConn() *lightsail.Lightsail {
+	return conn[*lightsail.Lightsail](client, names.Lightsail)
+}
+
+func (client *AWSClient) LocationConn() *locationservice.LocationService {
+	return conn[*locationservice.LocationService](client, names.Location)
+}
+
+func (client *AWSClient) LogsConn() *cloudwatchlogs.CloudWatchLogs {
+	return conn[*cloudwatchlogs.CloudWatchLogs](client, names.Logs)
+}
+
+func (client *AWSClient) LookoutEquipmentConn() *lookoutequipment.LookoutEquipment {
+	return conn[*lookoutequipment.LookoutEquipment](client, names.LookoutEquipment)
+}
+
+func (client *AWSClient) LookoutMetricsConn() *lookoutmetrics.LookoutMetrics {
+	return conn[*lookoutmetrics.LookoutMetrics](client, names.LookoutMetrics)
+}
+
+func (client *AWSClient) LookoutVisionConn() *lookoutforvision.LookoutForVision {
+	return conn[*lookoutforvision.LookoutForVision](client, names.LookoutVision)
+}
+
+func (client *AWSClient) MQConn() *mq.MQ {
+	return conn[*mq.MQ](client, names.MQ)
+}
+
+func (client *AWSClient) MTurkConn() *mturk.MTurk {
+	return conn[*mturk.MTurk](client, names.MTurk)
+}
+
+func (client *AWSClient) MWAAConn() *mwaa.MWAA {
+	return conn[*mwaa.MWAA](client, names.MWAA)
+}
+
+func (client *AWSClient) MachineLearningConn() *machinelearning.MachineLearning {
+	return conn[*machinelearning.MachineLearning](client, names.MachineLearning)
+}
+
+func (client *AWSClient) MacieConn() *macie.Macie {
+	return conn[*macie.Macie](client, names.Macie)
+}
+
+func (client *AWSClient) Macie2Conn() *macie2.Macie2 {
+	return conn[*macie2.Macie2](client, names.Macie2)
+}
+
+func (client *AWSClient) ManagedBlockchainConn() *managedblockchain.ManagedBlockchain {
+	return conn[*managedblockchain.ManagedBlockchain](client, names.ManagedBlockchain)
+}
+
+func (client *AWSClient) MarketplaceCatalogConn() *marketplacecatalog.MarketplaceCatalog {
+	return conn[*marketplacecatalog.MarketplaceCatalog](client, names.MarketplaceCatalog)
+}
+
+func (client *AWSClient) MarketplaceCommerceAnalyticsConn() *marketplacecommerceanalytics.MarketplaceCommerceAnalytics {
+	return conn[*marketplacecommerceanalytics.MarketplaceCommerceAnalytics](client, names.MarketplaceCommerceAnalytics)
+}
+
+func (client *AWSClient) MarketplaceEntitlementConn() *marketplaceentitlementservice.MarketplaceEntitlementService {
+	return conn[*marketplaceentitlementservice.MarketplaceEntitlementService](client, names.MarketplaceEntitlement)
+}
+
+func (client *AWSClient) MarketplaceMeteringConn() *marketplacemetering.MarketplaceMetering {
+	return conn[*marketplacemetering.MarketplaceMetering](client, names.MarketplaceMetering)
+}
+
+func (client *AWSClient) MediaConnectConn() *mediaconnect.MediaConnect {
+	return conn[*mediaconnect.MediaConnect](client, names.MediaConnect)
+}
+
+func (client *AWSClient) MediaConvertConn() *mediaconvert.MediaConvert {
+	return conn[*mediaconvert.MediaConvert](client, names.MediaConvert)
+}
+
+func (client *AWSClient) MediaLiveConn() *medialive.Client {
+	return conn[*medialive.Client](client, names.MediaLive)
+}
+
+func (client *AWSClient) MediaPackageConn() *mediapackage.MediaPackage {
+	return conn[*mediapackage.MediaPackage](client, names.MediaPackage)
+}
+
+func (client *AWSClient) MediaPackageVODConn() *mediapackagevod.MediaPackageVod {
+	return conn[*mediapackagevod.MediaPackageVod](client, names.MediaPackageVOD)
+}
+
+func (client *AWSClient) MediaStoreConn() *mediastore.MediaStore {
+	return conn[*mediastore.MediaStore](client, names.MediaStore)
+}
+
+func (client *AWSClient) MediaStoreDataConn() *mediastoredata.MediaStoreData {
+	return conn[*mediastoredata.MediaStoreData](client, names.MediaStoreData)
+}
+
+func (client *AWSClient) MediaTailorConn() *mediatailor.MediaTailor {
+	return conn[*mediatailor.MediaTailor](client, names.MediaTailor)
+}
+
+func (client *AWSClient) MemoryDBConn() *memorydb.MemoryDB {
+	return conn[*memorydb.MemoryDB](client, names.MemoryDB)
+}
+
+func (client *AWSClient) MgHConn() *migrationhub.MigrationHub {
+	return conn[*migrationhub.MigrationHub](client, names.MgH)
+}
+
+func (client *AWSClient) MgnConn() *mgn.Mgn {
+	return conn[*mgn.Mgn](client, names.Mgn)
+}
+
+func (client *AWSClient) MigrationHubConfigConn() *migrationhubconfig.MigrationHubConfig {
+	return conn[*migrationhubconfig.MigrationHubConfig](client, names.MigrationHubConfig)
+}
+
+func (client *AWSClient) MigrationHubRefactorSpacesConn() *migrationhubrefactorspaces.MigrationHubRefactorSpaces {
+	return conn[*migrationhubrefactorspaces.MigrationHubRefactorSpaces](client, names.MigrationHubRefactorSpaces)
+}
+
+func (client *AWSClient) MigrationHubStrategyConn() *migrationhubstrategyrecommendations.MigrationHubStrategyRecommendations {
+	return conn[*migrationhubstrategyrecommendations.MigrationHubStrategyRecommendations](client, names.MigrationHubStrategy)
+}
+
+func (client *AWSClient) MobileConn() *mobile.Mobile {
+	return conn[*mobile.Mobile](client, names.Mobile)
+}
+
+func (client *AWSClient) NeptuneConn() *neptune.Neptune {
+	return conn[*neptune.Neptune](client, names.Neptune)
+}
+
+func (client *AWSClient) NetworkFirewallConn() *networkfirewall.NetworkFirewall {
+	return conn[*networkfirewall.NetworkFirewall](client, names.NetworkFirewall)
+}
+
+func (client *AWSClient) NetworkManagerConn() *networkmanager.NetworkManager {
+	return conn[*networkmanager.NetworkManager](client, names.NetworkManager)
+}
+
+func (client *AWSClient) NimbleConn() *nimblestudio.NimbleStudio {
+	return conn[*nimblestudio.NimbleStudio](client, names.Nimble)
+}
+
+func (client *AWSClient) OpenSearchConn() *opensearchservice.OpenSearchService {
+	return conn[*opensearchservice.OpenSearchService](client, names.OpenSearch)
+}
+
+func (client *AWSClient) OpsWorksConn() *opsworks.OpsWorks {
+	return conn[*opsworks.OpsWorks](client, names.OpsWorks)
+}
+
+func (client *AWSClient) OpsWorksCMConn() *opsworkscm.OpsWorksCM {
+	return conn[*opsworkscm.OpsWorksCM](client, names.OpsWorksCM)
+}
+
+func (client *AWSClient) OrganizationsConn() *organizations.Organizations {
+	return conn[*organizations.Organizations](client, names.Organizations)
+}
+
+func (client *AWSClient) OutpostsConn() *outposts.Outposts {
+	return conn[*outposts.Outposts](client, names.Outposts)
+}
+
+func (client *AWSClient) PIConn() *pi.PI {
+	return conn[*pi.PI](client, names.PI)
+}
+
+func (client *AWSClient) PanoramaConn() *panorama.Panorama {
+	return conn[*panorama.Panorama](client, names.Panorama)
+}
+
+func (client *AWSClient) PersonalizeConn() *personalize.Personalize {
+	return conn[*personalize.Personalize](client, names.Personalize)
+}
+
+func (client *AWSClient) PersonalizeEventsConn() *personalizeevents.PersonalizeEvents {
+	return conn[*personalizeevents.PersonalizeEvents](client, names.PersonalizeEvents)
+}
+
+func (client *AWSClient) PersonalizeRuntimeConn() *personalizeruntime.PersonalizeRuntime {
+	return conn[*personalizeruntime.PersonalizeRuntime](client, names.PersonalizeRuntime)
+}
+
+func (client *AWSClient) PinpointConn() *pinpoint.Pinpoint {
+	return conn[*pinpoint.Pinpoint](client, names.Pinpoint)
+}
+
+func (client *AWSClient) PinpointEmailConn() *pinpointemail.PinpointEmail {
+	return conn[*pinpointemail.PinpointEmail](client, names.PinpointEmail)
+}
+
+func (client *AWSClient) PinpointSMSVoiceConn() *pinpointsmsvoice.PinpointSMSVoice {
+	return conn[*pinpointsmsvoice.PinpointSMSVoice](client, names.PinpointSMSVoice)
+}
+
+func (client *AWSClient) PollyConn() *polly.Polly {
+	return conn[*polly.Polly](client, names.Polly)
+}
+
+func (client *AWSClient) PricingConn() *pricing.Pricing {
+	return conn[*pricing.Pricing](client, names.Pricing)
+}
+
+func (client *AWSClient) ProtonConn() *proton.Proton {
+	return conn[*proton.Proton](client, names.Proton)
+}
+
+func (client *AWSClient) QLDBConn() *qldb.QLDB {
+	return conn[*qldb.QLDB](client, names.QLDB)
+}
+
+func (client *AWSClient) QLDBSessionConn() *qldbsession.QLDBSession {
+	return conn[*qldbsession.QLDBSession](client, names.QLDBSession)
+}
+
+func (client *AWSClient) QuickSightConn() *quicksight.QuickSight {
+	return conn[*quicksight.QuickSight](client, names.QuickSight)
+}
+
+func (client *AWSClient) RAMConn() *ram.RAM {
+	return conn[*ram.RAM](client, names.RAM)
+}
+
+func (client *AWSClient) RBinConn() *recyclebin.RecycleBin {
+	return conn[*recyclebin.RecycleBin](client, names.RBin)
+}
+
+func (client *AWSClient) RDSConn() *rds.RDS {
+	return conn[*rds.RDS](client, names.RDS)
+}
+
+func (client *AWSClient) RDSDataConn() *rdsdataservice.RDSDataService {
+	return conn[*rdsdataservice.RDSDataService](client, names.RDSData)
+}
+
+func (client *AWSClient) RUMConn() *cloudwatchrum.CloudWatchRUM {
+	return conn[*cloudwatchrum.CloudWatchRUM](client, names.RUM)
+}
+
+func (client *AWSClient) RedshiftConn() *redshift.Redshift {
+	return conn[*redshift.Redshift](client, names.Redshift)
+}
+
+func (client *AWSClient) RedshiftDataConn() *redshiftdataapiservice.RedshiftDataAPIService {
+	return conn[*redshiftdataapiservice.RedshiftDataAPIService](client, names.RedshiftData)
+}
+
+func (client *AWSClient) RedshiftServerlessConn() *redshiftserverless.RedshiftServerless {
+	return conn[*redshiftserverless.RedshiftServerless](client, names.RedshiftServerless)
+}
+
+func (client *AWSClient) RekognitionConn() *rekognition.Rekognition {
+	return conn[*rekognition.Rekognition](client, names.Rekognition)
+}
+
+func (client *AWSClient) ResilienceHubConn() *resiliencehub.ResilienceHub {
+	return conn[*resiliencehub.ResilienceHub](client, names.ResilienceHub)
+}
+
+func (client *AWSClient) ResourceGroupsConn() *resourcegroups.ResourceGroups {
+	return conn[*resourcegroups.ResourceGroups](client, names.ResourceGroups)
+}
+
+func (client *AWSClient) ResourceGroupsTaggingAPIConn() *resourcegroupstaggingapi.ResourceGroupsTaggingAPI {
+	return conn[*resourcegroupstaggingapi.ResourceGroupsTaggingAPI](client, names.ResourceGroupsTaggingAPI)
+}
+
+func (client *AWSClient) RoboMakerConn() *robomaker.RoboMaker {
+	return conn[*robomaker.RoboMaker](client, names.RoboMaker)
+}
+
+func (client *AWSClient) RolesAnywhereConn() *rolesanywhere.Client {
+	return conn[*rolesanywhere.Client](client, names.RolesAnywhere)
+}
+
+func (client *AWSClient) Route53Conn() *route53.Route53 {
+	return conn[*route53.Route53](client, names.Route53)
+}
+
+func (client *AWSClient) Route53DomainsConn() *route53domains.Client {
+	return conn[*route53domains.Client](client, names.Route53Domains)
+}
+
+func (client *AWSClient) Route53RecoveryClusterConn() *route53recoverycluster.Route53RecoveryCluster {
+	return conn[*route53recoverycluster.Route53RecoveryCluster](client, names.Route53RecoveryCluster)
+}
+
+func (client *AWSClient) Route53RecoveryControlConfigConn() *route53recoverycontrolconfig.Route53RecoveryControlConfig {
+	return conn[*route53recoverycontrolconfig.Route53RecoveryControlConfig](client, names.Route53RecoveryControlConfig)
+}
+
+func (client *AWSClient) Route53RecoveryReadinessConn() *route53recoveryreadiness.Route53RecoveryReadiness {
+	return conn[*route53recoveryreadiness.Route53RecoveryReadiness](client, names.Route53RecoveryReadiness)
+}
+
+func (client *AWSClient) Route53ResolverConn() *route53resolver.Route53Resolver {
+	return conn[*route53resolver.Route53Resolver](client, names.Route53Resolver)
+}
+
+func (client *AWSClient) S3Conn() *s3.S3 {
+	return conn[*s3.S3](client, names.S3)
+}
+
+func (client *AWSClient) S3ControlConn() *s3control.S3Control {
+	return conn[*s3control.S3Control](client, names.S3Control)
+}
+
+func (client *AWSClient) S3OutpostsConn() *s3outposts.S3Outposts {
+	return conn[*s3outposts.S3Outposts](client, names.S3Outposts)
+}
+
+func (client *AWSClient) SESConn() *ses.SES {
+	return conn[*ses.SES](client, names.SES)
+}
+
+func (client *AWSClient) SESV2Conn() *sesv2.Client {
+	return conn[*sesv2.Client](client, names.SESV2)
+}
+
+func (client *AWSClient) SFNConn() *sfn.SFN {
+	return conn[*sfn.SFN](client, names.SFN)
+}
+
+func (client *AWSClient) SMSConn() *sms.SMS {
+	return conn[*sms.SMS](client, names.SMS)
+}
+
+func (client *AWSClient) SNSConn() *sns.SNS {
+	return conn[*sns.SNS](client, names.SNS)
+}
+
+func (client *AWSClient) SQSConn() *sqs.SQS {
+	return conn[*sqs.SQS](client, names.SQS)
+}
+
+func (client *AWSClient) SSMConn() *ssm.SSM {
+	return conn[*ssm.SSM](client, names.SSM)
+}
+
+func (client *AWSClient) SSMContactsConn() *ssmcontacts.SSMContacts {
+	return conn[*ssmcontacts.SSMContacts](client, names.SSMContacts)
+}
+
+func (client *AWSClient) SSMIncidentsConn() *ssmincidents.SSMIncidents {
+	return conn[*ssmincidents.SSMIncidents](client, names.SSMIncidents)
+}
+
+func (client *AWSClient) SSOConn() *sso.SSO {
+	return conn[*sso.SSO](client, names.SSO)
+}
+
+func (client *AWSClient) SSOAdminConn() *ssoadmin.SSOAdmin {
+	return conn[*ssoadmin.SSOAdmin](client, names.SSOAdmin)
+}
+
+func (client *AWSClient) SSOOIDCConn() *ssooidc.SSOOIDC {
+	return conn[*ssooidc.SSOOIDC](client, names.SSOOIDC)
+}
+
+func (client *AWSClient) STSConn() *sts.STS {
+	return conn[*sts.STS](client, names.STS)
+}
+
+func (client *AWSClient) SWFConn() *swf.SWF {
+	return conn[*swf.SWF](client, names.SWF)
+}
+
+func (client *AWSClient) SageMakerConn() *sagemaker.SageMaker {
+	return conn[*sagemaker.SageMaker](client, names.SageMaker)
+}
+
+func (client *AWSClient) SageMakerA2IRuntimeConn() *augmentedairuntime.AugmentedAIRuntime {
+	return conn[*augmentedairuntime.AugmentedAIRuntime](client, names.SageMakerA2IRuntime)
+}
+
+func (client *AWSClient) SageMakerEdgeConn() *sagemakeredgemanager.SagemakerEdgeManager {
+	return conn[*sagemakeredgemanager.SagemakerEdgeManager](client, names.SageMakerEdge)
+}
+
+func (client *AWSClient) SageMakerFeatureStoreRuntimeConn() *sagemakerfeaturestoreruntime.SageMakerFeatureStoreRuntime {
+	return conn[*sagemakerfeaturestoreruntime.SageMakerFeatureStoreRuntime](client, names.SageMakerFeatureStoreRuntime)
+}
+
+func (client *AWSClient) SageMakerRuntimeConn() *sagemakerruntime.SageMakerRuntime {
+	return conn[*sagemakerruntime.SageMakerRuntime](client, names.SageMakerRuntime)
+}
+
+func (client *AWSClient) SavingsPlansConn() *savingsplans.SavingsPlans {
+	return conn[*savingsplans.SavingsPlans](client, names.SavingsPlans)
+}
+
+func (client *AWSClient) SchemasConn() *schemas.Schemas {
+	return conn[*schemas.Schemas](client, names.Schemas)
+}
+
+func (client *AWSClient) SecretsManagerConn() *secretsmanager.SecretsManager {
+	return conn[*secretsmanager.SecretsManager](client, names.SecretsManager)
+}
+
+func (client *AWSClient) SecurityHubConn() *securityhub.SecurityHub {
+	return conn[*securityhub.SecurityHub](client, names.SecurityHub)
+}
+
+func (client *AWSClient) ServerlessRepoConn() *serverlessapplicationrepository.ServerlessApplicationRepository {
+	return conn[*serverlessapplicationrepository.ServerlessApplicationRepository](client, names.ServerlessRepo)
+}
+
+func (client *AWSClient) ServiceCatalogConn() *servicecatalog.ServiceCatalog {
+	return conn[*servicecatalog.ServiceCatalog](client, names.ServiceCatalog)
+}
+
+func (client *AWSClient) ServiceCatalogAppRegistryConn() *appregistry.AppRegistry {
+	return conn[*appregistry.AppRegistry](client, names.ServiceCatalogAppRegistry)
+}
+
+func (client *AWSClient) ServiceDiscoveryConn() *servicediscovery.ServiceDiscovery {
+	return conn[*servicediscovery.ServiceDiscovery](client, names.ServiceDiscovery)
+}
+
+func (client *AWSClient) ServiceQuotasConn() *servicequotas.ServiceQuotas {
+	return conn[*servicequotas.ServiceQuotas](client, names.ServiceQuotas)
+}
+
+func (client *AWSClient) ShieldConn() *shield.Shield {
+	return conn[*shield.Shield](client, names.Shield)
+}
+
+func (client *AWSClient) SignerConn() *signer.Signer {
+	return conn[*signer.Signer](client, names.Signer)
+}
+
+func (client *AWSClient) SimpleDBConn() *simpledb.SimpleDB {
+	return conn[*simpledb.SimpleDB](client, names.SimpleDB)
+}
+
+func (client *AWSClient) SnowDeviceManagementConn() *snowdevicemanagement.SnowDeviceManagement {
+	return conn[*snowdevicemanagement.SnowDeviceManagement](client, names.SnowDeviceManagement)
+}
+
+func (client *AWSClient) SnowballConn() *snowball.Snowball {
+	return conn[*snowball.Snowball](client, names.Snowball)
+}
+
+func (client *AWSClient) StorageGatewayConn() *storagegateway.StorageGateway {
+	return conn[*storagegateway.StorageGateway](client, names.StorageGateway)
+}
+
+func (client *AWSClient) SupportConn() *support.Support {
+	return conn[*support.Support](client, names.Support)
+}
+
+func (client *AWSClient) SyntheticsConn() *synthetics.Synthetics {
+	return conn[*synthetics.Synthetics](client, names.Synthetics)
+}
+
+func (client *AWSClient) TextractConn() *textract.Textract {
+	return conn[*textract.Textract](client, names.Textract)
+}
+
+func (client *AWSClient) TimestreamQueryConn() *timestreamquery.TimestreamQuery {
+	return conn[*timestreamquery.TimestreamQuery](client, names.TimestreamQuery)
+}
+
+func (client *AWSClient) TimestreamWriteConn() *timestreamwrite.TimestreamWrite {
+	return conn[*timestreamwrite.TimestreamWrite](client, names.TimestreamWrite)
+}
+
+func (client *AWSClient) TranscribeConn() *transcribe.Client {
+	return conn[*transcribe.Client](client, names.Transcribe)
+}
+
+func (client *AWSClient) TranscribeStreamingConn() *transcribestreamingservice.TranscribeStreamingService {
+	return conn[*transcribestreamingservice.TranscribeStreamingService](client, names.TranscribeStreaming)
+}
+
+func (client *AWSClient) TransferConn() *transfer.Transfer {
+	return conn[*transfer.Transfer](client, names.Transfer)
+}
+
+func (client *AWSClient) TranslateConn() *translate.Translate {
+	return conn[*translate.Translate](client, names.Translate)
+}
+
+func (client *AWSClient) VoiceIDConn() *voiceid.VoiceID {
+	return conn[*voiceid.VoiceID](client, names.VoiceID)
+}
+
+func (client *AWSClient) WAFConn() *waf.WAF {
+	return conn[*waf.WAF](client, names.WAF)
+}
+
+func (client *AWSClient) WAFRegionalConn() *wafregional.WAFRegional {
+	return conn[*wafregional.WAFRegional](client, names.WAFRegional)
+}
+
+func (client *AWSClient) WAFV2Conn() *wafv2.WAFV2 {
+	return conn[*wafv2.WAFV2](client, names.WAFV2)
+}
+
+func (client *AWSClient) WellArchitectedConn() *wellarchitected.WellArchitected {
+	return conn[*wellarchitected.WellArchitected](client, names.WellArchitected)
+}
+
+func (client *AWSClient) WisdomConn() *connectwisdomservice.ConnectWisdomService {
+	return conn[*connectwisdomservice.ConnectWisdomService](client, names.Wisdom)
+}
+
+func (client *AWSClient) WorkDocsConn() *workdocs.WorkDocs {
+	return conn[*workdocs.WorkDocs](client, names.WorkDocs)
+}
+
+func (client *AWSClient) WorkLinkConn() *worklink.WorkLink {
+	return conn[*worklink.WorkLink](client, names.WorkLink)
+}
+
+func (client *AWSClient) WorkMailConn() *workmail.WorkMail {
+	return conn[*workmail.WorkMail](client, names.WorkMail)
+}
+
+func (client *AWSClient) WorkMailMessageFlowConn() *workmailmessageflow.WorkMailMessageFlow {
+	return conn[*workmailmessageflow.WorkMailMessageFlow](client, names.WorkMailMessageFlow)
+}
+
+func (client *AWSClient) WorkSpacesConn() *workspaces.WorkSpaces {
+	return conn[*workspaces.WorkSpaces](client, names.WorkSpaces)
+}
+
+func (client *AWSClient) WorkSpacesWebConn() *workspacesweb.WorkSpacesWeb {
+	return conn[*workspacesweb.WorkSpacesWeb](client, names.WorkSpacesWeb)
+}
+
+func (client *AWSClient) XRayConn() *xray.XRay {
+	return conn[*xray.XRay](client, names.XRay)
 }
@@ -2,6 +2,9 @@ package conns
 
 import (
 	"testing"
+	"time"
+
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
 )
 
 func TestAWSClientPartitionHostname(t *testing.T) { // nosemgrep:ci.aws-in-func-name
@@ -77,3 +80,130 @@ func TestAWSClientRegionalHostname(t *testing.T) { // nosemgrep:ci.aws-in-func-n
 		})
 	}
 }
+
+func TestAWSClientRegionalClient(t *testing.T) { // nosemgrep:ci.aws-in-func-name
+	client := &AWSClient{
+		Region: "us-west-2", //lintignore:AWSAT003
+	}
+
+	for _, region := range []string{"", "us-west-2"} { //lintignore:AWSAT003
+		got, err := client.RegionalClient(region)
+
+		if err != nil {
+			t.Fatalf("unexpected error for Region (%s): %s", region, err)
+		}
+
+		if got != client {
+			t.Errorf("expected provider client for Region (%s)", region)
+		}
+	}
+
+	if _, err := client.RegionalClient("us-east-1"); err == nil { //lintignore:AWSAT003
+		t.Error("expected error for unconfigured client")
+	}
+}
+
+func TestRegionalClientCache(t *testing.T) {
+	cache := newRegionalClientCache()
+	cache.add(&AWSClient{Region: "us-west-2"}) //lintignore:AWSAT003
+
+	n := 0
+	create := func() (*AWSClient, error) {
+		n++
+		return &AWSClient{Region: "us-east-1"}, nil //lintignore:AWSAT003
+	}
+
+	for i := 0; i < 2; i++ {
+		client, err := cache.get("us-east-1", create) //lintignore:AWSAT003
+
+		if err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+
+		if got, expected := client.Region, "us-east-1"; got != expected { //lintignore:AWSAT003
+			t.Errorf("got Region %s, expected %s", got, expected)
+		}
+	}
+
+	if n != 1 {
+		t.Errorf("got %d client creations, expected 1", n)
+	}
+
+	if client, err := cache.get("us-west-2", create); err != nil || client.Region != "us-west-2" { //lintignore:AWSAT003
+		t.Errorf("expected cached provider client, got %v, %v", client, err)
+	}
+}
+
+func TestAWSClientResourceTypeClient(t *testing.T) { // nosemgrep:ci.aws-in-func-name
+	client := &AWSClient{
+		DefaultTagsConfig: &tftags.DefaultConfig{
+			Tags: tftags.New(map[string]string{"key1": "value1"}),
+		},
+		Region: "us-west-2", //lintignore:AWSAT003
+	}
+
+	if got := client.ResourceTypeClient("aws_vpc"); got != client {
+		t.Error("expected provider client for static default tags")
+	}
+
+	client.DefaultTagsConfig.Rules = []tftags.DefaultRule{
+		{
+			ResourceTypes: []string{"aws_vpc"},
+			Tags:          tftags.New(map[string]string{"key2": "{{resource_type}}/{{region}}"}),
+		},
+	}
+
+	got := client.ResourceTypeClient("aws_vpc")
+
+	if got == client {
+		t.Fatal("expected resource type client")
+	}
+
+	if got.parent != client || got.Region != client.Region {
+		t.Errorf("resource type client not derived from provider client")
+	}
+
+	if got, expected := got.DefaultTagsConfig.Tags.Map()["key2"], "aws_vpc/us-west-2"; got != expected { //lintignore:AWSAT003
+		t.Errorf("got tag value %s, expected %s", got, expected)
+	}
+
+	if client.ResourceTypeClient("aws_vpc") != got {
+		t.Error("expected cached resource type client")
+	}
+
+	if got, expected := len(client.ResourceTypeClient("aws_subnet").DefaultTagsConfig.Tags), 1; got != expected {
+		t.Errorf("got %d tags, expected %d", got, expected)
+	}
+}
+
+func TestRegionalClientCacheConcurrentCreate(t *testing.T) {
+	cache := newRegionalClientCache()
+	cache.add(&AWSClient{Region: "us-west-2"}) //lintignore:AWSAT003
+
+	creating, done := make(chan struct{}), make(chan struct{})
+
+	go func() {
+		defer close(done)
+
+		cache.get("us-east-1", func() (*AWSClient, error) { //lintignore:AWSAT003
+			close(creating)
+			<-time.After(time.Second)
+
+			return &AWSClient{Region: "us-east-1"}, nil //lintignore:AWSAT003
+		})
+	}()
+
+	<-creating
+
+	start := time.Now()
+
+	if _, err := cache.get("us-west-2", nil); err != nil { //lintignore:AWSAT003
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if d := time.Since(start); d >= time.Second {
+		t.Errorf("cached lookup blocked by client creation for another Region for %s", d)
+	}
+
+	<-done
+}
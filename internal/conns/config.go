@@ -3,8 +3,8 @@ package conns
 import (
 	"context"
 	"log"
-	"strings"
 
+	awsv2 "github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/feature/ec2/imds"
 	"github.com/aws/aws-sdk-go-v2/service/comprehend"
 	"github.com/aws/aws-sdk-go-v2/service/computeoptimizer"
@@ -19,35 +19,16 @@ import (
 	"github.com/aws/aws-sdk-go-v2/service/transcribe"
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/endpoints"
-	"github.com/aws/aws-sdk-go/aws/request"
-	"github.com/aws/aws-sdk-go/service/apigateway"
-	"github.com/aws/aws-sdk-go/service/appconfig"
-	"github.com/aws/aws-sdk-go/service/applicationautoscaling"
-	"github.com/aws/aws-sdk-go/service/appsync"
-	"github.com/aws/aws-sdk-go/service/chime"
-	"github.com/aws/aws-sdk-go/service/cloudformation"
-	"github.com/aws/aws-sdk-go/service/cloudhsmv2"
-	"github.com/aws/aws-sdk-go/service/configservice"
-	"github.com/aws/aws-sdk-go/service/dynamodb"
-	"github.com/aws/aws-sdk-go/service/fms"
+	"github.com/aws/aws-sdk-go/aws/session"
 	"github.com/aws/aws-sdk-go/service/globalaccelerator"
-	"github.com/aws/aws-sdk-go/service/kafka"
-	"github.com/aws/aws-sdk-go/service/kinesis"
-	"github.com/aws/aws-sdk-go/service/lightsail"
-	"github.com/aws/aws-sdk-go/service/organizations"
 	"github.com/aws/aws-sdk-go/service/route53"
 	"github.com/aws/aws-sdk-go/service/route53recoverycontrolconfig"
 	"github.com/aws/aws-sdk-go/service/route53recoveryreadiness"
 	"github.com/aws/aws-sdk-go/service/s3"
-	"github.com/aws/aws-sdk-go/service/securityhub"
 	"github.com/aws/aws-sdk-go/service/shield"
-	"github.com/aws/aws-sdk-go/service/ssoadmin"
-	"github.com/aws/aws-sdk-go/service/storagegateway"
 	"github.com/aws/aws-sdk-go/service/sts"
-	"github.com/aws/aws-sdk-go/service/wafv2"
 	awsbase "github.com/hashicorp/aws-sdk-go-base/v2"
 	awsbasev1 "github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2"
-	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
 	"github.com/hashicorp/terraform-provider-aws/names"
@@ -56,6 +37,10 @@ import (
 type Config struct {
 	AccessKey                      string
 	AllowedAccountIds              []string
+	AllowedOrganizationIDs         []string
+	AllowedOrganizationalUnitPaths []string
+	AllowedPartitions              []string
+	AllowedRegions                 []string
 	AssumeRole                     *awsbase.AssumeRole
 	AssumeRoleWithWebIdentity      *awsbase.AssumeRoleWithWebIdentity
 	CustomCABundle                 string
@@ -66,10 +51,14 @@ type Config struct {
 	Endpoints                      map[string]string
 	ForbiddenAccountIds            []string
 	HTTPProxy                      string
+	HTTPTransport                  HTTPTransportFunc
 	IgnoreTagsConfig               *tftags.IgnoreConfig
 	Insecure                       bool
+	LintIAMPolicies                bool
 	MaxRetries                     int
+	ReadOnly                       bool
 	Profile                        string
+	RateLimitConfig                *RateLimitConfig
 	Region                         string
 	S3UsePathStyle                 bool
 	SecretKey                      string
@@ -85,6 +74,7 @@ type Config struct {
 	Token                          string
 	UseDualStackEndpoint           bool
 	UseFIPSEndpoint                bool
+	ValidateWithAccessAnalyzer     []string
 }
 
 // ConfigureProvider configures the provided provider Meta (instance data).
@@ -154,6 +144,12 @@ func (c *Config) ConfigureProvider(ctx context.Context, client *AWSClient) (*AWS
 		return nil, diag.Errorf("error creating AWS SDK v1 session: %s", err)
 	}
 
+	// Any HTTP transport wrapper, e.g. for recording acceptance tests, applies to all subsequent requests.
+	if c.HTTPTransport != nil {
+		cfg.HTTPClient = wrapHTTPClientV2(cfg.HTTPClient, c.HTTPTransport)
+		sess.Config.HTTPClient = wrapHTTPClientV1(sess.Config.HTTPClient, c.HTTPTransport)
+	}
+
 	accountID, partition, err := awsbase.GetAwsAccountIDAndPartition(ctx, cfg, &awsbaseConfig)
 	if err != nil {
 		return nil, diag.Errorf("error retrieving account details: %s", err)
@@ -163,444 +159,273 @@ func (c *Config) ConfigureProvider(ctx context.Context, client *AWSClient) (*AWS
 		log.Println("[WARN] AWS account ID not found for provider. See https://www.terraform.io/docs/providers/aws/index.html#skip_requesting_account_id for implications.")
 	}
 
-	if len(c.ForbiddenAccountIds) > 0 {
-		for _, forbiddenAccountID := range c.AllowedAccountIds {
-			if accountID == forbiddenAccountID {
-				return nil, diag.Errorf("AWS Account ID not allowed: %s", accountID)
-			}
-		}
-	}
-	if len(c.AllowedAccountIds) > 0 {
-		found := false
-		for _, allowedAccountID := range c.AllowedAccountIds {
-			if accountID == allowedAccountID {
-				found = true
-				break
-			}
-		}
-		if !found {
-			return nil, diag.Errorf("AWS Account ID not allowed: %s", accountID)
-		}
+	if diags := c.verifyAccountID(accountID); diags.HasError() {
+		return nil, diags
 	}
 
-	DNSSuffix := "amazonaws.com"
-	if p, ok := endpoints.PartitionForRegion(endpoints.DefaultPartitions(), c.Region); ok {
-		DNSSuffix = p.DNSSuffix()
+	if diags := c.verifyPartition(partition); diags.HasError() {
+		return nil, diags
 	}
 
-	c.clientConns(client, sess)
+	if err := c.verifyRegion(c.Region); err != nil {
+		return nil, diag.FromErr(err)
+	}
 
 	client.AccountID = accountID
-	client.Config = &cfg
 	client.DefaultTagsConfig = c.DefaultTagsConfig
-	client.DNSSuffix = DNSSuffix
 	client.IgnoreTagsConfig = c.IgnoreTagsConfig
 	client.Partition = partition
-	client.Region = c.Region
-	client.ReverseDNSPrefix = ReverseDNS(DNSSuffix)
-	client.Session = sess
 	client.TerraformVersion = c.TerraformVersion
+	client.providerConfig = c
+	client.regionalClients = newRegionalClientCache()
 
-	client.ComprehendConn = comprehend.NewFromConfig(cfg, func(o *comprehend.Options) {
-		if endpoint := c.Endpoints[names.Comprehend]; endpoint != "" {
-			o.EndpointResolver = comprehend.EndpointResolverFromURL(endpoint)
-		}
-	})
-
-	client.ComputeOptimizerConn = computeoptimizer.NewFromConfig(cfg, func(o *computeoptimizer.Options) {
-		if endpoint := c.Endpoints[names.ComputeOptimizer]; endpoint != "" {
-			o.EndpointResolver = computeoptimizer.EndpointResolverFromURL(endpoint)
-		}
-	})
-
-	client.FISConn = fis.NewFromConfig(cfg, func(o *fis.Options) {
-		if endpoint := c.Endpoints[names.FIS]; endpoint != "" {
-			o.EndpointResolver = fis.EndpointResolverFromURL(endpoint)
-		}
-	})
+	c.configureServiceClients(client, cfg, sess)
 
-	client.IdentityStoreConn = identitystore.NewFromConfig(cfg, func(o *identitystore.Options) {
-		if endpoint := c.Endpoints[names.IdentityStore]; endpoint != "" {
-			o.EndpointResolver = identitystore.EndpointResolverFromURL(endpoint)
-		}
-	})
-
-	client.Inspector2Conn = inspector2.NewFromConfig(cfg, func(o *inspector2.Options) {
-		if endpoint := c.Endpoints[names.Inspector2]; endpoint != "" {
-			o.EndpointResolver = inspector2.EndpointResolverFromURL(endpoint)
-		}
-	})
+	if diags := c.verifyOrganization(ctx, client.OrganizationsConn(), accountID); diags.HasError() {
+		return nil, diags
+	}
 
-	client.KendraConn = kendra.NewFromConfig(cfg, func(o *kendra.Options) {
-		if endpoint := c.Endpoints[names.Kendra]; endpoint != "" {
-			o.EndpointResolver = kendra.EndpointResolverFromURL(endpoint)
+	if !c.SkipGetEC2Platforms {
+		supportedPlatforms, err := GetSupportedEC2Platforms(client.EC2Conn())
+		if err != nil {
+			// We intentionally fail *silently* because there's a chance
+			// user just doesn't have ec2:DescribeAccountAttributes permissions
+			log.Printf("[WARN] Unable to get supported EC2 platforms: %s", err)
+		} else {
+			client.SupportedPlatforms = supportedPlatforms
 		}
-	})
+	}
 
-	client.MediaLiveConn = medialive.NewFromConfig(cfg, func(o *medialive.Options) {
-		if endpoint := c.Endpoints[names.MediaLive]; endpoint != "" {
-			o.EndpointResolver = medialive.EndpointResolverFromURL(endpoint)
-		}
-	})
+	client.regionalClients.add(client)
 
-	client.RolesAnywhereConn = rolesanywhere.NewFromConfig(cfg, func(o *rolesanywhere.Options) {
-		if endpoint := c.Endpoints[names.RolesAnywhere]; endpoint != "" {
-			o.EndpointResolver = rolesanywhere.EndpointResolverFromURL(endpoint)
-		}
-	})
-
-	client.Route53DomainsConn = route53domains.NewFromConfig(cfg, func(o *route53domains.Options) {
-		if endpoint := c.Endpoints[names.Route53Domains]; endpoint != "" {
-			o.EndpointResolver = route53domains.EndpointResolverFromURL(endpoint)
-		} else if partition == endpoints.AwsPartitionID {
-			// Route 53 Domains is only available in AWS Commercial us-east-1 Region.
-			o.Region = endpoints.UsEast1RegionID
-		}
-	})
+	return client, nil
+}
 
-	client.SESV2Conn = sesv2.NewFromConfig(cfg, func(o *sesv2.Options) {
-		if endpoint := c.Endpoints[names.SESV2]; endpoint != "" {
-			o.EndpointResolver = sesv2.EndpointResolverFromURL(endpoint)
-		}
-	})
+// configureServiceClients configures the Region-specific fields of the specified AWSClient.
+// Service clients are created on first use.
+// The client's Partition must already be set.
+func (c *Config) configureServiceClients(client *AWSClient, cfg awsv2.Config, sess *session.Session) {
+	DNSSuffix := "amazonaws.com"
+	if p, ok := endpoints.PartitionForRegion(endpoints.DefaultPartitions(), cfg.Region); ok {
+		DNSSuffix = p.DNSSuffix()
+	}
 
-	client.TranscribeConn = transcribe.NewFromConfig(cfg, func(o *transcribe.Options) {
-		if endpoint := c.Endpoints[names.Transcribe]; endpoint != "" {
-			o.EndpointResolver = transcribe.EndpointResolverFromURL(endpoint)
-		}
-	})
+	client.Config = &cfg
+	client.DNSSuffix = DNSSuffix
+	client.Region = cfg.Region
+	client.ReverseDNSPrefix = ReverseDNS(DNSSuffix)
+	client.Session = sess
+	client.conns = make(map[string]any)
+	client.rateLimiters = make(map[string]*rateLimiter)
+}
 
-	// sts
-	stsConfig := &aws.Config{
-		Endpoint: aws.String(c.Endpoints[names.STS]),
+// newConn returns a new client for the specified service.
+// Any retry rules registered for the service and any client-side rate limiting are applied to the new client.
+// If the Config is read-only, the new client fails any API operation that may modify resources.
+func (c *Config) newConn(client *AWSClient, serviceName string) any {
+	sess, cfg := client.Session.Copy(), *client.Config
+	// Limit capacity so that appending does not modify the shared aws.Config's API options.
+	cfg.APIOptions = cfg.APIOptions[:len(cfg.APIOptions):len(cfg.APIOptions)]
+
+	baseServiceName := serviceName
+	if serviceName == s3URICleaningDisabled {
+		baseServiceName = names.S3
 	}
 
-	if c.STSRegion != "" {
-		stsConfig.Region = aws.String(c.STSRegion)
+	if rules := serviceRetryRules(baseServiceName); len(rules) > 0 {
+		sess.Handlers.Retry.PushBack(retryHandlerV1(rules))
+		cfg.APIOptions = append(cfg.APIOptions, retryMiddlewareV2(rules))
 	}
 
-	client.STSConn = sts.New(sess.Copy(stsConfig))
+	// All clients for a service share a rate limiter.
+	limiter, ok := client.rateLimiters[baseServiceName]
+	if !ok {
+		limiter = newRateLimiter(client.Region, baseServiceName, c.RateLimitConfig.ForService(baseServiceName))
+		registerRateLimiter(limiter)
 
-	// "Global" services that require customizations
-	globalAcceleratorConfig := &aws.Config{
-		Endpoint: aws.String(c.Endpoints[names.GlobalAccelerator]),
-	}
-	route53Config := &aws.Config{
-		Endpoint: aws.String(c.Endpoints[names.Route53]),
-	}
-	route53RecoveryControlConfigConfig := &aws.Config{
-		Endpoint: aws.String(c.Endpoints[names.Route53RecoveryControlConfig]),
-	}
-	route53RecoveryReadinessConfig := &aws.Config{
-		Endpoint: aws.String(c.Endpoints[names.Route53RecoveryReadiness]),
-	}
-	shieldConfig := &aws.Config{
-		Endpoint: aws.String(c.Endpoints[names.Shield]),
+		if client.rateLimiters == nil {
+			client.rateLimiters = make(map[string]*rateLimiter)
+		}
+		client.rateLimiters[baseServiceName] = limiter
 	}
 
-	// Services that require multiple client configurations
-	s3Config := &aws.Config{
-		Endpoint:         aws.String(c.Endpoints[names.S3]),
-		S3ForcePathStyle: aws.Bool(c.S3UsePathStyle),
+	if c.ReadOnly {
+		sess.Handlers.Validate.PushFrontNamed(readOnlyHandlerV1())
+		cfg.APIOptions = append(cfg.APIOptions, readOnlyMiddlewareV2())
 	}
 
-	client.S3Conn = s3.New(sess.Copy(s3Config))
-
-	s3Config.DisableRestProtocolURICleaning = aws.Bool(true)
-	client.S3ConnURICleaningDisabled = s3.New(sess.Copy(s3Config))
-
-	// Force "global" services to correct regions
-	switch partition {
-	case endpoints.AwsPartitionID:
-		globalAcceleratorConfig.Region = aws.String(endpoints.UsWest2RegionID)
-		route53Config.Region = aws.String(endpoints.UsEast1RegionID)
-		route53RecoveryControlConfigConfig.Region = aws.String(endpoints.UsWest2RegionID)
-		route53RecoveryReadinessConfig.Region = aws.String(endpoints.UsWest2RegionID)
-		shieldConfig.Region = aws.String(endpoints.UsEast1RegionID)
-	case endpoints.AwsCnPartitionID:
-		// The AWS Go SDK is missing endpoint information for Route 53 in the AWS China partition.
-		// This can likely be removed in the future.
-		if aws.StringValue(route53Config.Endpoint) == "" {
-			route53Config.Endpoint = aws.String("https://api.route53.cn")
-		}
-		route53Config.Region = aws.String(endpoints.CnNorthwest1RegionID)
-	case endpoints.AwsUsGovPartitionID:
-		route53Config.Region = aws.String(endpoints.UsGovWest1RegionID)
-	}
+	sess.Handlers.Sign.PushFrontNamed(limiter.signHandlerV1())
+	sess.Handlers.CompleteAttempt.PushBackNamed(limiter.completeAttemptHandlerV1())
+	cfg.APIOptions = append(cfg.APIOptions, limiter.middlewareV2())
 
-	client.GlobalAcceleratorConn = globalaccelerator.New(sess.Copy(globalAcceleratorConfig))
-	client.Route53Conn = route53.New(sess.Copy(route53Config))
-	client.Route53RecoveryControlConfigConn = route53recoverycontrolconfig.New(sess.Copy(route53RecoveryControlConfigConfig))
-	client.Route53RecoveryReadinessConn = route53recoveryreadiness.New(sess.Copy(route53RecoveryReadinessConfig))
-	client.ShieldConn = shield.New(sess.Copy(shieldConfig))
-
-	client.APIGatewayConn.Handlers.Retry.PushBack(func(r *request.Request) {
-		// Many operations can return an error such as:
-		//   ConflictException: Unable to complete operation due to concurrent modification. Please try again later.
-		// Handle them all globally for the service client.
-		if tfawserr.ErrMessageContains(r.Error, apigateway.ErrCodeConflictException, "try again later") {
-			r.Retryable = aws.Bool(true)
-		}
-	})
+	conn := c.newCustomConn(client.Partition, sess, cfg, serviceName)
 
-	// Workaround for https://github.com/aws/aws-sdk-go/issues/1472
-	client.AppAutoScalingConn.Handlers.Retry.PushBack(func(r *request.Request) {
-		if !strings.HasPrefix(r.Operation.Name, "Describe") && !strings.HasPrefix(r.Operation.Name, "List") {
-			return
-		}
-		if tfawserr.ErrCodeEquals(r.Error, applicationautoscaling.ErrCodeFailedResourceAccessException) {
-			r.Retryable = aws.Bool(true)
-		}
-	})
-
-	// StartDeployment operations can return a ConflictException
-	// if ongoing deployments are in-progress, thus we handle them
-	// here for the service client.
-	client.AppConfigConn.Handlers.Retry.PushBack(func(r *request.Request) {
-		if r.Operation.Name == "StartDeployment" {
-			if tfawserr.ErrCodeEquals(r.Error, appconfig.ErrCodeConflictException) {
-				r.Retryable = aws.Bool(true)
-			}
-		}
-	})
+	if conn == nil {
+		conn = c.newGeneratedConn(sess, serviceName)
+	}
 
-	client.AppSyncConn.Handlers.Retry.PushBack(func(r *request.Request) {
-		if r.Operation.Name == "CreateGraphqlApi" {
-			if tfawserr.ErrMessageContains(r.Error, appsync.ErrCodeConcurrentModificationException, "a GraphQL API creation is already in progress") {
-				r.Retryable = aws.Bool(true)
-			}
-		}
-	})
-
-	client.ChimeConn.Handlers.Retry.PushBack(func(r *request.Request) {
-		// When calling CreateVoiceConnector across multiple resources,
-		// the API can randomly return a BadRequestException without explanation
-		if r.Operation.Name == "CreateVoiceConnector" {
-			if tfawserr.ErrMessageContains(r.Error, chime.ErrCodeBadRequestException, "Service received a bad request") {
-				r.Retryable = aws.Bool(true)
-			}
-		}
-	})
+	return conn
+}
 
-	client.CloudHSMV2Conn.Handlers.Retry.PushBack(func(r *request.Request) {
-		if tfawserr.ErrMessageContains(r.Error, cloudhsmv2.ErrCodeCloudHsmInternalFailureException, "request was rejected because of an AWS CloudHSM internal failure") {
-			r.Retryable = aws.Bool(true)
-		}
-	})
-
-	client.ConfigServiceConn.Handlers.Retry.PushBack(func(r *request.Request) {
-		// When calling Config Organization Rules API actions immediately
-		// after Organization creation, the API can randomly return the
-		// OrganizationAccessDeniedException error for a few minutes, even
-		// after succeeding a few requests.
-		switch r.Operation.Name {
-		case "DeleteOrganizationConfigRule", "DescribeOrganizationConfigRules", "DescribeOrganizationConfigRuleStatuses", "PutOrganizationConfigRule":
-			if !tfawserr.ErrMessageContains(r.Error, configservice.ErrCodeOrganizationAccessDeniedException, "This action can be only made by AWS Organization's master account.") {
-				return
-			}
+// newCustomConn returns a new client for services whose clients require customization.
+// nil is returned for all other services.
+func (c *Config) newCustomConn(partition string, sess *session.Session, cfg awsv2.Config, serviceName string) any {
 
-			// We only want to retry briefly as the default max retry count would
-			// excessively retry when the error could be legitimate.
-			// We currently depend on the DefaultRetryer exponential backoff here.
-			// ~10 retries gives a fair backoff of a few seconds.
-			if r.RetryCount < 9 {
-				r.Retryable = aws.Bool(true)
-			} else {
-				r.Retryable = aws.Bool(false)
+	switch serviceName {
+	case names.Comprehend:
+		return comprehend.NewFromConfig(cfg, func(o *comprehend.Options) {
+			if endpoint := c.Endpoints[names.Comprehend]; endpoint != "" {
+				o.EndpointResolver = comprehend.EndpointResolverFromURL(endpoint)
 			}
-		case "DeleteOrganizationConformancePack", "DescribeOrganizationConformancePacks", "DescribeOrganizationConformancePackStatuses", "PutOrganizationConformancePack":
-			if !tfawserr.ErrCodeEquals(r.Error, configservice.ErrCodeOrganizationAccessDeniedException) {
-				if r.Operation.Name == "DeleteOrganizationConformancePack" && tfawserr.ErrCodeEquals(err, configservice.ErrCodeResourceInUseException) {
-					r.Retryable = aws.Bool(true)
-				}
-				return
+		})
+	case names.ComputeOptimizer:
+		return computeoptimizer.NewFromConfig(cfg, func(o *computeoptimizer.Options) {
+			if endpoint := c.Endpoints[names.ComputeOptimizer]; endpoint != "" {
+				o.EndpointResolver = computeoptimizer.EndpointResolverFromURL(endpoint)
 			}
-
-			// We only want to retry briefly as the default max retry count would
-			// excessively retry when the error could be legitimate.
-			// We currently depend on the DefaultRetryer exponential backoff here.
-			// ~10 retries gives a fair backoff of a few seconds.
-			if r.RetryCount < 9 {
-				r.Retryable = aws.Bool(true)
-			} else {
-				r.Retryable = aws.Bool(false)
+		})
+	case names.FIS:
+		return fis.NewFromConfig(cfg, func(o *fis.Options) {
+			if endpoint := c.Endpoints[names.FIS]; endpoint != "" {
+				o.EndpointResolver = fis.EndpointResolverFromURL(endpoint)
 			}
-		}
-	})
-
-	client.CloudFormationConn.Handlers.Retry.PushBack(func(r *request.Request) {
-		if tfawserr.ErrMessageContains(r.Error, cloudformation.ErrCodeOperationInProgressException, "Another Operation on StackSet") {
-			r.Retryable = aws.Bool(true)
-		}
-	})
-
-	// See https://github.com/aws/aws-sdk-go/pull/1276
-	client.DynamoDBConn.Handlers.Retry.PushBack(func(r *request.Request) {
-		if r.Operation.Name != "PutItem" && r.Operation.Name != "UpdateItem" && r.Operation.Name != "DeleteItem" {
-			return
-		}
-		if tfawserr.ErrMessageContains(r.Error, dynamodb.ErrCodeLimitExceededException, "Subscriber limit exceeded:") {
-			r.Retryable = aws.Bool(true)
-		}
-	})
-
-	client.EC2Conn.Handlers.Retry.PushBack(func(r *request.Request) {
-		switch err := r.Error; r.Operation.Name {
-		case "AttachVpnGateway", "DetachVpnGateway":
-			if tfawserr.ErrMessageContains(err, "InvalidParameterValue", "This call cannot be completed because there are pending VPNs or Virtual Interfaces") {
-				r.Retryable = aws.Bool(true)
+		})
+	case names.IdentityStore:
+		return identitystore.NewFromConfig(cfg, func(o *identitystore.Options) {
+			if endpoint := c.Endpoints[names.IdentityStore]; endpoint != "" {
+				o.EndpointResolver = identitystore.EndpointResolverFromURL(endpoint)
 			}
-
-		case "CreateClientVpnEndpoint":
-			if tfawserr.ErrMessageContains(err, "OperationNotPermitted", "Endpoint cannot be created while another endpoint is being created") {
-				r.Retryable = aws.Bool(true)
+		})
+	case names.Inspector2:
+		return inspector2.NewFromConfig(cfg, func(o *inspector2.Options) {
+			if endpoint := c.Endpoints[names.Inspector2]; endpoint != "" {
+				o.EndpointResolver = inspector2.EndpointResolverFromURL(endpoint)
 			}
-
-		case "CreateClientVpnRoute", "DeleteClientVpnRoute":
-			if tfawserr.ErrMessageContains(err, "ConcurrentMutationLimitExceeded", "Cannot initiate another change for this endpoint at this time") {
-				r.Retryable = aws.Bool(true)
+		})
+	case names.Kendra:
+		return kendra.NewFromConfig(cfg, func(o *kendra.Options) {
+			if endpoint := c.Endpoints[names.Kendra]; endpoint != "" {
+				o.EndpointResolver = kendra.EndpointResolverFromURL(endpoint)
 			}
-
-		case "CreateVpnConnection":
-			if tfawserr.ErrMessageContains(err, "VpnConnectionLimitExceeded", "maximum number of mutating objects has been reached") {
-				r.Retryable = aws.Bool(true)
+		})
+	case names.MediaLive:
+		return medialive.NewFromConfig(cfg, func(o *medialive.Options) {
+			if endpoint := c.Endpoints[names.MediaLive]; endpoint != "" {
+				o.EndpointResolver = medialive.EndpointResolverFromURL(endpoint)
 			}
-
-		case "CreateVpnGateway":
-			if tfawserr.ErrMessageContains(err, "VpnGatewayLimitExceeded", "maximum number of mutating objects has been reached") {
-				r.Retryable = aws.Bool(true)
+		})
+	case names.RolesAnywhere:
+		return rolesanywhere.NewFromConfig(cfg, func(o *rolesanywhere.Options) {
+			if endpoint := c.Endpoints[names.RolesAnywhere]; endpoint != "" {
+				o.EndpointResolver = rolesanywhere.EndpointResolverFromURL(endpoint)
 			}
-		}
-	})
-
-	client.FMSConn.Handlers.Retry.PushBack(func(r *request.Request) {
-		// Acceptance testing creates and deletes resources in quick succession.
-		// The FMS onboarding process into Organizations is opaque to consumers.
-		// Since we cannot reasonably check this status before receiving the error,
-		// set the operation as retryable.
-		switch r.Operation.Name {
-		case "AssociateAdminAccount":
-			if tfawserr.ErrMessageContains(r.Error, fms.ErrCodeInvalidOperationException, "Your AWS Organization is currently offboarding with AWS Firewall Manager. Please submit onboard request after offboarded.") {
-				r.Retryable = aws.Bool(true)
+		})
+	case names.Route53Domains:
+		return route53domains.NewFromConfig(cfg, func(o *route53domains.Options) {
+			if endpoint := c.Endpoints[names.Route53Domains]; endpoint != "" {
+				o.EndpointResolver = route53domains.EndpointResolverFromURL(endpoint)
+			} else if partition == endpoints.AwsPartitionID {
+				// Route 53 Domains is only available in AWS Commercial us-east-1 Region.
+				o.Region = endpoints.UsEast1RegionID
 			}
-		case "DisassociateAdminAccount":
-			if tfawserr.ErrMessageContains(r.Error, fms.ErrCodeInvalidOperationException, "Your AWS Organization is currently onboarding with AWS Firewall Manager and cannot be offboarded.") {
-				r.Retryable = aws.Bool(true)
+		})
+	case names.SESV2:
+		return sesv2.NewFromConfig(cfg, func(o *sesv2.Options) {
+			if endpoint := c.Endpoints[names.SESV2]; endpoint != "" {
+				o.EndpointResolver = sesv2.EndpointResolverFromURL(endpoint)
 			}
-		// System problems can arise during FMS policy updates (maybe also creation),
-		// so we set the following operation as retryable.
-		// Reference: https://github.com/hashicorp/terraform-provider-aws/issues/23946
-		case "PutPolicy":
-			if tfawserr.ErrCodeEquals(r.Error, fms.ErrCodeInternalErrorException) {
-				r.Retryable = aws.Bool(true)
+		})
+	case names.Transcribe:
+		return transcribe.NewFromConfig(cfg, func(o *transcribe.Options) {
+			if endpoint := c.Endpoints[names.Transcribe]; endpoint != "" {
+				o.EndpointResolver = transcribe.EndpointResolverFromURL(endpoint)
 			}
+		})
+	case names.STS:
+		stsConfig := &aws.Config{
+			Endpoint: aws.String(c.Endpoints[names.STS]),
 		}
-	})
 
-	client.KafkaConn.Handlers.Retry.PushBack(func(r *request.Request) {
-		if tfawserr.ErrMessageContains(r.Error, kafka.ErrCodeTooManyRequestsException, "Too Many Requests") {
-			r.Retryable = aws.Bool(true)
+		if c.STSRegion != "" {
+			stsConfig.Region = aws.String(c.STSRegion)
 		}
-	})
 
-	client.KinesisConn.Handlers.Retry.PushBack(func(r *request.Request) {
-		if r.Operation.Name == "CreateStream" {
-			if tfawserr.ErrMessageContains(r.Error, kinesis.ErrCodeLimitExceededException, "simultaneously be in CREATING or DELETING") {
-				r.Retryable = aws.Bool(true)
-			}
+		return sts.New(sess.Copy(stsConfig))
+	// Services that require multiple client configurations
+	case names.S3, s3URICleaningDisabled:
+		s3Config := &aws.Config{
+			Endpoint:         aws.String(c.Endpoints[names.S3]),
+			S3ForcePathStyle: aws.Bool(c.S3UsePathStyle),
 		}
-		if r.Operation.Name == "CreateStream" || r.Operation.Name == "DeleteStream" {
-			if tfawserr.ErrMessageContains(r.Error, kinesis.ErrCodeLimitExceededException, "Rate exceeded for stream") {
-				r.Retryable = aws.Bool(true)
-			}
+
+		if serviceName == s3URICleaningDisabled {
+			s3Config.DisableRestProtocolURICleaning = aws.Bool(true)
 		}
-	})
 
-	client.LightsailConn.Handlers.Retry.PushBack(func(r *request.Request) {
-		switch r.Operation.Name {
-		case "CreateContainerService", "UpdateContainerService", "CreateContainerServiceDeployment":
-			if tfawserr.ErrMessageContains(r.Error, lightsail.ErrCodeInvalidInputException, "Please try again in a few minutes") {
-				r.Retryable = aws.Bool(true)
-			}
-		case "DeleteContainerService":
-			if tfawserr.ErrMessageContains(r.Error, lightsail.ErrCodeInvalidInputException, "Please try again in a few minutes") ||
-				tfawserr.ErrMessageContains(r.Error, lightsail.ErrCodeInvalidInputException, "Please wait for it to complete before trying again") {
-				r.Retryable = aws.Bool(true)
-			}
+		return s3.New(sess.Copy(s3Config))
+	// "Global" services that require customizations
+	case names.GlobalAccelerator:
+		config := &aws.Config{
+			Endpoint: aws.String(c.Endpoints[names.GlobalAccelerator]),
 		}
-	})
 
-	client.OrganizationsConn.Handlers.Retry.PushBack(func(r *request.Request) {
-		// Retry on the following error:
-		// ConcurrentModificationException: AWS Organizations can't complete your request because it conflicts with another attempt to modify the same entity. Try again later.
-		if tfawserr.ErrMessageContains(r.Error, organizations.ErrCodeConcurrentModificationException, "Try again later") {
-			r.Retryable = aws.Bool(true)
+		if partition == endpoints.AwsPartitionID {
+			config.Region = aws.String(endpoints.UsWest2RegionID)
 		}
-	})
 
-	client.S3Conn.Handlers.Retry.PushBack(func(r *request.Request) {
-		if tfawserr.ErrMessageContains(r.Error, "OperationAborted", "A conflicting conditional operation is currently in progress against this resource. Please try again.") {
-			r.Retryable = aws.Bool(true)
+		return globalaccelerator.New(sess.Copy(config))
+	case names.Route53:
+		config := &aws.Config{
+			Endpoint: aws.String(c.Endpoints[names.Route53]),
 		}
-	})
-
-	// Reference: https://github.com/hashicorp/terraform-provider-aws/issues/17996
-	client.SecurityHubConn.Handlers.Retry.PushBack(func(r *request.Request) {
-		switch r.Operation.Name {
-		case "EnableOrganizationAdminAccount":
-			if tfawserr.ErrCodeEquals(r.Error, securityhub.ErrCodeResourceConflictException) {
-				r.Retryable = aws.Bool(true)
+
+		// Force "global" services to correct regions
+		switch partition {
+		case endpoints.AwsPartitionID:
+			config.Region = aws.String(endpoints.UsEast1RegionID)
+		case endpoints.AwsCnPartitionID:
+			// The AWS Go SDK is missing endpoint information for Route 53 in the AWS China partition.
+			// This can likely be removed in the future.
+			if aws.StringValue(config.Endpoint) == "" {
+				config.Endpoint = aws.String("https://api.route53.cn")
 			}
+			config.Region = aws.String(endpoints.CnNorthwest1RegionID)
+		case endpoints.AwsUsGovPartitionID:
+			config.Region = aws.String(endpoints.UsGovWest1RegionID)
 		}
-	})
 
-	// Reference: https://github.com/hashicorp/terraform-provider-aws/issues/19215
-	client.SSOAdminConn.Handlers.Retry.PushBack(func(r *request.Request) {
-		if r.Operation.Name == "AttachManagedPolicyToPermissionSet" || r.Operation.Name == "DetachManagedPolicyFromPermissionSet" {
-			if tfawserr.ErrCodeEquals(r.Error, ssoadmin.ErrCodeConflictException) {
-				r.Retryable = aws.Bool(true)
-			}
+		return route53.New(sess.Copy(config))
+	case names.Route53RecoveryControlConfig:
+		config := &aws.Config{
+			Endpoint: aws.String(c.Endpoints[names.Route53RecoveryControlConfig]),
 		}
-	})
 
-	client.StorageGatewayConn.Handlers.Retry.PushBack(func(r *request.Request) {
-		// InvalidGatewayRequestException: The specified gateway proxy network connection is busy.
-		if tfawserr.ErrMessageContains(r.Error, storagegateway.ErrCodeInvalidGatewayRequestException, "The specified gateway proxy network connection is busy") {
-			r.Retryable = aws.Bool(true)
+		if partition == endpoints.AwsPartitionID {
+			config.Region = aws.String(endpoints.UsWest2RegionID)
 		}
-	})
 
-	client.WAFV2Conn.Handlers.Retry.PushBack(func(r *request.Request) {
-		if tfawserr.ErrMessageContains(r.Error, wafv2.ErrCodeWAFInternalErrorException, "Retry your request") {
-			r.Retryable = aws.Bool(true)
+		return route53recoverycontrolconfig.New(sess.Copy(config))
+	case names.Route53RecoveryReadiness:
+		config := &aws.Config{
+			Endpoint: aws.String(c.Endpoints[names.Route53RecoveryReadiness]),
 		}
 
-		if tfawserr.ErrMessageContains(r.Error, wafv2.ErrCodeWAFServiceLinkedRoleErrorException, "Retry") {
-			r.Retryable = aws.Bool(true)
+		if partition == endpoints.AwsPartitionID {
+			config.Region = aws.String(endpoints.UsWest2RegionID)
 		}
 
-		if r.Operation.Name == "CreateIPSet" || r.Operation.Name == "CreateRegexPatternSet" ||
-			r.Operation.Name == "CreateRuleGroup" || r.Operation.Name == "CreateWebACL" {
-			// WAFv2 supports tag on create which can result in the below error codes according to the documentation
-			if tfawserr.ErrMessageContains(r.Error, wafv2.ErrCodeWAFTagOperationException, "Retry your request") {
-				r.Retryable = aws.Bool(true)
-			}
-			if tfawserr.ErrMessageContains(err, wafv2.ErrCodeWAFTagOperationInternalErrorException, "Retry your request") {
-				r.Retryable = aws.Bool(true)
-			}
+		return route53recoveryreadiness.New(sess.Copy(config))
+	case names.Shield:
+		config := &aws.Config{
+			Endpoint: aws.String(c.Endpoints[names.Shield]),
 		}
-	})
 
-	if !c.SkipGetEC2Platforms {
-		supportedPlatforms, err := GetSupportedEC2Platforms(client.EC2Conn)
-		if err != nil {
-			// We intentionally fail *silently* because there's a chance
-			// user just doesn't have ec2:DescribeAccountAttributes permissions
-			log.Printf("[WARN] Unable to get supported EC2 platforms: %s", err)
-		} else {
-			client.SupportedPlatforms = supportedPlatforms
+		if partition == endpoints.AwsPartitionID {
+			config.Region = aws.String(endpoints.UsEast1RegionID)
 		}
+
+		return shield.New(sess.Copy(config))
 	}
 
-	return client, nil
+	return nil
 }
@@ -0,0 +1,163 @@
+package conns
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/organizations"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"golang.org/x/exp/slices"
+)
+
+// verifyAccountID returns an error diagnostic if the account ID is forbidden or not allowed.
+func (c *Config) verifyAccountID(accountID string) diag.Diagnostics {
+	for _, forbiddenAccountID := range c.ForbiddenAccountIds {
+		if accountID == forbiddenAccountID {
+			return diag.Diagnostics{guardrailDiagnostic(
+				"AWS Account ID not allowed: "+accountID,
+				fmt.Sprintf("The AWS account (%s) is listed in the provider's forbidden_account_ids.", accountID),
+			)}
+		}
+	}
+
+	if len(c.AllowedAccountIds) > 0 && !slices.Contains(c.AllowedAccountIds, accountID) {
+		return diag.Diagnostics{guardrailDiagnostic(
+			"AWS Account ID not allowed: "+accountID,
+			fmt.Sprintf("The AWS account (%s) is not listed in the provider's allowed_account_ids (%s).", accountID, strings.Join(c.AllowedAccountIds, ", ")),
+		)}
+	}
+
+	return nil
+}
+
+// verifyPartition returns an error diagnostic if the partition is not allowed.
+func (c *Config) verifyPartition(partition string) diag.Diagnostics {
+	if len(c.AllowedPartitions) > 0 && !slices.Contains(c.AllowedPartitions, partition) {
+		return diag.Diagnostics{guardrailDiagnostic(
+			"AWS partition not allowed: "+partition,
+			fmt.Sprintf("The AWS partition (%s) is not listed in the provider's allowed_partitions (%s).", partition, strings.Join(c.AllowedPartitions, ", ")),
+		)}
+	}
+
+	return nil
+}
+
+// verifyRegion returns an error if the Region is not allowed.
+func (c *Config) verifyRegion(region string) error {
+	if len(c.AllowedRegions) > 0 && !slices.Contains(c.AllowedRegions, region) {
+		return fmt.Errorf("AWS Region (%s) not allowed: the provider's allowed_regions are %s", region, strings.Join(c.AllowedRegions, ", "))
+	}
+
+	return nil
+}
+
+// verifyOrganization returns an error diagnostic if the account's AWS Organization or organizational unit path is not allowed.
+// The account's organizational unit path is only looked up if allowed_organizational_unit_paths is configured.
+func (c *Config) verifyOrganization(ctx context.Context, conn *organizations.Organizations, accountID string) diag.Diagnostics {
+	if len(c.AllowedOrganizationIDs) == 0 && len(c.AllowedOrganizationalUnitPaths) == 0 {
+		return nil
+	}
+
+	if accountID == "" {
+		return diag.Diagnostics{guardrailDiagnostic(
+			"AWS Organization cannot be verified",
+			"The AWS account ID is required to verify the provider's allowed_organization_ids and allowed_organizational_unit_paths. Do not set skip_requesting_account_id.",
+		)}
+	}
+
+	output, err := conn.DescribeOrganizationWithContext(ctx, &organizations.DescribeOrganizationInput{})
+
+	if err != nil {
+		return diag.Diagnostics{guardrailDiagnostic(
+			"AWS Organization cannot be verified",
+			fmt.Sprintf("Describing the AWS Organization of account (%s): %s", accountID, err),
+		)}
+	}
+
+	organizationID := aws.StringValue(output.Organization.Id)
+
+	if len(c.AllowedOrganizationIDs) > 0 && !slices.Contains(c.AllowedOrganizationIDs, organizationID) {
+		return diag.Diagnostics{guardrailDiagnostic(
+			"AWS Organization not allowed: "+organizationID,
+			fmt.Sprintf("The AWS account (%s) belongs to an AWS Organization (%s) that is not listed in the provider's allowed_organization_ids (%s).", accountID, organizationID, strings.Join(c.AllowedOrganizationIDs, ", ")),
+		)}
+	}
+
+	if len(c.AllowedOrganizationalUnitPaths) == 0 {
+		return nil
+	}
+
+	path, err := findOrganizationalUnitPath(ctx, conn, organizationID, accountID)
+
+	if err != nil {
+		return diag.Diagnostics{guardrailDiagnostic(
+			"AWS Organization cannot be verified",
+			fmt.Sprintf("Reading the organizational unit path of account (%s): %s", accountID, err),
+		)}
+	}
+
+	if !organizationalUnitPathAllowed(path, c.AllowedOrganizationalUnitPaths) {
+		return diag.Diagnostics{guardrailDiagnostic(
+			"AWS organizational unit path not allowed: "+path,
+			fmt.Sprintf("The AWS account (%s) is in an organizational unit path (%s) that does not match any of the provider's allowed_organizational_unit_paths (%s).", accountID, path, strings.Join(c.AllowedOrganizationalUnitPaths, ", ")),
+		)}
+	}
+
+	return nil
+}
+
+// findOrganizationalUnitPath returns the account's organizational unit path,
+// e.g. `o-a1b2c3d4e5/r-ab12/ou-ab12-11111111/ou-ab12-22222222/`.
+func findOrganizationalUnitPath(ctx context.Context, conn *organizations.Organizations, organizationID, accountID string) (string, error) {
+	var ids []string
+
+	for childID := accountID; ; {
+		output, err := conn.ListParentsWithContext(ctx, &organizations.ListParentsInput{
+			ChildId: aws.String(childID),
+		})
+
+		if err != nil {
+			return "", err
+		}
+
+		if len(output.Parents) == 0 || output.Parents[0] == nil {
+			return "", fmt.Errorf("%s has no parent", childID)
+		}
+
+		parent := output.Parents[0]
+		ids = append([]string{aws.StringValue(parent.Id)}, ids...)
+
+		if aws.StringValue(parent.Type) == organizations.ParentTypeRoot {
+			break
+		}
+
+		childID = aws.StringValue(parent.Id)
+	}
+
+	return organizationID + "/" + strings.Join(ids, "/") + "/", nil
+}
+
+// organizationalUnitPathAllowed returns whether the organizational unit path is equal to, or is nested beneath, any of the allowed paths.
+func organizationalUnitPathAllowed(path string, allowedPaths []string) bool {
+	for _, allowedPath := range allowedPaths {
+		if !strings.HasSuffix(allowedPath, "/") {
+			allowedPath += "/"
+		}
+
+		if strings.HasPrefix(path, allowedPath) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func guardrailDiagnostic(summary, detail string) diag.Diagnostic {
+	return diag.Diagnostic{
+		Severity: diag.Error,
+		Summary:  summary,
+		Detail:   detail + " No AWS resources have been read or modified.",
+	}
+}
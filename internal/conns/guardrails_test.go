@@ -0,0 +1,183 @@
+package conns
+
+import (
+	"testing"
+)
+
+func TestConfigVerifyAccountID(t *testing.T) {
+	testCases := []struct {
+		Name        string
+		Config      *Config
+		AccountID   string
+		ExpectError bool
+	}{
+		{
+			Name:      "no restrictions",
+			Config:    &Config{},
+			AccountID: "123456789012",
+		},
+		{
+			Name:        "forbidden",
+			Config:      &Config{ForbiddenAccountIds: []string{"123456789012"}},
+			AccountID:   "123456789012",
+			ExpectError: true,
+		},
+		{
+			Name:      "not forbidden",
+			Config:    &Config{ForbiddenAccountIds: []string{"123456789012"}},
+			AccountID: "210987654321",
+		},
+		{
+			Name:      "allowed",
+			Config:    &Config{AllowedAccountIds: []string{"123456789012", "210987654321"}},
+			AccountID: "210987654321",
+		},
+		{
+			Name:        "not allowed",
+			Config:      &Config{AllowedAccountIds: []string{"123456789012"}},
+			AccountID:   "210987654321",
+			ExpectError: true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			diags := testCase.Config.verifyAccountID(testCase.AccountID)
+
+			if got, expected := diags.HasError(), testCase.ExpectError; got != expected {
+				t.Errorf("got error %t, expected %t: %v", got, expected, diags)
+			}
+		})
+	}
+}
+
+func TestConfigVerifyPartition(t *testing.T) {
+	testCases := []struct {
+		Name        string
+		Config      *Config
+		Partition   string
+		ExpectError bool
+	}{
+		{
+			Name:      "no restrictions",
+			Config:    &Config{},
+			Partition: "aws",
+		},
+		{
+			Name:      "allowed",
+			Config:    &Config{AllowedPartitions: []string{"aws", "aws-us-gov"}},
+			Partition: "aws-us-gov",
+		},
+		{
+			Name:        "not allowed",
+			Config:      &Config{AllowedPartitions: []string{"aws-us-gov"}},
+			Partition:   "aws",
+			ExpectError: true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			diags := testCase.Config.verifyPartition(testCase.Partition)
+
+			if got, expected := diags.HasError(), testCase.ExpectError; got != expected {
+				t.Errorf("got error %t, expected %t: %v", got, expected, diags)
+			}
+		})
+	}
+}
+
+func TestConfigVerifyRegion(t *testing.T) {
+	testCases := []struct {
+		Name        string
+		Config      *Config
+		Region      string
+		ExpectError bool
+	}{
+		{
+			Name:   "no restrictions",
+			Config: &Config{},
+			Region: "us-west-2", //lintignore:AWSAT003
+		},
+		{
+			Name:   "allowed",
+			Config: &Config{AllowedRegions: []string{"us-east-1", "us-west-2"}}, //lintignore:AWSAT003
+			Region: "us-west-2",                                                 //lintignore:AWSAT003
+		},
+		{
+			Name:        "not allowed",
+			Config:      &Config{AllowedRegions: []string{"us-east-1"}}, //lintignore:AWSAT003
+			Region:      "eu-west-1",                                    //lintignore:AWSAT003
+			ExpectError: true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			err := testCase.Config.verifyRegion(testCase.Region)
+
+			if got, expected := err != nil, testCase.ExpectError; got != expected {
+				t.Errorf("got error %v, expected error %t", err, expected)
+			}
+		})
+	}
+}
+
+func TestOrganizationalUnitPathAllowed(t *testing.T) {
+	const path = "o-a1b2c3d4e5/r-ab12/ou-ab12-11111111/ou-ab12-22222222/"
+
+	testCases := []struct {
+		Name         string
+		AllowedPaths []string
+		Expected     bool
+	}{
+		{
+			Name:         "exact",
+			AllowedPaths: []string{path},
+			Expected:     true,
+		},
+		{
+			Name:         "parent",
+			AllowedPaths: []string{"o-a1b2c3d4e5/r-ab12/ou-ab12-11111111/"},
+			Expected:     true,
+		},
+		{
+			Name:         "parent without trailing separator",
+			AllowedPaths: []string{"o-a1b2c3d4e5/r-ab12/ou-ab12-11111111"},
+			Expected:     true,
+		},
+		{
+			Name:         "organization",
+			AllowedPaths: []string{"o-a1b2c3d4e5/"},
+			Expected:     true,
+		},
+		{
+			Name:         "sibling",
+			AllowedPaths: []string{"o-a1b2c3d4e5/r-ab12/ou-ab12-33333333/"},
+			Expected:     false,
+		},
+		{
+			Name:         "partial ID",
+			AllowedPaths: []string{"o-a1b2c3d4e5/r-ab12/ou-ab12-1111"},
+			Expected:     false,
+		},
+		{
+			Name:         "child",
+			AllowedPaths: []string{path + "ou-ab12-44444444/"},
+			Expected:     false,
+		},
+		{
+			Name:         "multiple",
+			AllowedPaths: []string{"o-zzzzzzzzzz/", "o-a1b2c3d4e5/r-ab12/"},
+			Expected:     true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			if got := organizationalUnitPathAllowed(path, testCase.AllowedPaths); got != testCase.Expected {
+				t.Errorf("got %t, expected %t", got, testCase.Expected)
+			}
+		})
+	}
+}
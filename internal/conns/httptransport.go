@@ -0,0 +1,64 @@
+package conns
+
+import (
+	"context"
+	"net/http"
+
+	awsv2 "github.com/aws/aws-sdk-go-v2/aws"
+)
+
+// HTTPTransportFunc wraps the HTTP transport used by AWS SDK clients, for example to record or replay API requests.
+type HTTPTransportFunc func(http.RoundTripper) http.RoundTripper
+
+type httpTransportContextKey struct{}
+
+// NewHTTPTransportContext returns a copy of ctx that carries an HTTP transport wrapper for provider configuration.
+func NewHTTPTransportContext(ctx context.Context, f HTTPTransportFunc) context.Context {
+	return context.WithValue(ctx, httpTransportContextKey{}, f)
+}
+
+// HTTPTransportFromContext returns the HTTP transport wrapper carried by ctx, if any.
+func HTTPTransportFromContext(ctx context.Context) HTTPTransportFunc {
+	f, _ := ctx.Value(httpTransportContextKey{}).(HTTPTransportFunc)
+
+	return f
+}
+
+// wrapHTTPClientV1 returns a copy of the AWS SDK for Go v1 HTTP client with its transport wrapped.
+func wrapHTTPClientV1(client *http.Client, f HTTPTransportFunc) *http.Client {
+	if client == nil {
+		client = http.DefaultClient
+	}
+
+	wrapped := *client
+	transport := client.Transport
+
+	if transport == nil {
+		transport = http.DefaultTransport
+	}
+
+	wrapped.Transport = f(transport)
+
+	return &wrapped
+}
+
+// wrapHTTPClientV2 returns an AWS SDK for Go v2 HTTP client that sends requests using the wrapped client.
+func wrapHTTPClientV2(client awsv2.HTTPClient, f HTTPTransportFunc) awsv2.HTTPClient {
+	return &httpClientV2{
+		transport: f(roundTripperFunc(client.Do)),
+	}
+}
+
+type httpClientV2 struct {
+	transport http.RoundTripper
+}
+
+func (c *httpClientV2) Do(req *http.Request) (*http.Response, error) {
+	return c.transport.RoundTrip(req)
+}
+
+type roundTripperFunc func(*http.Request) (*http.Response, error)
+
+func (f roundTripperFunc) RoundTrip(req *http.Request) (*http.Response, error) {
+	return f(req)
+}
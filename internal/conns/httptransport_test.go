@@ -0,0 +1,69 @@
+package conns
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/feature/ec2/imds"
+	"github.com/aws/aws-sdk-go/service/sts"
+)
+
+func TestConfigureProviderHTTPTransport(t *testing.T) {
+	errTransport := errors.New("test transport")
+
+	var (
+		mu    sync.Mutex
+		hosts = make(map[string]bool)
+	)
+
+	config := &Config{
+		AccessKey:                     "mock_access_key",
+		EC2MetadataServiceEnableState: imds.ClientDisabled,
+		Region:                        "us-west-2",
+		SecretKey:                     "mock_secret_key",
+		SkipCredsValidation:           true,
+		SkipGetEC2Platforms:           true,
+		SkipRequestingAccountId:       true,
+		HTTPTransport: func(next http.RoundTripper) http.RoundTripper {
+			return roundTripperFunc(func(req *http.Request) (*http.Response, error) {
+				mu.Lock()
+				hosts[req.URL.Host] = true
+				mu.Unlock()
+
+				return nil, errTransport
+			})
+		},
+	}
+
+	client, diags := config.ConfigureProvider(context.Background(), &AWSClient{})
+
+	if diags.HasError() {
+		t.Fatalf("configuring provider: %v", diags)
+	}
+
+	// AWS SDK for Go v1.
+	if _, err := client.STSConn().GetCallerIdentity(&sts.GetCallerIdentityInput{}); err == nil || !strings.Contains(err.Error(), errTransport.Error()) {
+		t.Errorf("AWS SDK for Go v1: got error %v, expected %s", err, errTransport)
+	}
+
+	// AWS SDK for Go v2.
+	req, err := http.NewRequest(http.MethodGet, "https://example.us-west-2.amazonaws.com/", nil)
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := client.Config.HTTPClient.Do(req); !errors.Is(err, errTransport) {
+		t.Errorf("AWS SDK for Go v2: got error %v, expected %s", err, errTransport)
+	}
+
+	for _, host := range []string{"sts.amazonaws.com", "example.us-west-2.amazonaws.com"} {
+		if !hosts[host] {
+			t.Errorf("no request to %s sent using HTTP transport", host)
+		}
+	}
+}
@@ -0,0 +1,380 @@
+package conns
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"math"
+	"sort"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws/retry"
+	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/smithy-go/middleware"
+)
+
+const (
+	// RateLimitModeAdaptive paces requests at a rate that is reduced each time a request is throttled
+	// and slowly increased again as requests succeed.
+	RateLimitModeAdaptive = "adaptive"
+	// RateLimitModeNone disables client-side rate limiting.
+	RateLimitModeNone = "none"
+	// RateLimitModeTokenBucket paces requests using a token bucket with a fixed fill rate.
+	RateLimitModeTokenBucket = "token_bucket"
+)
+
+func RateLimitMode_Values() []string {
+	return []string{
+		RateLimitModeAdaptive,
+		RateLimitModeNone,
+		RateLimitModeTokenBucket,
+	}
+}
+
+const (
+	// adaptiveBackoffFactor is the factor by which the adaptive request rate is reduced when a request is throttled.
+	adaptiveBackoffFactor = 0.7
+	// adaptiveIncrement is the amount (in requests per second) by which the adaptive request rate is increased when a request succeeds.
+	adaptiveIncrement = 0.1
+	// adaptiveMinRate is the minimum adaptive request rate (in requests per second).
+	adaptiveMinRate = 0.5
+)
+
+// RateLimit configures client-side rate limiting of API requests.
+type RateLimit struct {
+	// Mode is one of the RateLimitMode* values.
+	Mode string
+
+	// RequestsPerSecond is the token bucket fill rate.
+	// In adaptive mode it is the maximum request rate; zero means that requests are not paced until one is throttled.
+	RequestsPerSecond float64
+
+	// Burst is the maximum number of requests that can be sent without pacing.
+	// Defaults to RequestsPerSecond, rounded up.
+	Burst int
+}
+
+// RateLimitConfig configures client-side rate limiting of API requests for all services.
+// Services may override any of the provider-level settings.
+type RateLimitConfig struct {
+	RateLimit
+	Services map[string]RateLimit
+}
+
+// ForService returns the effective rate limit settings for the specified service.
+func (c *RateLimitConfig) ForService(serviceName string) RateLimit {
+	if c == nil {
+		return RateLimit{}
+	}
+
+	rl := c.RateLimit
+
+	if v, ok := c.Services[serviceName]; ok {
+		if v.Mode != "" {
+			rl.Mode = v.Mode
+		}
+		if v.RequestsPerSecond > 0 {
+			rl.RequestsPerSecond = v.RequestsPerSecond
+		}
+		if v.Burst > 0 {
+			rl.Burst = v.Burst
+		}
+	}
+
+	return rl
+}
+
+// Validate returns an error if the rate limit settings cannot be used.
+func (rl RateLimit) Validate() error {
+	if rl.Mode == RateLimitModeTokenBucket && rl.RequestsPerSecond <= 0 {
+		return fmt.Errorf("requests_per_second must be set when mode is %q", RateLimitModeTokenBucket)
+	}
+
+	return nil
+}
+
+// rateLimiter paces the API requests made by a single service's clients and counts throttled requests.
+// Requests are not paced unless rate limiting is configured for the service.
+type rateLimiter struct {
+	region      string
+	serviceName string
+
+	adaptive bool
+	burst    float64
+	enabled  bool
+	maxRate  float64
+	rate     float64
+	tokens   float64
+	last     time.Time
+
+	measuredRate float64
+	windowCount  int
+	windowStart  time.Time
+
+	requests  int64
+	throttles int64
+	summary   *throttlingCounts
+
+	mu  sync.Mutex
+	now func() time.Time
+}
+
+func newRateLimiter(region, serviceName string, rl RateLimit) *rateLimiter {
+	l := &rateLimiter{
+		region:      region,
+		serviceName: serviceName,
+		now:         time.Now,
+	}
+
+	if rl.Mode == "" || rl.Mode == RateLimitModeNone {
+		return l
+	}
+
+	l.adaptive = rl.Mode == RateLimitModeAdaptive
+	l.maxRate = rl.RequestsPerSecond
+	l.burst = float64(rl.Burst)
+	if l.burst <= 0 {
+		l.burst = math.Max(1, math.Ceil(rl.RequestsPerSecond))
+	}
+
+	// Adaptive rate limiting without a maximum rate only starts pacing requests once one is throttled.
+	if l.maxRate > 0 {
+		l.enabled = true
+		l.rate = l.maxRate
+		l.tokens = l.burst
+	}
+
+	return l
+}
+
+// reserve takes a token from the bucket, returning how long to wait before retrying if none is available.
+func (l *rateLimiter) reserve() time.Duration {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	now := l.now()
+
+	if l.enabled {
+		l.tokens = math.Min(l.burst, l.tokens+now.Sub(l.last).Seconds()*l.rate)
+		l.last = now
+
+		if l.tokens < 1 {
+			return time.Duration((1 - l.tokens) / l.rate * float64(time.Second))
+		}
+
+		l.tokens--
+	}
+
+	l.requests++
+	if l.summary != nil {
+		atomic.AddInt64(&l.summary.requests, 1)
+	}
+
+	if l.windowStart.IsZero() {
+		l.windowStart = now
+	}
+	l.windowCount++
+	if elapsed := now.Sub(l.windowStart); elapsed >= time.Second {
+		l.measuredRate = float64(l.windowCount) / elapsed.Seconds()
+		l.windowCount = 0
+		l.windowStart = now
+	}
+
+	return 0
+}
+
+// wait blocks until a request can be sent or the context is done.
+func (l *rateLimiter) wait(ctx context.Context) error {
+	for {
+		d := l.reserve()
+
+		if d == 0 {
+			return nil
+		}
+
+		timer := time.NewTimer(d)
+
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return ctx.Err()
+		case <-timer.C:
+		}
+	}
+}
+
+// attemptComplete records the outcome of a request attempt.
+func (l *rateLimiter) attemptComplete(success, throttled bool) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	if throttled {
+		l.throttles++
+		if l.summary != nil {
+			atomic.AddInt64(&l.summary.throttles, 1)
+		}
+		log.Printf("[DEBUG] %s API request throttled in Region (%s) (%d throttled requests)", l.serviceName, l.region, l.throttles)
+
+		if !l.adaptive {
+			return
+		}
+
+		rate := l.rate
+		if !l.enabled {
+			rate = l.measuredRate
+			if rate == 0 {
+				rate = l.burst
+			}
+			l.enabled = true
+			l.tokens = 0
+			l.last = l.now()
+		}
+
+		l.rate = math.Max(adaptiveMinRate, rate*adaptiveBackoffFactor)
+		l.burst = math.Max(1, math.Min(l.burst, math.Ceil(l.rate)))
+
+		log.Printf("[DEBUG] %s API request rate in Region (%s) reduced to %.2f requests per second", l.serviceName, l.region, l.rate)
+
+		return
+	}
+
+	if success && l.adaptive && l.enabled {
+		l.rate += adaptiveIncrement
+		if l.maxRate > 0 {
+			l.rate = math.Min(l.rate, l.maxRate)
+		}
+	}
+}
+
+func (l *rateLimiter) stats() (int64, int64) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	return l.requests, l.throttles
+}
+
+// signHandlerV1 returns an AWS SDK for Go v1 Sign handler that paces requests.
+func (l *rateLimiter) signHandlerV1() request.NamedHandler {
+	return request.NamedHandler{
+		Name: "terraform-provider-aws.RateLimiter",
+		Fn: func(r *request.Request) {
+			// Don't pace presigned requests.
+			if r.ExpireTime > 0 {
+				return
+			}
+
+			if err := l.wait(r.Context()); err != nil {
+				r.Error = err
+			}
+		},
+	}
+}
+
+// completeAttemptHandlerV1 returns an AWS SDK for Go v1 CompleteAttempt handler that records throttled requests.
+func (l *rateLimiter) completeAttemptHandlerV1() request.NamedHandler {
+	return request.NamedHandler{
+		Name: "terraform-provider-aws.RateLimiterCompleteAttempt",
+		Fn: func(r *request.Request) {
+			l.attemptComplete(r.Error == nil, r.Error != nil && r.IsErrorThrottle())
+		},
+	}
+}
+
+// middlewareV2 returns an AWS SDK for Go v2 API option that paces requests and records throttled requests.
+func (l *rateLimiter) middlewareV2() func(*middleware.Stack) error {
+	return func(stack *middleware.Stack) error {
+		return stack.Finalize.Insert(rateLimiterMiddleware{limiter: l}, "Retry", middleware.After)
+	}
+}
+
+var isErrorThrottle = retry.IsErrorThrottles(retry.DefaultThrottles)
+
+// rateLimiterMiddleware runs once per attempt.
+type rateLimiterMiddleware struct {
+	limiter *rateLimiter
+}
+
+func (rateLimiterMiddleware) ID() string {
+	return "TerraformProviderAWSRateLimiter"
+}
+
+func (m rateLimiterMiddleware) HandleFinalize(ctx context.Context, in middleware.FinalizeInput, next middleware.FinalizeHandler) (middleware.FinalizeOutput, middleware.Metadata, error) {
+	if err := m.limiter.wait(ctx); err != nil {
+		return middleware.FinalizeOutput{}, middleware.Metadata{}, err
+	}
+
+	out, metadata, err := next.HandleFinalize(ctx, in)
+
+	m.limiter.attemptComplete(err == nil, err != nil && isErrorThrottle.IsErrorThrottle(err).Bool())
+
+	return out, metadata, err
+}
+
+// throttlingSummary holds the number of API requests and throttled API requests made by each service in each Region.
+// Counts are keyed by service and Region, rather than by rate limiter, so that the summary stays bounded
+// however many times the provider is configured.
+var throttlingSummary = struct {
+	counts map[throttlingSummaryKey]*throttlingCounts
+	mu     sync.Mutex
+}{
+	counts: make(map[throttlingSummaryKey]*throttlingCounts),
+}
+
+type throttlingSummaryKey struct {
+	serviceName string
+	region      string
+}
+
+type throttlingCounts struct {
+	requests  int64
+	throttles int64
+}
+
+// registerRateLimiter adds the rate limiter's requests and throttled requests to the throttling summary.
+func registerRateLimiter(l *rateLimiter) {
+	throttlingSummary.mu.Lock()
+	defer throttlingSummary.mu.Unlock()
+
+	k := throttlingSummaryKey{serviceName: l.serviceName, region: l.region}
+
+	counts, ok := throttlingSummary.counts[k]
+	if !ok {
+		counts = &throttlingCounts{}
+		throttlingSummary.counts[k] = counts
+	}
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	l.summary = counts
+}
+
+// LogThrottlingSummary logs the number of API requests and throttled API requests made by each service in each Region.
+func LogThrottlingSummary() {
+	throttlingSummary.mu.Lock()
+	defer throttlingSummary.mu.Unlock()
+
+	var keys []throttlingSummaryKey
+
+	for k, v := range throttlingSummary.counts {
+		if atomic.LoadInt64(&v.requests) == 0 {
+			continue
+		}
+
+		keys = append(keys, k)
+	}
+
+	sort.Slice(keys, func(i, j int) bool {
+		if keys[i].serviceName == keys[j].serviceName {
+			return keys[i].region < keys[j].region
+		}
+		return keys[i].serviceName < keys[j].serviceName
+	})
+
+	for _, k := range keys {
+		v := throttlingSummary.counts[k]
+		log.Printf("[DEBUG] %s API requests in Region (%s): %d, throttled: %d", k.serviceName, k.region, atomic.LoadInt64(&v.requests), atomic.LoadInt64(&v.throttles))
+	}
+}
@@ -0,0 +1,261 @@
+package conns
+
+import (
+	"context"
+	"testing"
+	"time"
+)
+
+func TestRateLimitConfigForService(t *testing.T) {
+	config := &RateLimitConfig{
+		RateLimit: RateLimit{
+			Mode:              RateLimitModeTokenBucket,
+			RequestsPerSecond: 10,
+			Burst:             20,
+		},
+		Services: map[string]RateLimit{
+			"ec2":     {RequestsPerSecond: 5},
+			"route53": {Mode: RateLimitModeAdaptive, Burst: 2},
+			"s3":      {Mode: RateLimitModeNone},
+		},
+	}
+
+	testCases := []struct {
+		ServiceName string
+		Config      *RateLimitConfig
+		Expected    RateLimit
+	}{
+		{
+			ServiceName: "ec2",
+			Config:      config,
+			Expected:    RateLimit{Mode: RateLimitModeTokenBucket, RequestsPerSecond: 5, Burst: 20},
+		},
+		{
+			ServiceName: "route53",
+			Config:      config,
+			Expected:    RateLimit{Mode: RateLimitModeAdaptive, RequestsPerSecond: 10, Burst: 2},
+		},
+		{
+			ServiceName: "s3",
+			Config:      config,
+			Expected:    RateLimit{Mode: RateLimitModeNone, RequestsPerSecond: 10, Burst: 20},
+		},
+		{
+			ServiceName: "iam",
+			Config:      config,
+			Expected:    RateLimit{Mode: RateLimitModeTokenBucket, RequestsPerSecond: 10, Burst: 20},
+		},
+		{
+			ServiceName: "iam",
+			Expected:    RateLimit{},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.ServiceName, func(t *testing.T) {
+			if got := testCase.Config.ForService(testCase.ServiceName); got != testCase.Expected {
+				t.Errorf("got %+v, expected %+v", got, testCase.Expected)
+			}
+		})
+	}
+}
+
+func TestRateLimitValidate(t *testing.T) {
+	testCases := []struct {
+		Name        string
+		RateLimit   RateLimit
+		ExpectError bool
+	}{
+		{
+			Name:      "empty",
+			RateLimit: RateLimit{},
+		},
+		{
+			Name:      "adaptive without rate",
+			RateLimit: RateLimit{Mode: RateLimitModeAdaptive},
+		},
+		{
+			Name:        "token bucket without rate",
+			RateLimit:   RateLimit{Mode: RateLimitModeTokenBucket},
+			ExpectError: true,
+		},
+		{
+			Name:      "token bucket",
+			RateLimit: RateLimit{Mode: RateLimitModeTokenBucket, RequestsPerSecond: 1},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			err := testCase.RateLimit.Validate()
+
+			if got, expected := err != nil, testCase.ExpectError; got != expected {
+				t.Errorf("got error %v, expected error %t", err, expected)
+			}
+		})
+	}
+}
+
+type fakeClock struct {
+	now time.Time
+}
+
+func (c *fakeClock) Now() time.Time {
+	return c.now
+}
+
+func (c *fakeClock) Advance(d time.Duration) {
+	c.now = c.now.Add(d)
+}
+
+func TestRateLimiterTokenBucket(t *testing.T) {
+	clock := &fakeClock{now: time.Unix(0, 0)}
+	l := newRateLimiter("us-west-2", "ec2", RateLimit{Mode: RateLimitModeTokenBucket, RequestsPerSecond: 2, Burst: 2}) //lintignore:AWSAT003
+	l.now = clock.Now
+
+	for i := 0; i < 2; i++ {
+		if d := l.reserve(); d != 0 {
+			t.Fatalf("request %d: got delay %s, expected none", i, d)
+		}
+	}
+
+	if got, expected := l.reserve(), 500*time.Millisecond; got != expected {
+		t.Errorf("got delay %s, expected %s", got, expected)
+	}
+
+	clock.Advance(500 * time.Millisecond)
+
+	if d := l.reserve(); d != 0 {
+		t.Errorf("got delay %s, expected none", d)
+	}
+
+	if requests, _ := l.stats(); requests != 3 {
+		t.Errorf("got %d requests, expected 3", requests)
+	}
+}
+
+func TestRateLimiterNone(t *testing.T) {
+	l := newRateLimiter("us-west-2", "ec2", RateLimit{}) //lintignore:AWSAT003
+
+	for i := 0; i < 100; i++ {
+		if d := l.reserve(); d != 0 {
+			t.Fatalf("request %d: got delay %s, expected none", i, d)
+		}
+	}
+
+	l.attemptComplete(false, true)
+
+	if d := l.reserve(); d != 0 {
+		t.Errorf("got delay %s, expected none", d)
+	}
+
+	if _, throttles := l.stats(); throttles != 1 {
+		t.Errorf("got %d throttles, expected 1", throttles)
+	}
+}
+
+func TestRateLimiterAdaptive(t *testing.T) {
+	clock := &fakeClock{now: time.Unix(0, 0)}
+	l := newRateLimiter("us-west-2", "ec2", RateLimit{Mode: RateLimitModeAdaptive, RequestsPerSecond: 10}) //lintignore:AWSAT003
+	l.now = clock.Now
+
+	l.attemptComplete(false, true)
+
+	if got, expected := l.rate, 7.0; got != expected {
+		t.Errorf("got rate %f, expected %f", got, expected)
+	}
+
+	for i := 0; i < 100; i++ {
+		l.attemptComplete(true, false)
+	}
+
+	if got, expected := l.rate, 10.0; got != expected {
+		t.Errorf("got rate %f, expected %f", got, expected)
+	}
+
+	for i := 0; i < 100; i++ {
+		l.attemptComplete(false, true)
+	}
+
+	if got, expected := l.rate, adaptiveMinRate; got != expected {
+		t.Errorf("got rate %f, expected %f", got, expected)
+	}
+}
+
+func TestRateLimiterAdaptiveUnbounded(t *testing.T) {
+	clock := &fakeClock{now: time.Unix(0, 0)}
+	l := newRateLimiter("us-west-2", "ec2", RateLimit{Mode: RateLimitModeAdaptive}) //lintignore:AWSAT003
+	l.now = clock.Now
+
+	// 21 requests in 1 second.
+	for i := 0; i < 21; i++ {
+		if d := l.reserve(); d != 0 {
+			t.Fatalf("request %d: got delay %s, expected none", i, d)
+		}
+		clock.Advance(50 * time.Millisecond)
+	}
+
+	l.attemptComplete(false, true)
+
+	if !l.enabled {
+		t.Fatal("expected rate limiting to be enabled")
+	}
+
+	if got, expected := l.rate, 21*adaptiveBackoffFactor; got != expected {
+		t.Errorf("got rate %f, expected %f", got, expected)
+	}
+
+	if d := l.reserve(); d == 0 {
+		t.Error("expected delay")
+	}
+}
+
+func TestRateLimiterWaitCanceled(t *testing.T) {
+	l := newRateLimiter("us-west-2", "ec2", RateLimit{Mode: RateLimitModeTokenBucket, RequestsPerSecond: 0.001, Burst: 1}) //lintignore:AWSAT003
+
+	ctx, cancel := context.WithCancel(context.Background())
+
+	if err := l.wait(ctx); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	cancel()
+
+	if err := l.wait(ctx); err == nil {
+		t.Error("expected error")
+	}
+}
+
+func TestRegisterRateLimiter(t *testing.T) {
+	for i := 0; i < 3; i++ {
+		l := newRateLimiter("us-west-2", "testservice", RateLimit{}) //lintignore:AWSAT003
+		registerRateLimiter(l)
+
+		l.reserve()
+		l.attemptComplete(false, true)
+	}
+
+	throttlingSummary.mu.Lock()
+	defer throttlingSummary.mu.Unlock()
+
+	n := 0
+	for k := range throttlingSummary.counts {
+		if k.serviceName == "testservice" {
+			n++
+		}
+	}
+
+	if n != 1 {
+		t.Fatalf("got %d summary entries, expected 1", n)
+	}
+
+	counts := throttlingSummary.counts[throttlingSummaryKey{serviceName: "testservice", region: "us-west-2"}] //lintignore:AWSAT003
+
+	if got, expected := counts.requests, int64(3); got != expected {
+		t.Errorf("got %d requests, expected %d", got, expected)
+	}
+
+	if got, expected := counts.throttles, int64(3); got != expected {
+		t.Errorf("got %d throttles, expected %d", got, expected)
+	}
+}
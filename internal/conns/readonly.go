@@ -0,0 +1,76 @@
+package conns
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	awsmiddleware "github.com/aws/aws-sdk-go-v2/aws/middleware"
+	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/smithy-go/middleware"
+)
+
+// ErrReadOnlyOperation is returned by a read-only client for any API operation that may modify resources.
+var ErrReadOnlyOperation = errors.New("operation not permitted by read-only client")
+
+// readOnlyOperationPrefixes are the prefixes of API operation names that don't modify resources.
+var readOnlyOperationPrefixes = []string{
+	"BatchGet",
+	"Describe",
+	"Get",
+	"Head",
+	"List",
+	"Lookup",
+	"Query",
+	"Scan",
+	"Search",
+}
+
+// isReadOnlyOperation returns whether the named API operation doesn't modify resources.
+func isReadOnlyOperation(name string) bool {
+	for _, prefix := range readOnlyOperationPrefixes {
+		if strings.HasPrefix(name, prefix) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func readOnlyOperationError(name string) error {
+	return fmt.Errorf("%s: %w", name, ErrReadOnlyOperation)
+}
+
+// readOnlyHandlerV1 returns an AWS SDK for Go v1 Validate handler that fails requests for operations that may modify resources.
+func readOnlyHandlerV1() request.NamedHandler {
+	return request.NamedHandler{
+		Name: "terraform-provider-aws.ReadOnly",
+		Fn: func(r *request.Request) {
+			if name := r.Operation.Name; !isReadOnlyOperation(name) {
+				r.Error = readOnlyOperationError(name)
+			}
+		},
+	}
+}
+
+// readOnlyMiddlewareV2 returns an AWS SDK for Go v2 API option that fails requests for operations that may modify resources.
+func readOnlyMiddlewareV2() func(*middleware.Stack) error {
+	return func(stack *middleware.Stack) error {
+		return stack.Initialize.Add(readOnlyMiddleware{}, middleware.Before)
+	}
+}
+
+type readOnlyMiddleware struct{}
+
+func (readOnlyMiddleware) ID() string {
+	return "TerraformProviderAWSReadOnly"
+}
+
+func (readOnlyMiddleware) HandleInitialize(ctx context.Context, in middleware.InitializeInput, next middleware.InitializeHandler) (middleware.InitializeOutput, middleware.Metadata, error) {
+	if name := awsmiddleware.GetOperationName(ctx); !isReadOnlyOperation(name) {
+		return middleware.InitializeOutput{}, middleware.Metadata{}, readOnlyOperationError(name)
+	}
+
+	return next.HandleInitialize(ctx, in)
+}
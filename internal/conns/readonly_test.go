@@ -0,0 +1,40 @@
+package conns
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws/request"
+)
+
+func TestReadOnlyHandlerV1(t *testing.T) {
+	testCases := []struct {
+		Operation     string
+		ExpectedError bool
+	}{
+		{Operation: "DescribeVpcs"},
+		{Operation: "GetBucketPolicy"},
+		{Operation: "ListTagsForResource"},
+		{Operation: "BatchGetItem"},
+		{Operation: "CreateVpc", ExpectedError: true},
+		{Operation: "DeleteVpc", ExpectedError: true},
+		{Operation: "TerminateInstances", ExpectedError: true},
+		{Operation: "PutBucketPolicy", ExpectedError: true},
+	}
+
+	handler := readOnlyHandlerV1()
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Operation, func(t *testing.T) {
+			r := &request.Request{
+				Operation: &request.Operation{Name: testCase.Operation},
+			}
+
+			handler.Fn(r)
+
+			if got := errors.Is(r.Error, ErrReadOnlyOperation); got != testCase.ExpectedError {
+				t.Errorf("got error %v, expected error: %t", r.Error, testCase.ExpectedError)
+			}
+		})
+	}
+}
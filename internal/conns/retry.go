@@ -0,0 +1,188 @@
+package conns
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"sync"
+
+	awsmiddleware "github.com/aws/aws-sdk-go-v2/aws/middleware"
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/smithy-go"
+	"github.com/aws/smithy-go/middleware"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+)
+
+// RetryRule describes an API error that a service client retries in addition to the AWS SDK's defaults.
+// Rules are applied to both AWS SDK for Go v1 and v2 service clients.
+type RetryRule struct {
+	// Operations restricts the rule to the named API operations.
+	Operations []string
+
+	// OperationPrefixes restricts the rule to API operations whose names begin with any of the prefixes.
+	OperationPrefixes []string
+
+	// ErrorCode is the API error code to retry.
+	ErrorCode string
+
+	// ErrorMessage, if set, must be contained in the API error message.
+	ErrorMessage string
+
+	// MaxAttempts, if set, caps the total number of attempts (including the first) made for errors matching the rule.
+	// Once the cap is reached the error is no longer retried, even if the AWS SDK would otherwise retry it.
+	MaxAttempts int
+}
+
+var retryRules = struct {
+	rules map[string][]RetryRule
+	mu    sync.RWMutex
+}{
+	rules: make(map[string][]RetryRule),
+}
+
+// RegisterRetryRules registers additional retry rules for the specified service.
+// Rules are registered by service packages during initialization and
+// are applied to each service client when it is created.
+func RegisterRetryRules(serviceName string, rules ...RetryRule) {
+	retryRules.mu.Lock()
+	defer retryRules.mu.Unlock()
+
+	retryRules.rules[serviceName] = append(retryRules.rules[serviceName], rules...)
+}
+
+// serviceRetryRules returns the retry rules registered for the specified service.
+func serviceRetryRules(serviceName string) []RetryRule {
+	retryRules.mu.RLock()
+	defer retryRules.mu.RUnlock()
+
+	return retryRules.rules[serviceName]
+}
+
+func (rule RetryRule) matchesOperation(operation string) bool {
+	if len(rule.Operations) == 0 && len(rule.OperationPrefixes) == 0 {
+		return true
+	}
+
+	for _, v := range rule.Operations {
+		if v == operation {
+			return true
+		}
+	}
+
+	for _, v := range rule.OperationPrefixes {
+		if strings.HasPrefix(operation, v) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// retryable returns whether or not an error matching the rule should be retried after the specified attempt (1-based).
+func (rule RetryRule) retryable(attempt int) bool {
+	return rule.MaxAttempts <= 0 || attempt < rule.MaxAttempts
+}
+
+// matchRetryRule returns the first rule matching the specified operation and error.
+func matchRetryRule(rules []RetryRule, operation string, match func(code, message string) bool) (RetryRule, bool) {
+	for _, rule := range rules {
+		if rule.matchesOperation(operation) && match(rule.ErrorCode, rule.ErrorMessage) {
+			return rule, true
+		}
+	}
+
+	return RetryRule{}, false
+}
+
+// retryHandlerV1 returns an AWS SDK for Go v1 Retry handler that applies the specified rules.
+func retryHandlerV1(rules []RetryRule) func(*request.Request) {
+	return func(r *request.Request) {
+		rule, ok := matchRetryRule(rules, r.Operation.Name, func(code, message string) bool {
+			return tfawserr.ErrMessageContains(r.Error, code, message)
+		})
+
+		if !ok {
+			return
+		}
+
+		r.Retryable = aws.Bool(rule.retryable(r.RetryCount + 1))
+	}
+}
+
+// retryMiddlewareV2 returns an AWS SDK for Go v2 API option that applies the specified rules.
+func retryMiddlewareV2(rules []RetryRule) func(*middleware.Stack) error {
+	return func(stack *middleware.Stack) error {
+		if err := stack.Finalize.Insert(attemptCounterMiddleware{}, "Retry", middleware.Before); err != nil {
+			return err
+		}
+
+		return stack.Finalize.Insert(retryRulesMiddleware{rules: rules}, "Retry", middleware.After)
+	}
+}
+
+type attemptCounterKey struct{}
+
+// attemptCounterMiddleware adds an attempt counter to the context of each operation invocation.
+type attemptCounterMiddleware struct{}
+
+func (attemptCounterMiddleware) ID() string {
+	return "TerraformProviderAWSAttemptCounter"
+}
+
+func (attemptCounterMiddleware) HandleFinalize(ctx context.Context, in middleware.FinalizeInput, next middleware.FinalizeHandler) (middleware.FinalizeOutput, middleware.Metadata, error) {
+	return next.HandleFinalize(middleware.WithStackValue(ctx, attemptCounterKey{}, new(int)), in)
+}
+
+// retryRulesMiddleware runs once per attempt and marks errors matching a rule as retryable (or not).
+type retryRulesMiddleware struct {
+	rules []RetryRule
+}
+
+func (retryRulesMiddleware) ID() string {
+	return "TerraformProviderAWSRetryRules"
+}
+
+func (m retryRulesMiddleware) HandleFinalize(ctx context.Context, in middleware.FinalizeInput, next middleware.FinalizeHandler) (middleware.FinalizeOutput, middleware.Metadata, error) {
+	attempt := 1
+	if v, ok := middleware.GetStackValue(ctx, attemptCounterKey{}).(*int); ok {
+		*v++
+		attempt = *v
+	}
+
+	out, metadata, err := next.HandleFinalize(ctx, in)
+
+	if err == nil {
+		return out, metadata, err
+	}
+
+	var apiErr smithy.APIError
+	if !errors.As(err, &apiErr) {
+		return out, metadata, err
+	}
+
+	rule, ok := matchRetryRule(m.rules, awsmiddleware.GetOperationName(ctx), func(code, message string) bool {
+		return apiErr.ErrorCode() == code && strings.Contains(apiErr.ErrorMessage(), message)
+	})
+
+	if !ok {
+		return out, metadata, err
+	}
+
+	return out, metadata, &retryRuleError{error: err, retryable: rule.retryable(attempt)}
+}
+
+// retryRuleError wraps an error matching a RetryRule.
+// It implements the optional interface used by the AWS SDK for Go v2's retry.RetryableError check.
+type retryRuleError struct {
+	error
+	retryable bool
+}
+
+func (e *retryRuleError) RetryableError() bool {
+	return e.retryable
+}
+
+func (e *retryRuleError) Unwrap() error {
+	return e.error
+}
@@ -0,0 +1,251 @@
+package conns
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	awsmiddleware "github.com/aws/aws-sdk-go-v2/aws/middleware"
+	"github.com/aws/aws-sdk-go-v2/aws/retry"
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/smithy-go"
+	"github.com/aws/smithy-go/middleware"
+	smithyhttp "github.com/aws/smithy-go/transport/http"
+)
+
+func TestRetryRuleMatchesOperation(t *testing.T) {
+	testCases := []struct {
+		Name      string
+		Rule      RetryRule
+		Operation string
+		Expected  bool
+	}{
+		{
+			Name:      "no filters",
+			Rule:      RetryRule{},
+			Operation: "CreateThing",
+			Expected:  true,
+		},
+		{
+			Name:      "operation match",
+			Rule:      RetryRule{Operations: []string{"CreateThing", "DeleteThing"}},
+			Operation: "DeleteThing",
+			Expected:  true,
+		},
+		{
+			Name:      "operation no match",
+			Rule:      RetryRule{Operations: []string{"CreateThing"}},
+			Operation: "CreateThings",
+			Expected:  false,
+		},
+		{
+			Name:      "prefix match",
+			Rule:      RetryRule{OperationPrefixes: []string{"Describe", "List"}},
+			Operation: "ListThings",
+			Expected:  true,
+		},
+		{
+			Name:      "prefix no match",
+			Rule:      RetryRule{OperationPrefixes: []string{"Describe", "List"}},
+			Operation: "PutThing",
+			Expected:  false,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			if got := testCase.Rule.matchesOperation(testCase.Operation); got != testCase.Expected {
+				t.Errorf("got %t, expected %t", got, testCase.Expected)
+			}
+		})
+	}
+}
+
+func TestRetryHandlerV1(t *testing.T) {
+	rules := []RetryRule{
+		{
+			Operations:   []string{"CreateThing"},
+			ErrorCode:    "ConflictException",
+			ErrorMessage: "try again later",
+		},
+		{
+			ErrorCode:   "AccessDeniedException",
+			MaxAttempts: 3,
+		},
+	}
+
+	testCases := []struct {
+		Name       string
+		Operation  string
+		Error      error
+		RetryCount int
+		Retryable  *bool
+		Expected   *bool
+	}{
+		{
+			Name:      "match",
+			Operation: "CreateThing",
+			Error:     awserr.New("ConflictException", "Please try again later.", nil),
+			Expected:  aws.Bool(true),
+		},
+		{
+			Name:      "message mismatch",
+			Operation: "CreateThing",
+			Error:     awserr.New("ConflictException", "Already exists.", nil),
+		},
+		{
+			Name:      "operation mismatch",
+			Operation: "DeleteThing",
+			Error:     awserr.New("ConflictException", "Please try again later.", nil),
+		},
+		{
+			Name:      "no match leaves SDK decision",
+			Operation: "DeleteThing",
+			Error:     awserr.New("ThrottlingException", "Rate exceeded", nil),
+			Retryable: aws.Bool(true),
+			Expected:  aws.Bool(true),
+		},
+		{
+			Name:       "under max attempts",
+			Operation:  "DeleteThing",
+			Error:      awserr.New("AccessDeniedException", "Denied", nil),
+			RetryCount: 1,
+			Expected:   aws.Bool(true),
+		},
+		{
+			Name:       "max attempts reached",
+			Operation:  "DeleteThing",
+			Error:      awserr.New("AccessDeniedException", "Denied", nil),
+			RetryCount: 2,
+			Retryable:  aws.Bool(true),
+			Expected:   aws.Bool(false),
+		},
+	}
+
+	handler := retryHandlerV1(rules)
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			r := &request.Request{
+				Operation:  &request.Operation{Name: testCase.Operation},
+				Error:      testCase.Error,
+				RetryCount: testCase.RetryCount,
+				Retryable:  testCase.Retryable,
+			}
+
+			handler(r)
+
+			if got, expected := r.Retryable, testCase.Expected; (got == nil) != (expected == nil) || (got != nil && *got != *expected) {
+				t.Errorf("got %v, expected %v", aws.BoolValue(got), aws.BoolValue(expected))
+			}
+		})
+	}
+}
+
+func TestRetryMiddlewareV2(t *testing.T) {
+	rules := []RetryRule{
+		{
+			Operations:   []string{"CreateThing"},
+			ErrorCode:    "ConflictException",
+			ErrorMessage: "try again later",
+		},
+		{
+			ErrorCode:   "AccessDeniedException",
+			MaxAttempts: 3,
+		},
+	}
+
+	testCases := []struct {
+		Name             string
+		Operation        string
+		Error            error
+		ExpectedAttempts int
+	}{
+		{
+			Name:             "match",
+			Operation:        "CreateThing",
+			Error:            &smithy.GenericAPIError{Code: "ConflictException", Message: "Please try again later."},
+			ExpectedAttempts: 5,
+		},
+		{
+			Name:             "operation mismatch",
+			Operation:        "DeleteThing",
+			Error:            &smithy.GenericAPIError{Code: "ConflictException", Message: "Please try again later."},
+			ExpectedAttempts: 1,
+		},
+		{
+			Name:             "no match leaves SDK decision",
+			Operation:        "DeleteThing",
+			Error:            &smithy.GenericAPIError{Code: "ThrottlingException", Message: "Rate exceeded"},
+			ExpectedAttempts: 5,
+		},
+		{
+			Name:             "max attempts",
+			Operation:        "DeleteThing",
+			Error:            &smithy.GenericAPIError{Code: "AccessDeniedException", Message: "Denied"},
+			ExpectedAttempts: 3,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			stack := middleware.NewStack(testCase.Operation, smithyhttp.NewStackRequest)
+
+			if err := stack.Initialize.Add(&awsmiddleware.RegisterServiceMetadata{OperationName: testCase.Operation}, middleware.Before); err != nil {
+				t.Fatal(err)
+			}
+
+			retryer := retry.NewStandard(func(o *retry.StandardOptions) {
+				o.MaxAttempts = 5
+				o.Backoff = retry.BackoffDelayerFunc(func(int, error) (time.Duration, error) {
+					return 0, nil
+				})
+			})
+
+			if err := retry.AddRetryMiddlewares(stack, retry.AddRetryMiddlewaresOptions{Retryer: retryer}); err != nil {
+				t.Fatal(err)
+			}
+
+			if err := retryMiddlewareV2(rules)(stack); err != nil {
+				t.Fatal(err)
+			}
+
+			var attempts int
+			handler := middleware.DecorateHandler(middleware.HandlerFunc(func(ctx context.Context, input any) (any, middleware.Metadata, error) {
+				attempts++
+				return nil, middleware.Metadata{}, testCase.Error
+			}), stack)
+
+			if _, _, err := handler.Handle(context.Background(), struct{}{}); err == nil {
+				t.Fatal("expected error")
+			}
+
+			if attempts != testCase.ExpectedAttempts {
+				t.Errorf("got %d attempts, expected %d", attempts, testCase.ExpectedAttempts)
+			}
+		})
+	}
+}
+
+func TestRegisterRetryRules(t *testing.T) {
+	const serviceName = "testservice"
+
+	RegisterRetryRules(serviceName, RetryRule{ErrorCode: "Code1"})
+	RegisterRetryRules(serviceName, RetryRule{ErrorCode: "Code2"}, RetryRule{ErrorCode: "Code3"})
+
+	rules := serviceRetryRules(serviceName)
+
+	if got, expected := len(rules), 3; got != expected {
+		t.Fatalf("got %d rules, expected %d", got, expected)
+	}
+
+	if got, expected := rules[2].ErrorCode, "Code3"; got != expected {
+		t.Errorf("got %s, expected %s", got, expected)
+	}
+
+	if got := serviceRetryRules("otherservice"); len(got) != 0 {
+		t.Errorf("got %d rules, expected none", len(got))
+	}
+}
@@ -54,6 +54,18 @@ const (
 	// For tests requiring restricted IAM permissions, an existing IAM Role to assume
 	// An inline assume role policy is then used to deny actions for the test
 	AccAssumeRoleARN = "TF_ACC_ASSUME_ROLE_ARN"
+
+	// For running acceptance tests against a local AWS stand-in, the path of an endpoint profile JSON file
+	// The profile configures service endpoints and lists the services supported
+	EndpointProfile = "TF_ACC_ENDPOINT_PROFILE"
+
+	// For recorded acceptance tests, either "record" to record AWS API requests or "replay" to replay them
+	// Recording is disabled if empty
+	RecordMode = "TF_ACC_RECORD_MODE"
+
+	// For recorded acceptance tests, the directory containing the cassette files of recorded AWS API requests
+	// Defaults to testdata/cassettes in the service package directory
+	CassetteDir = "TF_ACC_CASSETTE_DIR"
 )
 
 // Custom environment variables used for assuming a role with resource sweepers
@@ -182,6 +182,41 @@ func (g *Graph) OverallOrder() ([]string, error) {
 	return order, nil
 }
 
+// Layers returns the overall processing order for the dependency graph grouped into layers.
+// Each node's dependencies are all in earlier layers, so the nodes within a layer can be processed concurrently.
+// Returns an error if a dependency cycle is detected.
+func (g *Graph) Layers() ([][]string, error) {
+	order, err := g.OverallOrder()
+
+	if err != nil {
+		return nil, err
+	}
+
+	layers := make([][]string, 0)
+	nodeLayers := make(map[string]int)
+
+	// In the overall order every node follows its dependencies.
+	for _, node := range order {
+		n := 0
+
+		for _, dependency := range g.outgoingEdges[node] {
+			if v := nodeLayers[dependency] + 1; v > n {
+				n = v
+			}
+		}
+
+		nodeLayers[node] = n
+
+		if n == len(layers) {
+			layers = append(layers, make([]string, 0))
+		}
+
+		layers[n] = append(layers[n], node)
+	}
+
+	return layers, nil
+}
+
 // depthFirstSearch returns a Topological Sort using Depth-First-Search on a set of edges.
 // Returns an error if a dependency cycle is detected.
 func depthFirstSearch(edges map[string][]string) func(s string) ([]string, error) {
@@ -0,0 +1,197 @@
+// Package flextest provides golden-file unit testing of expand and flatten functions.
+//
+// Each test case round-trips a recorded API object through the flatten function, a schema.ResourceData and the expand function:
+//
+//	testdata/<Name>.json → flatten → schema.ResourceData → expand
+//
+// The resulting Terraform state and expanded API object are compared against testdata/<Name>.golden.
+// Run tests with the -update flag to write golden files.
+// Recorded API objects can be generated from acceptance test debug logs using internal/generate/flexfixtures.
+package flextest
+
+import (
+	"bytes"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+var update = flag.Bool("update", false, "write flextest golden files")
+
+// Case is an expand/flatten golden-file test case for API objects of type T.
+type Case[T any] struct {
+	// Name identifies the test case and its files in the testdata directory.
+	Name string
+
+	// Resource is the resource with the top-level Attribute.
+	Resource *schema.Resource
+
+	// Attribute is the name of the attribute set from the flattened API object.
+	Attribute string
+
+	// Flatten flattens the API object into a value for Attribute.
+	Flatten func(*T) interface{}
+
+	// Expand expands the value of Attribute into an API object.
+	Expand func(interface{}) *T
+
+	// RoundTrip, if set, also requires the expanded API object to equal the recorded API object.
+	RoundTrip bool
+}
+
+// golden is the contents of a golden file.
+type golden struct {
+	State    map[string]string `json:"state"`
+	Expanded interface{}       `json:"expanded"`
+}
+
+// Run runs the test cases.
+func Run[T any](t *testing.T, testCases ...Case[T]) {
+	t.Helper()
+
+	for _, testCase := range testCases {
+		testCase := testCase
+
+		t.Run(testCase.Name, func(t *testing.T) {
+			testCase.run(t)
+		})
+	}
+}
+
+func (c Case[T]) run(t *testing.T) {
+	b, err := os.ReadFile(filepath.Join("testdata", c.Name+".json"))
+
+	if err != nil {
+		t.Fatalf("reading recorded API object: %s", err)
+	}
+
+	apiObject := new(T)
+
+	if err := json.Unmarshal(b, apiObject); err != nil {
+		t.Fatalf("parsing recorded API object: %s", err)
+	}
+
+	d := c.Resource.TestResourceData()
+	d.SetId(c.Name)
+
+	if err := d.Set(c.Attribute, c.Flatten(apiObject)); err != nil {
+		t.Fatalf("setting %s: %s", c.Attribute, err)
+	}
+
+	expanded, err := normalize(c.Expand(d.Get(c.Attribute)))
+
+	if err != nil {
+		t.Fatalf("normalizing expanded API object: %s", err)
+	}
+
+	if c.RoundTrip {
+		recorded, err := normalize(apiObject)
+
+		if err != nil {
+			t.Fatalf("normalizing recorded API object: %s", err)
+		}
+
+		if !reflect.DeepEqual(expanded, recorded) {
+			t.Errorf("expanded API object does not equal recorded API object\ngot:\n%s\nexpected:\n%s", indent(expanded), indent(recorded))
+		}
+	}
+
+	got := golden{
+		State:    stateAttributes(d, c.Attribute),
+		Expanded: expanded,
+	}
+
+	gotBytes, err := json.MarshalIndent(got, "", "  ")
+
+	if err != nil {
+		t.Fatalf("encoding golden file: %s", err)
+	}
+
+	gotBytes = append(gotBytes, '\n')
+	path := filepath.Join("testdata", c.Name+".golden")
+
+	if *update {
+		if err := os.WriteFile(path, gotBytes, 0600); err != nil {
+			t.Fatalf("writing golden file: %s", err)
+		}
+
+		return
+	}
+
+	expectedBytes, err := os.ReadFile(path)
+
+	if err != nil {
+		t.Fatalf("reading golden file (run with -update to create): %s", err)
+	}
+
+	if !bytes.Equal(gotBytes, expectedBytes) {
+		t.Errorf("does not match golden file %s (run with -update to update)\ngot:\n%s\nexpected:\n%s", path, gotBytes, expectedBytes)
+	}
+}
+
+// stateAttributes returns the flatmapped state attributes for the top-level attribute.
+func stateAttributes(d *schema.ResourceData, attribute string) map[string]string {
+	attributes := make(map[string]string)
+
+	for k, v := range d.State().Attributes {
+		if k == attribute || strings.HasPrefix(k, attribute+".") {
+			attributes[k] = v
+		}
+	}
+
+	return attributes
+}
+
+// normalize converts the API object to its JSON representation, omitting null values.
+func normalize(apiObject interface{}) (interface{}, error) {
+	b, err := json.Marshal(apiObject)
+
+	if err != nil {
+		return nil, err
+	}
+
+	var v interface{}
+
+	if err := json.Unmarshal(b, &v); err != nil {
+		return nil, err
+	}
+
+	return omitNulls(v), nil
+}
+
+func omitNulls(v interface{}) interface{} {
+	switch v := v.(type) {
+	case map[string]interface{}:
+		for k, e := range v {
+			if e == nil {
+				delete(v, k)
+				continue
+			}
+
+			v[k] = omitNulls(e)
+		}
+	case []interface{}:
+		for i, e := range v {
+			v[i] = omitNulls(e)
+		}
+	}
+
+	return v
+}
+
+func indent(v interface{}) string {
+	b, err := json.MarshalIndent(v, "", "  ")
+
+	if err != nil {
+		return fmt.Sprintf("%v", v)
+	}
+
+	return string(b)
+}
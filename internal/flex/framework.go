@@ -38,6 +38,20 @@ func ExpandFrameworkStringValueSet(ctx context.Context, set types.Set) []string
 	return vs
 }
 
+func ExpandFrameworkStringValueMap(ctx context.Context, m types.Map) map[string]string {
+	if m.IsNull() || m.IsUnknown() {
+		return nil
+	}
+
+	var vs map[string]string
+
+	if m.ElementsAs(ctx, &vs, false).HasError() {
+		return nil
+	}
+
+	return vs
+}
+
 func FlattenFrameworkStringList(_ context.Context, vs []*string) types.List {
 	elems := make([]attr.Value, len(vs))
 
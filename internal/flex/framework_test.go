@@ -88,6 +88,56 @@ func TestExpandFrameworkStringValueSet(t *testing.T) {
 	}
 }
 
+func TestExpandFrameworkStringValueMap(t *testing.T) {
+	t.Parallel()
+
+	type testCase struct {
+		input    types.Map
+		expected map[string]string
+	}
+	tests := map[string]testCase{
+		"null": {
+			input:    types.Map{ElemType: types.StringType, Null: true},
+			expected: nil,
+		},
+		"unknown": {
+			input:    types.Map{ElemType: types.StringType, Unknown: true},
+			expected: nil,
+		},
+		"two elements": {
+			input: types.Map{ElemType: types.StringType, Elems: map[string]attr.Value{
+				"one": types.String{Value: "GET"},
+				"two": types.String{Value: "HEAD"},
+			}},
+			expected: map[string]string{
+				"one": "GET",
+				"two": "HEAD",
+			},
+		},
+		"zero elements": {
+			input:    types.Map{ElemType: types.StringType, Elems: map[string]attr.Value{}},
+			expected: map[string]string{},
+		},
+		"invalid element type": {
+			input: types.Map{ElemType: types.BoolType, Elems: map[string]attr.Value{
+				"one": types.Bool{Value: true},
+			}},
+			expected: nil,
+		},
+	}
+
+	for name, test := range tests {
+		name, test := name, test
+		t.Run(name, func(t *testing.T) {
+			got := ExpandFrameworkStringValueMap(context.Background(), test.input)
+
+			if diff := cmp.Diff(got, test.expected); diff != "" {
+				t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+			}
+		})
+	}
+}
+
 func TestFlattenFrameworkStringValueList(t *testing.T) {
 	t.Parallel()
 
@@ -0,0 +1,626 @@
+//go:build generate
+// +build generate
+
+package main
+
+import (
+	"bytes"
+	_ "embed"
+	"flag"
+	"fmt"
+	"go/ast"
+	"go/format"
+	"go/parser"
+	"go/token"
+	"io/fs"
+	"log"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"reflect"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"text/template"
+
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+var (
+	resourceName  = flag.String("Resource", "", "name of the resource, used in function names")
+	findOp        = flag.String("FindOp", "", "name of the API operation that finds the resource")
+	idField       = flag.String("IDField", "", "name of the find operation's input field that identifies the resource")
+	idName        = flag.String("IDName", "ID", "name of the resource identifier, used in the finder's name")
+	outputField   = flag.String("OutputField", "", "name of the find operation's output field holding the resource; the output itself if empty")
+	notFoundError = flag.String("NotFoundError", "ResourceNotFoundException", "name of the API error returned when the resource is not found")
+	notFoundMsg   = flag.String("NotFoundMessage", "", "text that the not-found error's message must contain, if any")
+	statusField   = flag.String("StatusField", "", "name of the resource's status field; no status function is generated if empty")
+	waiters       = flag.String("Waiters", "", "comma-separated list of waiters, each <name>:<pending>[|<pending>...]:[<target>[|<target>...]]")
+	sdkVersion    = flag.Int("SDKVersion", 1, "version of the AWS SDK for Go used by the service package")
+	export        = flag.Bool("Export", false, "whether to export the finder")
+)
+
+func usage() {
+	fmt.Fprintf(os.Stderr, "Usage:\n")
+	fmt.Fprintf(os.Stderr, "\tmain.go [flags] [<generated-file>]\n\n")
+	fmt.Fprintf(os.Stderr, "Flags:\n")
+	flag.PrintDefaults()
+}
+
+// Config is the generator's configuration, set from its flags.
+type Config struct {
+	Resource        string
+	FindOp          string
+	IDField         string
+	IDName          string
+	OutputField     string
+	NotFoundError   string
+	NotFoundMessage string
+	StatusField     string
+	Waiters         string
+	SDKVersion      int
+	Export          bool
+}
+
+type Import struct {
+	Alias string
+	Path  string
+}
+
+type HeaderInfo struct {
+	Parameters         string
+	DestinationPackage string
+	Imports            []Import
+}
+
+type FuncSpec struct {
+	V2            bool
+	SDKPackage    string
+	ClientType    string
+	FindOp        string
+	FindFunc      string
+	IDField       string
+	IDParam       string
+	IDValue       string
+	NotFoundError string
+	NotFoundMsg   string
+	OutputField   string
+	OutputList    bool
+	ResultType    string
+	StatusFunc    string
+	StatusValue   string
+}
+
+type WaiterSpec struct {
+	*FuncSpec
+	Name    string
+	Pending string
+	Target  string
+}
+
+//go:embed header.tmpl
+var headerTemplate string
+
+//go:embed find.tmpl
+var findTemplate string
+
+//go:embed status.tmpl
+var statusTemplate string
+
+//go:embed wait.tmpl
+var waitTemplate string
+
+func main() {
+	log.SetFlags(0)
+	flag.Usage = usage
+	flag.Parse()
+
+	config := Config{
+		Resource:        *resourceName,
+		FindOp:          *findOp,
+		IDField:         *idField,
+		IDName:          *idName,
+		OutputField:     *outputField,
+		NotFoundError:   *notFoundError,
+		NotFoundMessage: *notFoundMsg,
+		StatusField:     *statusField,
+		Waiters:         *waiters,
+		SDKVersion:      *sdkVersion,
+		Export:          *export,
+	}
+
+	if config.Resource == "" || config.FindOp == "" || config.IDField == "" {
+		flag.Usage()
+		log.Fatal("-Resource, -FindOp and -IDField are required")
+	}
+
+	if config.SDKVersion != 1 && config.SDKVersion != 2 {
+		log.Fatalf("unsupported AWS SDK for Go version: %d", config.SDKVersion)
+	}
+
+	filename := fmt.Sprintf("find_%s_gen.go", toSnakeCase(config.Resource))
+	if args := flag.Args(); len(args) > 0 {
+		filename = args[0]
+	}
+
+	wd, err := os.Getwd()
+
+	if err != nil {
+		log.Fatalf("unable to get working directory: %s", err)
+	}
+
+	servicePackage := filepath.Base(wd)
+	awsService, err := names.AWSGoPackage(servicePackage, config.SDKVersion)
+
+	if err != nil {
+		log.Fatalf("encountered: %s", err)
+	}
+
+	g := Generator{
+		config: config,
+		v2:     config.SDKVersion == 2,
+	}
+
+	if g.v2 {
+		g.sourcePackage = fmt.Sprintf("github.com/aws/aws-sdk-go-v2/service/%s", awsService)
+		g.sdk = loadPackage(g.sourcePackage)
+		g.types = loadPackage(g.sourcePackage + "/types")
+		g.clientType = fmt.Sprintf("*%s.Client", g.sdk.name)
+	} else {
+		g.sourcePackage = fmt.Sprintf("github.com/aws/aws-sdk-go/service/%s", awsService)
+		g.sdk = loadPackage(g.sourcePackage)
+		g.types = g.sdk
+
+		clientTypeName, err := names.AWSGoV1ClientTypeName(servicePackage)
+
+		if err != nil {
+			log.Fatalf("encountered: %s", err)
+		}
+
+		g.clientType = fmt.Sprintf("*%s.%s", g.sdk.name, clientTypeName)
+	}
+
+	src := g.generate(servicePackage, strings.Join(os.Args[1:], " "))
+
+	if err := os.WriteFile(filename, src, 0644); err != nil {
+		log.Fatalf("error writing output: %s", err)
+	}
+}
+
+// generate returns the source of the generated file for the destination package.
+func (g *Generator) generate(destinationPackage, parameters string) []byte {
+	spec := g.funcSpec()
+
+	var body bytes.Buffer
+
+	execute(&body, "find", findTemplate, spec)
+
+	if g.config.StatusField != "" {
+		execute(&body, "status", statusTemplate, spec)
+	}
+
+	if g.config.Waiters != "" {
+		if g.config.StatusField == "" {
+			log.Fatal("-Waiters requires -StatusField")
+		}
+
+		for _, waiter := range strings.Split(g.config.Waiters, ",") {
+			execute(&body, "wait", waitTemplate, g.waiterSpec(spec, waiter))
+		}
+	}
+
+	var buf bytes.Buffer
+
+	execute(&buf, "header", headerTemplate, HeaderInfo{
+		Parameters:         parameters,
+		DestinationPackage: destinationPackage,
+		Imports:            g.imports(body.String()),
+	})
+
+	buf.Write(body.Bytes())
+
+	src, err := format.Source(buf.Bytes())
+
+	if err != nil {
+		log.Printf("warning: internal error: invalid Go generated: %s", err)
+		log.Printf("warning: compile the package to analyze the error")
+		src = buf.Bytes()
+	}
+
+	return src
+}
+
+func execute(buf *bytes.Buffer, name, text string, data interface{}) {
+	tmpl := template.Must(template.New(name).Parse(text))
+
+	if err := tmpl.Execute(buf, data); err != nil {
+		log.Fatalf("error executing %s template: %s", name, err)
+	}
+}
+
+type Package struct {
+	name  string
+	files []*ast.File
+}
+
+// loadPackage parses the source of the named package, which must be in the provider's module graph.
+func loadPackage(path string) *Package {
+	output, err := exec.Command("go", "list", "-f", "{{.Dir}}", path).Output()
+	if err != nil {
+		log.Fatalf("error listing package \"%s\": %s", path, err)
+	}
+
+	return parsePackage(strings.TrimSpace(string(output)))
+}
+
+// parsePackage parses the source of the package in the directory.
+func parsePackage(dir string) *Package {
+	pkgs, err := parser.ParseDir(token.NewFileSet(), dir, func(fi fs.FileInfo) bool {
+		return !strings.HasSuffix(fi.Name(), "_test.go")
+	}, 0)
+	if err != nil {
+		log.Fatal(err)
+	}
+	if len(pkgs) != 1 {
+		log.Fatalf("error: %d packages found", len(pkgs))
+	}
+
+	p := &Package{}
+	for name, pkg := range pkgs {
+		p.name = name
+		for _, file := range pkg.Files {
+			p.files = append(p.files, file)
+		}
+	}
+
+	return p
+}
+
+// structType returns the named struct type declared in the package.
+func (p *Package) structType(name string) *ast.StructType {
+	for _, file := range p.files {
+		for _, decl := range file.Decls {
+			genDecl, ok := decl.(*ast.GenDecl)
+			if !ok || genDecl.Tok != token.TYPE {
+				continue
+			}
+
+			for _, spec := range genDecl.Specs {
+				if typeSpec := spec.(*ast.TypeSpec); typeSpec.Name.Name == name {
+					if structType, ok := typeSpec.Type.(*ast.StructType); ok {
+						return structType
+					}
+				}
+			}
+		}
+	}
+
+	log.Fatalf("struct type \"%s.%s\" not found", p.name, name)
+	return nil
+}
+
+// field returns the named field of the named struct type declared in the package.
+func (p *Package) field(typeName, fieldName string) *ast.Field {
+	for _, field := range p.structType(typeName).Fields.List {
+		for _, name := range field.Names {
+			if name.Name == fieldName {
+				return field
+			}
+		}
+	}
+
+	log.Fatalf("field \"%s\" of struct type \"%s.%s\" not found", fieldName, p.name, typeName)
+	return nil
+}
+
+// hasConstant returns whether the package declares the named constant.
+func (p *Package) hasConstant(name string) bool {
+	for _, file := range p.files {
+		for _, decl := range file.Decls {
+			genDecl, ok := decl.(*ast.GenDecl)
+			if !ok || genDecl.Tok != token.CONST {
+				continue
+			}
+
+			for _, spec := range genDecl.Specs {
+				for _, ident := range spec.(*ast.ValueSpec).Names {
+					if ident.Name == name {
+						return true
+					}
+				}
+			}
+		}
+	}
+
+	return false
+}
+
+// enumConstant returns the name of the package's constant for the value of the named enum type, if any.
+func (p *Package) enumConstant(enumName, value string, v2 bool) (string, bool) {
+	for _, file := range p.files {
+		for _, decl := range file.Decls {
+			genDecl, ok := decl.(*ast.GenDecl)
+			if !ok || genDecl.Tok != token.CONST {
+				continue
+			}
+
+			for _, spec := range genDecl.Specs {
+				valueSpec := spec.(*ast.ValueSpec)
+
+				for i, name := range valueSpec.Names {
+					if i >= len(valueSpec.Values) {
+						continue
+					}
+
+					lit, ok := valueSpec.Values[i].(*ast.BasicLit)
+					if !ok || lit.Kind != token.STRING {
+						continue
+					}
+
+					if v, err := strconv.Unquote(lit.Value); err != nil || v != value {
+						continue
+					}
+
+					// AWS SDK for Go v2 enum values are typed. AWS SDK for Go v1 enum values are named for their enum.
+					if v2 {
+						if ident, ok := valueSpec.Type.(*ast.Ident); ok && ident.Name == enumName {
+							return name.Name, true
+						}
+					} else if strings.HasPrefix(name.Name, enumName) {
+						return name.Name, true
+					}
+				}
+			}
+		}
+	}
+
+	return "", false
+}
+
+type Generator struct {
+	config        Config
+	v2            bool
+	sourcePackage string
+	sdk           *Package
+	types         *Package
+	clientType    string
+	// Name of the enum type of the resource's status, if any.
+	statusEnum string
+}
+
+func (g *Generator) funcSpec() *FuncSpec {
+	spec := &FuncSpec{
+		V2:            g.v2,
+		SDKPackage:    g.sdk.name,
+		ClientType:    g.clientType,
+		FindOp:        g.config.FindOp,
+		FindFunc:      fmt.Sprintf("Find%sBy%s", g.config.Resource, g.config.IDName),
+		IDField:       g.config.IDField,
+		IDParam:       idParam(g.config.IDName),
+		NotFoundError: g.config.NotFoundError,
+		NotFoundMsg:   g.config.NotFoundMessage,
+		OutputField:   g.config.OutputField,
+		StatusFunc:    fmt.Sprintf("status%s", g.config.Resource),
+	}
+
+	if !g.config.Export {
+		spec.FindFunc = "f" + strings.TrimPrefix(spec.FindFunc, "F")
+	}
+
+	if g.v2 {
+		g.types.structType(g.config.NotFoundError)
+	} else if !g.sdk.hasConstant("ErrCode" + g.config.NotFoundError) {
+		log.Fatalf("error code constant \"%s.ErrCode%s\" not found", g.sdk.name, g.config.NotFoundError)
+	}
+
+	switch typeString(g.sdk.field(g.config.FindOp+"Input", g.config.IDField).Type) {
+	case "*string":
+		spec.IDValue = fmt.Sprintf("aws.String(%s)", spec.IDParam)
+	case "[]*string":
+		spec.IDValue = fmt.Sprintf("aws.StringSlice([]string{%s})", spec.IDParam)
+	case "[]string":
+		spec.IDValue = fmt.Sprintf("[]string{%s}", spec.IDParam)
+	case "string":
+		spec.IDValue = spec.IDParam
+	default:
+		log.Fatalf("unsupported type of input field \"%s\"", g.config.IDField)
+	}
+
+	// The resource's type, in the package in which it's declared.
+	var resultPkg *Package
+	var resultTypeName string
+
+	if g.config.OutputField == "" {
+		resultPkg, resultTypeName = g.sdk, g.config.FindOp+"Output"
+	} else {
+		expr := g.sdk.field(g.config.FindOp+"Output", g.config.OutputField).Type
+
+		if array, ok := expr.(*ast.ArrayType); ok {
+			spec.OutputList = true
+			expr = array.Elt
+		}
+
+		if star, ok := expr.(*ast.StarExpr); ok {
+			expr = star.X
+		} else if !g.v2 || !spec.OutputList {
+			log.Fatalf("unsupported type of output field \"%s\"", g.config.OutputField)
+		}
+
+		switch expr := expr.(type) {
+		case *ast.Ident:
+			resultPkg, resultTypeName = g.sdk, expr.Name
+		case *ast.SelectorExpr:
+			resultPkg, resultTypeName = g.types, expr.Sel.Name
+		default:
+			log.Fatalf("unsupported type of output field \"%s\"", g.config.OutputField)
+		}
+	}
+
+	spec.ResultType = fmt.Sprintf("*%s.%s", resultPkg.name, resultTypeName)
+
+	if g.config.StatusField != "" {
+		field := resultPkg.field(resultTypeName, g.config.StatusField)
+		value := fmt.Sprintf("output.%s", g.config.StatusField)
+
+		switch expr := field.Type.(type) {
+		case *ast.StarExpr:
+			if ident, ok := expr.X.(*ast.Ident); !ok || ident.Name != "string" {
+				log.Fatalf("unsupported type of status field \"%s\"", g.config.StatusField)
+			}
+
+			if g.v2 {
+				spec.StatusValue = fmt.Sprintf("aws.ToString(%s)", value)
+			} else {
+				spec.StatusValue = fmt.Sprintf("aws.StringValue(%s)", value)
+
+				if field.Tag != nil {
+					tag, _ := strconv.Unquote(field.Tag.Value)
+					g.statusEnum = reflect.StructTag(tag).Get("enum")
+				}
+			}
+		case *ast.Ident:
+			spec.StatusValue = fmt.Sprintf("string(%s)", value)
+			g.statusEnum = expr.Name
+		case *ast.SelectorExpr:
+			spec.StatusValue = fmt.Sprintf("string(%s)", value)
+			g.statusEnum = expr.Sel.Name
+		default:
+			log.Fatalf("unsupported type of status field \"%s\"", g.config.StatusField)
+		}
+	}
+
+	return spec
+}
+
+func (g *Generator) waiterSpec(spec *FuncSpec, waiter string) *WaiterSpec {
+	parts := strings.Split(waiter, ":")
+
+	if len(parts) != 3 || parts[0] == "" || parts[1] == "" {
+		log.Fatalf("invalid waiter \"%s\": expected <name>:<pending>[|<pending>...]:[<target>[|<target>...]]", waiter)
+	}
+
+	return &WaiterSpec{
+		FuncSpec: spec,
+		Name:     fmt.Sprintf("wait%s%s", g.config.Resource, parts[0]),
+		Pending:  g.states(parts[1]),
+		Target:   g.states(parts[2]),
+	}
+}
+
+// states returns the expression for a list of status values, using the AWS SDK's enum constants where possible.
+func (g *Generator) states(s string) string {
+	if s == "" {
+		return "[]string{}"
+	}
+
+	var constants, literals []string
+	for _, value := range strings.Split(s, "|") {
+		literals = append(literals, strconv.Quote(value))
+
+		if g.statusEnum == "" {
+			continue
+		}
+
+		if name, ok := g.types.enumConstant(g.statusEnum, value, g.v2); ok {
+			constants = append(constants, fmt.Sprintf("%s.%s", g.types.name, name))
+		}
+	}
+
+	switch {
+	case len(constants) != len(literals):
+		return fmt.Sprintf("[]string{%s}", strings.Join(literals, ", "))
+	case g.v2:
+		return fmt.Sprintf("enum.Slice(%s)", strings.Join(constants, ", "))
+	default:
+		return fmt.Sprintf("[]string{%s}", strings.Join(constants, ", "))
+	}
+}
+
+// imports returns the imports used by the generated code.
+func (g *Generator) imports(body string) []Import {
+	sourcePackage := g.sourcePackage
+	imports := []Import{
+		{Path: "context"},
+		{Path: "github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"},
+		{Path: "github.com/hashicorp/terraform-provider-aws/internal/tfresource"},
+		{Path: sourcePackage},
+	}
+
+	if g.v2 {
+		imports = append(imports, Import{Path: "errors"}, Import{Path: sourcePackage + "/types"})
+	} else {
+		imports = append(imports, Import{Path: "github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"})
+	}
+
+	if strings.Contains(body, "aws.") {
+		if g.v2 {
+			imports = append(imports, Import{Path: "github.com/aws/aws-sdk-go-v2/aws"})
+		} else {
+			imports = append(imports, Import{Path: "github.com/aws/aws-sdk-go/aws"})
+		}
+	}
+
+	if strings.Contains(body, "strings.") {
+		imports = append(imports, Import{Path: "strings"})
+	}
+
+	if strings.Contains(body, "time.Duration") {
+		imports = append(imports, Import{Path: "time"})
+	}
+
+	if strings.Contains(body, "enum.Slice(") {
+		imports = append(imports, Import{Path: "github.com/hashicorp/terraform-provider-aws/internal/enum"})
+	}
+
+	// Standard library imports, which contain no dots, come first.
+	sort.Slice(imports, func(i, j int) bool {
+		if stdi, stdj := !strings.Contains(imports[i].Path, "."), !strings.Contains(imports[j].Path, "."); stdi != stdj {
+			return stdi
+		}
+
+		return imports[i].Path < imports[j].Path
+	})
+
+	for i, imp := range imports {
+		if strings.Contains(imp.Path, ".") {
+			imports = append(imports[:i], append([]Import{{}}, imports[i:]...)...)
+			break
+		}
+	}
+
+	return imports
+}
+
+// typeString returns the string representation of a type expression.
+func typeString(expr ast.Expr) string {
+	switch expr := expr.(type) {
+	case *ast.Ident:
+		return expr.Name
+	case *ast.StarExpr:
+		return "*" + typeString(expr.X)
+	case *ast.ArrayType:
+		return "[]" + typeString(expr.Elt)
+	case *ast.SelectorExpr:
+		return typeString(expr.X) + "." + expr.Sel.Name
+	}
+
+	return fmt.Sprintf("%T", expr)
+}
+
+// idParam returns the finder's identifier parameter name for the identifier name, e.g. "arn" for "ARN".
+func idParam(name string) string {
+	if name == strings.ToUpper(name) {
+		return strings.ToLower(name)
+	}
+
+	return strings.ToLower(name[0:1]) + name[1:]
+}
+
+func toSnakeCase(upper string) string {
+	re := regexp.MustCompile(`([a-z])([A-Z]{2,})`)
+	upper = re.ReplaceAllString(upper, `${1}_${2}`)
+
+	re2 := regexp.MustCompile(`([A-Z][a-z])`)
+	return strings.TrimPrefix(strings.ToLower(re2.ReplaceAllString(upper, `_$1`)), "_")
+}
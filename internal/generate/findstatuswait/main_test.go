@@ -0,0 +1,136 @@
+//go:build generate
+// +build generate
+
+package main
+
+import (
+	"bytes"
+	"flag"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+var update = flag.Bool("update", false, "update the golden files")
+
+func TestGenerate(t *testing.T) {
+	testCases := []struct {
+		Name       string
+		Config     Config
+		Parameters string
+	}{
+		{
+			Name: "v1_waiters",
+			Config: Config{
+				Resource:      "Thing",
+				FindOp:        "DescribeThing",
+				IDField:       "ThingArn",
+				IDName:        "ARN",
+				OutputField:   "ThingInfo",
+				NotFoundError: "ResourceNotFoundException",
+				StatusField:   "State",
+				Waiters:       "Created:CREATING:ACTIVE,Deleted:DELETING:",
+				SDKVersion:    1,
+				Export:        true,
+			},
+			Parameters: "-Resource=Thing -FindOp=DescribeThing -IDField=ThingArn -IDName=ARN -OutputField=ThingInfo -StatusField=State -Waiters=Created:CREATING:ACTIVE,Deleted:DELETING: -Export",
+		},
+		{
+			Name: "v1_list_not_found_message",
+			Config: Config{
+				Resource:        "Thing",
+				FindOp:          "ListThings",
+				IDField:         "ThingIds",
+				IDName:          "ID",
+				OutputField:     "Things",
+				NotFoundError:   "BadRequestException",
+				NotFoundMessage: "does not exist",
+				SDKVersion:      1,
+			},
+			Parameters: "-Resource=Thing -FindOp=ListThings -IDField=ThingIds -OutputField=Things -NotFoundError=BadRequestException -NotFoundMessage=does not exist",
+		},
+		{
+			Name: "v1_output",
+			Config: Config{
+				Resource:      "Thing",
+				FindOp:        "DescribeThing",
+				IDField:       "ThingArn",
+				IDName:        "ARN",
+				NotFoundError: "ResourceNotFoundException",
+				SDKVersion:    1,
+			},
+			Parameters: "-Resource=Thing -FindOp=DescribeThing -IDField=ThingArn -IDName=ARN",
+		},
+		{
+			Name: "v2_waiters",
+			Config: Config{
+				Resource:      "Thing",
+				FindOp:        "DescribeThing",
+				IDField:       "ThingArn",
+				IDName:        "ARN",
+				OutputField:   "Thing",
+				NotFoundError: "ResourceNotFoundException",
+				StatusField:   "State",
+				Waiters:       "Created:CREATING:ACTIVE,Deleted:DELETING:",
+				SDKVersion:    2,
+			},
+			Parameters: "-Resource=Thing -FindOp=DescribeThing -IDField=ThingArn -IDName=ARN -OutputField=Thing -StatusField=State -Waiters=Created:CREATING:ACTIVE,Deleted:DELETING: -SDKVersion=2",
+		},
+		{
+			Name: "v2_not_found_message",
+			Config: Config{
+				Resource:        "Thing",
+				FindOp:          "DescribeThing",
+				IDField:         "ThingArn",
+				IDName:          "ARN",
+				OutputField:     "Thing",
+				NotFoundError:   "ResourceNotFoundException",
+				NotFoundMessage: "does not exist",
+				SDKVersion:      2,
+				Export:          true,
+			},
+			Parameters: "-Resource=Thing -FindOp=DescribeThing -IDField=ThingArn -IDName=ARN -OutputField=Thing -NotFoundMessage=does not exist -SDKVersion=2 -Export",
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			g := Generator{
+				config: testCase.Config,
+				v2:     testCase.Config.SDKVersion == 2,
+			}
+
+			// Minimal stand-ins for the AWS SDK for Go service packages are in testdata.
+			if g.v2 {
+				g.sourcePackage = "github.com/aws/aws-sdk-go-v2/service/example"
+				g.sdk = parsePackage(filepath.Join("testdata", "sdkv2", "example"))
+				g.types = parsePackage(filepath.Join("testdata", "sdkv2", "example", "types"))
+				g.clientType = "*example.Client"
+			} else {
+				g.sourcePackage = "github.com/aws/aws-sdk-go/service/example"
+				g.sdk = parsePackage(filepath.Join("testdata", "sdkv1", "example"))
+				g.types = g.sdk
+				g.clientType = "*example.Example"
+			}
+
+			got := g.generate("example", testCase.Parameters)
+			golden := filepath.Join("testdata", testCase.Name+".golden")
+
+			if *update {
+				if err := os.WriteFile(golden, got, 0644); err != nil {
+					t.Fatalf("writing golden file: %s", err)
+				}
+			}
+
+			want, err := os.ReadFile(golden)
+
+			if err != nil {
+				t.Fatalf("reading golden file: %s", err)
+			}
+
+			if !bytes.Equal(got, want) {
+				t.Errorf("generated code doesn't match %s, got:\n%s", golden, got)
+			}
+		})
+	}
+}
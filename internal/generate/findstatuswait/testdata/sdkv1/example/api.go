@@ -0,0 +1,38 @@
+// Package example is a minimal stand-in for an AWS SDK for Go v1 service package.
+package example
+
+const (
+	ErrCodeBadRequestException = "BadRequestException"
+
+	ErrCodeResourceNotFoundException = "ResourceNotFoundException"
+)
+
+const (
+	ThingStateActive = "ACTIVE"
+
+	ThingStateCreating = "CREATING"
+
+	ThingStateDeleting = "DELETING"
+)
+
+type Example struct{}
+
+type DescribeThingInput struct {
+	ThingArn *string `type:"string" required:"true"`
+}
+
+type DescribeThingOutput struct {
+	ThingInfo *ThingInfo `type:"structure"`
+}
+
+type ListThingsInput struct {
+	ThingIds []*string `type:"list"`
+}
+
+type ListThingsOutput struct {
+	Things []*ThingInfo `type:"list"`
+}
+
+type ThingInfo struct {
+	State *string `type:"string" enum:"ThingState"`
+}
@@ -0,0 +1,16 @@
+// Package example is a minimal stand-in for an AWS SDK for Go v2 service package.
+package example
+
+import (
+	"example/types"
+)
+
+type Client struct{}
+
+type DescribeThingInput struct {
+	ThingArn *string
+}
+
+type DescribeThingOutput struct {
+	Thing *types.Thing
+}
@@ -0,0 +1,17 @@
+package types
+
+type ResourceNotFoundException struct {
+	Message *string
+}
+
+type Thing struct {
+	State ThingState
+}
+
+type ThingState string
+
+const (
+	ThingStateActive   ThingState = "ACTIVE"
+	ThingStateCreating ThingState = "CREATING"
+	ThingStateDeleting ThingState = "DELETING"
+)
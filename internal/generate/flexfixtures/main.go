@@ -0,0 +1,172 @@
+//go:build generate
+// +build generate
+
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"log"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+const (
+	responseSectionEnd = "-----------------------------------------------------"
+)
+
+var (
+	logFile   = flag.String("Log", "", "path of the acceptance test debug log (TF_LOG=DEBUG)")
+	operation = flag.String("Operation", "", "AWS SDK for Go v1 service and operation, for example es/DescribeDomain")
+	path      = flag.String("Path", "", "dot-separated path of the API object in the response, for example DomainStatus.EBSOptions")
+	index     = flag.Int("Index", -1, "index of the matching response to use; negative values count from the last response")
+)
+
+// accountIDRegexp matches AWS account IDs, which are replaced in fixtures.
+var accountIDRegexp = regexp.MustCompile(`\b\d{12}\b`)
+
+const scrubbedAccountID = "123456789012"
+
+func usage() {
+	fmt.Fprintf(os.Stderr, "Usage:\n")
+	fmt.Fprintf(os.Stderr, "\tmain.go -Log <log-file> -Operation <service>/<operation> [flags] <fixture-file>\n\n")
+	fmt.Fprintf(os.Stderr, "Flags:\n")
+	flag.PrintDefaults()
+}
+
+func main() {
+	log.SetFlags(0)
+	flag.Usage = usage
+	flag.Parse()
+
+	if *logFile == "" || *operation == "" || flag.NArg() != 1 {
+		flag.Usage()
+		os.Exit(2)
+	}
+
+	filename := flag.Arg(0)
+
+	b, err := os.ReadFile(*logFile)
+
+	if err != nil {
+		log.Fatalf("reading log: %s", err)
+	}
+
+	responses, err := responseBodies(string(b), *operation)
+
+	if err != nil {
+		log.Fatalf("parsing log: %s", err)
+	}
+
+	if len(responses) == 0 {
+		log.Fatalf("no JSON responses found for %s", *operation)
+	}
+
+	i := *index
+	if i < 0 {
+		i += len(responses)
+	}
+
+	if i < 0 || i >= len(responses) {
+		log.Fatalf("response index %d out of range: %d responses found for %s", *index, len(responses), *operation)
+	}
+
+	v, err := lookup(responses[i], *path)
+
+	if err != nil {
+		log.Fatalf("%s", err)
+	}
+
+	out, err := json.MarshalIndent(v, "", "  ")
+
+	if err != nil {
+		log.Fatalf("encoding fixture: %s", err)
+	}
+
+	out = accountIDRegexp.ReplaceAll(out, []byte(scrubbedAccountID))
+	out = append(out, '\n')
+
+	if err := os.MkdirAll(filepath.Dir(filename), 0755); err != nil {
+		log.Fatalf("creating fixture directory: %s", err)
+	}
+
+	if err := os.WriteFile(filename, out, 0644); err != nil {
+		log.Fatalf("writing fixture: %s", err)
+	}
+
+	log.Printf("wrote %s", filename)
+}
+
+// responseBodies returns the decoded JSON bodies of the AWS SDK for Go v1 debug-logged responses for the operation.
+func responseBodies(s, operation string) ([]interface{}, error) {
+	header := fmt.Sprintf("DEBUG: Response %s Details:", operation)
+
+	var bodies []interface{}
+
+	for {
+		i := strings.Index(s, header)
+
+		if i < 0 {
+			break
+		}
+
+		s = s[i+len(header):]
+		section := s
+
+		if j := strings.Index(section, responseSectionEnd); j >= 0 {
+			section = section[:j]
+		}
+
+		// The HTTP headers precede the body, which for JSON protocols is a single JSON object.
+		j := strings.Index(section, "{")
+
+		if j < 0 {
+			continue
+		}
+
+		var body interface{}
+
+		if err := json.NewDecoder(bytes.NewBufferString(section[j:])).Decode(&body); err != nil {
+			return nil, fmt.Errorf("decoding %s response body: %w", operation, err)
+		}
+
+		bodies = append(bodies, body)
+	}
+
+	return bodies, nil
+}
+
+// lookup returns the value at the dot-separated path.
+func lookup(v interface{}, path string) (interface{}, error) {
+	if path == "" {
+		return v, nil
+	}
+
+	for _, segment := range strings.Split(path, ".") {
+		switch tv := v.(type) {
+		case map[string]interface{}:
+			var ok bool
+
+			if v, ok = tv[segment]; !ok {
+				return nil, fmt.Errorf("path %s: %s not found", path, segment)
+			}
+		case []interface{}:
+			i, err := strconv.Atoi(segment)
+
+			if err != nil || i < 0 || i >= len(tv) {
+				return nil, fmt.Errorf("path %s: invalid array index %s", path, segment)
+			}
+
+			v = tv[i]
+		default:
+			return nil, fmt.Errorf("path %s: %s is not an object or array", path, segment)
+		}
+	}
+
+	return v, nil
+}
@@ -0,0 +1,166 @@
+//go:build generate
+// +build generate
+
+package main
+
+import (
+	"bytes"
+	_ "embed"
+	"errors"
+	"flag"
+	"fmt"
+	"go/format"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"text/template"
+
+	"github.com/mitchellh/cli"
+)
+
+const (
+	// servicePackageDataDirective is the generate directive that marks a service package as providing service package data.
+	servicePackageDataDirective = `generate/servicepackagedata/main.go`
+)
+
+func usage() {
+	fmt.Fprintf(os.Stderr, "Usage:\n")
+	fmt.Fprintf(os.Stderr, "\tmain.go [flags] [<generated-file>]\n\n")
+	fmt.Fprintf(os.Stderr, "Flags:\n")
+	flag.PrintDefaults()
+}
+
+var (
+	serviceDir = flag.String("ServiceDir", "../service", "directory containing the service packages")
+)
+
+func main() {
+	flag.Usage = usage
+	flag.Parse()
+
+	filename := `service_packages_gen.go`
+	if args := flag.Args(); len(args) > 0 {
+		filename = args[0]
+	}
+
+	ui := &cli.BasicUi{
+		Reader:      os.Stdin,
+		Writer:      os.Stdout,
+		ErrorWriter: os.Stderr,
+	}
+	generator := &Generator{
+		UI: ui,
+	}
+	templateData := &TemplateData{
+		PackageName: os.Getenv("GOPACKAGE"),
+	}
+
+	servicePackages, err := findServicePackages(*serviceDir)
+
+	if err != nil {
+		ui.Error(fmt.Sprintf("error finding service packages: %s", err.Error()))
+		os.Exit(1)
+	}
+
+	templateData.ServicePackages = servicePackages
+
+	if err := generator.ApplyAndWriteTemplate(filename, tmpl, templateData); err != nil {
+		ui.Error(fmt.Sprintf("error generating %s service packages: %s", templateData.PackageName, err.Error()))
+		os.Exit(1)
+	}
+}
+
+// findServicePackages returns the names of all service packages whose generate.go
+// file contains the servicepackagedata generate directive.
+func findServicePackages(dir string) ([]string, error) {
+	entries, err := os.ReadDir(dir)
+
+	if err != nil {
+		return nil, fmt.Errorf("reading directory (%s): %w", dir, err)
+	}
+
+	var servicePackages []string
+
+	for _, entry := range entries {
+		if !entry.IsDir() {
+			continue
+		}
+
+		filename := filepath.Join(dir, entry.Name(), "generate.go")
+		b, err := os.ReadFile(filename)
+
+		if errors.Is(err, fs.ErrNotExist) {
+			continue
+		}
+
+		if err != nil {
+			return nil, fmt.Errorf("reading file (%s): %w", filename, err)
+		}
+
+		if !strings.Contains(string(b), servicePackageDataDirective) {
+			continue
+		}
+
+		servicePackages = append(servicePackages, entry.Name())
+	}
+
+	sort.Strings(servicePackages)
+
+	return servicePackages, nil
+}
+
+type Generator struct {
+	UI cli.Ui
+}
+
+func (g *Generator) ApplyAndWriteTemplate(filename, templateBody string, templateData *TemplateData) error {
+	tmpl, err := template.New("servicepackages").Parse(templateBody)
+
+	if err != nil {
+		return fmt.Errorf("parsing function template: %w", err)
+	}
+
+	var buffer bytes.Buffer
+	err = tmpl.Execute(&buffer, templateData)
+
+	if err != nil {
+		return fmt.Errorf("executing template: %w", err)
+	}
+
+	generatedFileContents, err := format.Source(buffer.Bytes())
+
+	if err != nil {
+		g.Infof("%s", buffer.String())
+		return fmt.Errorf("formatting generated source code: %w", err)
+	}
+
+	f, err := os.Create(filename)
+
+	if err != nil {
+		return fmt.Errorf("creating file (%s): %w", filename, err)
+	}
+
+	defer f.Close()
+
+	_, err = f.Write(generatedFileContents)
+
+	if err != nil {
+		return fmt.Errorf("writing to file (%s): %w", filename, err)
+	}
+
+	return nil
+}
+
+func (g *Generator) Infof(format string, a ...interface{}) {
+	g.UI.Info(fmt.Sprintf(format, a...))
+}
+
+type TemplateData struct {
+	PackageName     string
+	ServicePackages []string
+}
+
+//go:embed file.tmpl
+var tmpl string
@@ -0,0 +1,543 @@
+package policy
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sort"
+	"strconv"
+
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+const (
+	policyModelMarshallJSONStartSliceSize = 2
+)
+
+type IAMPolicyDoc struct {
+	Version    string                `json:",omitempty"`
+	Id         string                `json:",omitempty"`
+	Statements []*IAMPolicyStatement `json:"Statement,omitempty"`
+}
+
+type IAMPolicyStatement struct {
+	Sid           string
+	Effect        string                         `json:",omitempty"`
+	Actions       interface{}                    `json:"Action,omitempty"`
+	NotActions    interface{}                    `json:"NotAction,omitempty"`
+	Resources     interface{}                    `json:"Resource,omitempty"`
+	NotResources  interface{}                    `json:"NotResource,omitempty"`
+	Principals    IAMPolicyStatementPrincipalSet `json:"Principal,omitempty"`
+	NotPrincipals IAMPolicyStatementPrincipalSet `json:"NotPrincipal,omitempty"`
+	Conditions    IAMPolicyStatementConditionSet `json:"Condition,omitempty"`
+}
+
+type IAMPolicyStatementPrincipal struct {
+	Type        string
+	Identifiers interface{}
+}
+
+type IAMPolicyStatementCondition struct {
+	Test     string
+	Variable string
+	Values   interface{}
+}
+
+type IAMPolicyStatementPrincipalSet []IAMPolicyStatementPrincipal
+type IAMPolicyStatementConditionSet []IAMPolicyStatementCondition
+
+func (s *IAMPolicyDoc) Merge(newDoc *IAMPolicyDoc) {
+	// adopt newDoc's Id
+	if len(newDoc.Id) > 0 {
+		s.Id = newDoc.Id
+	}
+
+	// let newDoc upgrade our Version
+	if newDoc.Version > s.Version {
+		s.Version = newDoc.Version
+	}
+
+	// merge in newDoc's statements, overwriting any existing Sids
+	var seen bool
+	for _, newStatement := range newDoc.Statements {
+		if len(newStatement.Sid) == 0 {
+			s.Statements = append(s.Statements, newStatement)
+			continue
+		}
+		seen = false
+		for i, existingStatement := range s.Statements {
+			if existingStatement.Sid == newStatement.Sid {
+				s.Statements[i] = newStatement
+				seen = true
+				break
+			}
+		}
+		if !seen {
+			s.Statements = append(s.Statements, newStatement)
+		}
+	}
+}
+
+// MergeUnion merges newDoc's statements into the document, combining statements with the same Sid:
+// their actions, resources and principals are unioned. Combined statements must have the same effect and conditions.
+func (s *IAMPolicyDoc) MergeUnion(newDoc *IAMPolicyDoc) error {
+	if len(newDoc.Id) > 0 {
+		s.Id = newDoc.Id
+	}
+
+	if newDoc.Version > s.Version {
+		s.Version = newDoc.Version
+	}
+
+	for _, newStatement := range newDoc.Statements {
+		var existingStatement *IAMPolicyStatement
+
+		if len(newStatement.Sid) > 0 {
+			for _, v := range s.Statements {
+				if v.Sid == newStatement.Sid {
+					existingStatement = v
+					break
+				}
+			}
+		}
+
+		if existingStatement == nil {
+			s.Statements = append(s.Statements, newStatement)
+			continue
+		}
+
+		statement, err := existingStatement.union(newStatement)
+
+		if err != nil {
+			return fmt.Errorf("merging statements with Sid (%s): %w", newStatement.Sid, err)
+		}
+
+		*existingStatement = *statement
+	}
+
+	return nil
+}
+
+func (st *IAMPolicyStatement) union(other *IAMPolicyStatement) (*IAMPolicyStatement, error) {
+	if st.Effect != other.Effect {
+		return nil, fmt.Errorf("effects differ (%s, %s)", st.Effect, other.Effect)
+	}
+
+	if (st.Actions == nil) != (other.Actions == nil) || (st.NotActions == nil) != (other.NotActions == nil) {
+		return nil, errors.New("one statement has actions and the other has not_actions")
+	}
+
+	if (st.Resources == nil) != (other.Resources == nil) || (st.NotResources == nil) != (other.NotResources == nil) {
+		return nil, errors.New("one statement has resources and the other has not_resources")
+	}
+
+	if (st.Principals == nil) != (other.Principals == nil) || (st.NotPrincipals == nil) != (other.NotPrincipals == nil) {
+		return nil, errors.New("one statement has principals and the other has not_principals")
+	}
+
+	c1, err := json.Marshal(st.Conditions)
+
+	if err != nil {
+		return nil, err
+	}
+
+	c2, err := json.Marshal(other.Conditions)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if !bytes.Equal(c1, c2) {
+		return nil, errors.New("conditions differ")
+	}
+
+	return &IAMPolicyStatement{
+		Sid:           st.Sid,
+		Effect:        st.Effect,
+		Actions:       policyStringsUnion(st.Actions, other.Actions),
+		NotActions:    policyStringsUnion(st.NotActions, other.NotActions),
+		Resources:     policyStringsUnion(st.Resources, other.Resources),
+		NotResources:  policyStringsUnion(st.NotResources, other.NotResources),
+		Principals:    st.Principals.union(other.Principals),
+		NotPrincipals: st.NotPrincipals.union(other.NotPrincipals),
+		Conditions:    st.Conditions,
+	}, nil
+}
+
+func (ps IAMPolicyStatementPrincipalSet) union(other IAMPolicyStatementPrincipalSet) IAMPolicyStatementPrincipalSet {
+	if ps == nil && other == nil {
+		return nil
+	}
+
+	var out IAMPolicyStatementPrincipalSet
+
+	for _, p := range append(append(IAMPolicyStatementPrincipalSet{}, ps...), other...) {
+		i := 0
+		for ; i < len(out); i++ {
+			if out[i].Type == p.Type {
+				break
+			}
+		}
+
+		if i == len(out) {
+			out = append(out, IAMPolicyStatementPrincipal{Type: p.Type})
+		}
+
+		out[i].Identifiers = policyStringsUnion(out[i].Identifiers, p.Identifiers)
+	}
+
+	return out
+}
+
+// policyStringsUnion returns the unique strings in two policy model values in the form used by DecodeConfigStringList.
+func policyStringsUnion(a, b interface{}) interface{} {
+	if a == nil && b == nil {
+		return nil
+	}
+
+	var values []interface{}
+	seen := make(map[string]struct{})
+
+	for _, v := range append(policyStrings(a), policyStrings(b)...) {
+		if _, ok := seen[v]; !ok {
+			seen[v] = struct{}{}
+			values = append(values, v)
+		}
+	}
+
+	return DecodeConfigStringList(values)
+}
+
+// Deduplicate removes statements that are equivalent to an earlier statement.
+func (s *IAMPolicyDoc) Deduplicate() error {
+	var statements []*IAMPolicyStatement
+	seen := make(map[string]struct{})
+
+	for _, statement := range s.Statements {
+		b, err := json.Marshal(&IAMPolicyDoc{Statements: []*IAMPolicyStatement{statement}})
+
+		if err != nil {
+			return err
+		}
+
+		key, err := verify.NormalizePolicy(string(b))
+
+		if err != nil {
+			return err
+		}
+
+		if _, ok := seen[key]; ok {
+			continue
+		}
+
+		seen[key] = struct{}{}
+		statements = append(statements, statement)
+	}
+
+	s.Statements = statements
+
+	return nil
+}
+
+// Split returns the document split into documents of at most maxSize characters, not counting white space.
+// Statements are kept in order. A statement too large for a document of its own is split into statements
+// with fewer resources or, failing that, actions, whose Sids have the part's index appended.
+func (s *IAMPolicyDoc) Split(maxSize int) ([]*IAMPolicyDoc, error) {
+	var statements []*IAMPolicyStatement
+
+	for i, statement := range s.Statements {
+		parts, err := s.splitStatement(statement, maxSize)
+
+		if err != nil {
+			return nil, fmt.Errorf("statement %d: %w", i, err)
+		}
+
+		if len(parts) > 1 && len(statement.Sid) > 0 {
+			for j, part := range parts {
+				part.Sid = fmt.Sprintf("%s%d", statement.Sid, j)
+			}
+		}
+
+		statements = append(statements, parts...)
+	}
+
+	docs := []*IAMPolicyDoc{{Version: s.Version, Id: s.Id}}
+
+	for _, statement := range statements {
+		doc := docs[len(docs)-1]
+		doc.Statements = append(doc.Statements, statement)
+
+		size, err := doc.size()
+
+		if err != nil {
+			return nil, err
+		}
+
+		if size > maxSize && len(doc.Statements) > 1 {
+			doc.Statements = doc.Statements[:len(doc.Statements)-1]
+			docs = append(docs, &IAMPolicyDoc{Version: s.Version, Id: s.Id, Statements: []*IAMPolicyStatement{statement}})
+		}
+	}
+
+	return docs, nil
+}
+
+// splitStatement splits a statement into statements that each fit in a document of at most maxSize characters.
+func (s *IAMPolicyDoc) splitStatement(statement *IAMPolicyStatement, maxSize int) ([]*IAMPolicyStatement, error) {
+	size, err := (&IAMPolicyDoc{Version: s.Version, Id: s.Id, Statements: []*IAMPolicyStatement{statement}}).size()
+
+	if err != nil {
+		return nil, err
+	}
+
+	if size <= maxSize {
+		return []*IAMPolicyStatement{statement}, nil
+	}
+
+	halve := func(v interface{}, set func(*IAMPolicyStatement, interface{})) ([]*IAMPolicyStatement, error) {
+		values := policyStrings(v)
+		n := len(values) / 2
+
+		var parts []*IAMPolicyStatement
+
+		for _, half := range [][]string{values[:n], values[n:]} {
+			part := *statement
+			set(&part, policyStringsUnion(half, nil))
+
+			v, err := s.splitStatement(&part, maxSize)
+
+			if err != nil {
+				return nil, err
+			}
+
+			parts = append(parts, v...)
+		}
+
+		return parts, nil
+	}
+
+	if len(policyStrings(statement.Resources)) > 1 {
+		return halve(statement.Resources, func(st *IAMPolicyStatement, v interface{}) { st.Resources = v })
+	}
+
+	if len(policyStrings(statement.Actions)) > 1 {
+		return halve(statement.Actions, func(st *IAMPolicyStatement, v interface{}) { st.Actions = v })
+	}
+
+	return nil, fmt.Errorf("%d characters, not counting white space, exceeds the maximum size (%d) and the statement can't be split", size, maxSize)
+}
+
+func (s *IAMPolicyDoc) size() (int, error) {
+	b, err := json.Marshal(s)
+
+	if err != nil {
+		return 0, err
+	}
+
+	return Size(string(b)), nil
+}
+
+func (ps IAMPolicyStatementPrincipalSet) MarshalJSON() ([]byte, error) {
+	raw := map[string]interface{}{}
+
+	// Although IAM documentation says, that "*" and {"AWS": "*"} are equivalent
+	// (https://docs.aws.amazon.com/IAM/latest/UserGuide/reference_policies_elements_principal.html),
+	// in practice they are not for IAM roles. IAM will return an error if trust
+	// policy have "*" or {"*": "*"} as principal, but will accept {"AWS": "*"}.
+	// Only {"*": "*"} should be normalized to "*".
+	if len(ps) == 1 {
+		p := ps[0]
+		if p.Type == "*" {
+			if sv, ok := p.Identifiers.(string); ok && sv == "*" {
+				return []byte(`"*"`), nil
+			}
+
+			if av, ok := p.Identifiers.([]string); ok && len(av) == 1 && av[0] == "*" {
+				return []byte(`"*"`), nil
+			}
+		}
+	}
+
+	for _, p := range ps {
+		switch i := p.Identifiers.(type) {
+		case []string:
+			switch v := raw[p.Type].(type) {
+			case nil:
+				raw[p.Type] = make([]string, 0, len(i))
+			case string:
+				// Convert to []string to prevent panic
+				raw[p.Type] = make([]string, 0, len(i)+1)
+				raw[p.Type] = append(raw[p.Type].([]string), v)
+			}
+			sort.Sort(sort.Reverse(sort.StringSlice(i)))
+			raw[p.Type] = append(raw[p.Type].([]string), i...)
+		case string:
+			switch v := raw[p.Type].(type) {
+			case nil:
+				raw[p.Type] = i
+			case string:
+				// Convert to []string to stop drop of principals
+				raw[p.Type] = make([]string, 0, policyModelMarshallJSONStartSliceSize)
+				raw[p.Type] = append(raw[p.Type].([]string), v)
+				raw[p.Type] = append(raw[p.Type].([]string), i)
+			case []string:
+				raw[p.Type] = append(raw[p.Type].([]string), i)
+			}
+		default:
+			return []byte{}, fmt.Errorf("Unsupported data type %T for IAMPolicyStatementPrincipalSet", i)
+		}
+	}
+
+	return json.Marshal(&raw)
+}
+
+func (ps *IAMPolicyStatementPrincipalSet) UnmarshalJSON(b []byte) error {
+	var out IAMPolicyStatementPrincipalSet
+
+	var data interface{}
+	if err := json.Unmarshal(b, &data); err != nil {
+		return err
+	}
+
+	switch t := data.(type) {
+	case string:
+		out = append(out, IAMPolicyStatementPrincipal{Type: "*", Identifiers: []string{"*"}})
+	case map[string]interface{}:
+		for key, value := range data.(map[string]interface{}) {
+			switch vt := value.(type) {
+			case string:
+				out = append(out, IAMPolicyStatementPrincipal{Type: key, Identifiers: value.(string)})
+			case []interface{}:
+				values := []string{}
+				for _, v := range value.([]interface{}) {
+					s, ok := v.(string)
+					if !ok {
+						return fmt.Errorf("Unsupported data type %T for IAMPolicyStatementPrincipalSet.Identifiers", v)
+					}
+					values = append(values, s)
+				}
+				out = append(out, IAMPolicyStatementPrincipal{Type: key, Identifiers: values})
+			default:
+				return fmt.Errorf("Unsupported data type %T for IAMPolicyStatementPrincipalSet.Identifiers", vt)
+			}
+		}
+	default:
+		return fmt.Errorf("Unsupported data type %T for IAMPolicyStatementPrincipalSet", t)
+	}
+
+	*ps = out
+	return nil
+}
+
+func (cs IAMPolicyStatementConditionSet) MarshalJSON() ([]byte, error) {
+	raw := map[string]map[string]interface{}{}
+
+	for _, c := range cs {
+		if _, ok := raw[c.Test]; !ok {
+			raw[c.Test] = map[string]interface{}{}
+		}
+		switch i := c.Values.(type) {
+		case []string:
+			if _, ok := raw[c.Test][c.Variable]; !ok {
+				raw[c.Test][c.Variable] = make([]string, 0, len(i))
+			}
+			// order matters with values so not sorting here
+			raw[c.Test][c.Variable] = append(raw[c.Test][c.Variable].([]string), i...)
+		case string:
+			raw[c.Test][c.Variable] = i
+		default:
+			return nil, fmt.Errorf("Unsupported data type for IAMPolicyStatementConditionSet: %s", i)
+		}
+	}
+
+	return json.Marshal(&raw)
+}
+
+func (cs *IAMPolicyStatementConditionSet) UnmarshalJSON(b []byte) error {
+	var out IAMPolicyStatementConditionSet
+
+	var data map[string]map[string]interface{}
+	if err := json.Unmarshal(b, &data); err != nil {
+		return err
+	}
+
+	for test_key, test_value := range data {
+		for var_key, var_values := range test_value {
+			values, err := decodeConditionValues(var_values)
+			if err != nil {
+				return err
+			}
+			out = append(out, IAMPolicyStatementCondition{Test: test_key, Variable: var_key, Values: values})
+		}
+	}
+
+	*cs = out
+	return nil
+}
+
+// decodeConditionValues returns a condition key's values, decoded from JSON, as a policy model value.
+// Numbers and booleans are converted to strings.
+func decodeConditionValues(v interface{}) (interface{}, error) {
+	switch v := v.(type) {
+	case string:
+		return []string{v}, nil
+	case bool:
+		return strconv.FormatBool(v), nil
+	case float64:
+		return strconv.FormatFloat(v, 'f', -1, 64), nil
+	case []interface{}:
+		values := []string{}
+		for _, v := range v {
+			switch v := v.(type) {
+			case string:
+				values = append(values, v)
+			case bool:
+				values = append(values, strconv.FormatBool(v))
+			case float64:
+				values = append(values, strconv.FormatFloat(v, 'f', -1, 64))
+			default:
+				return nil, fmt.Errorf("Unsupported data type %T for IAMPolicyStatementCondition.Values", v)
+			}
+		}
+		return values, nil
+	default:
+		return nil, fmt.Errorf("Unsupported data type %T for IAMPolicyStatementCondition.Values", v)
+	}
+}
+
+// DecodeConfigStringList returns a list of strings from the configuration as a policy model value:
+// a single string, or the strings in reverse order.
+func DecodeConfigStringList(lI []interface{}) interface{} {
+	if len(lI) == 1 {
+		return lI[0].(string)
+	}
+	ret := make([]string, len(lI))
+	for i, vI := range lI {
+		ret[i] = vI.(string)
+	}
+	sort.Sort(sort.Reverse(sort.StringSlice(ret)))
+	return ret
+}
+
+// policyStrings returns the strings in a policy model value, which is a string or a slice of strings or, if decoded from JSON, of interfaces.
+func policyStrings(v interface{}) []string {
+	switch v := v.(type) {
+	case string:
+		return []string{v}
+	case []string:
+		return v
+	case []interface{}:
+		ss := make([]string, 0, len(v))
+
+		for _, v := range v {
+			if v, ok := v.(string); ok {
+				ss = append(ss, v)
+			}
+		}
+
+		return ss
+	default:
+		return nil
+	}
+}
@@ -0,0 +1,227 @@
+package policy_test
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-aws/internal/policy"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+func TestIAMPolicyDocMergeUnion(t *testing.T) {
+	testCases := []struct {
+		Name          string
+		Doc           string
+		NewDoc        string
+		Expected      string
+		ExpectedError string
+	}{
+		{
+			Name:     "union",
+			Doc:      `{"Version": "2012-10-17", "Statement": [{"Sid": "Read", "Effect": "Allow", "Action": "s3:GetObject", "Resource": "arn:aws:s3:::first/*", "Principal": {"AWS": "123456789012"}}]}`,
+			NewDoc:   `{"Version": "2012-10-17", "Statement": [{"Sid": "Read", "Effect": "Allow", "Action": ["s3:GetObject", "s3:GetObjectVersion"], "Resource": "arn:aws:s3:::second/*", "Principal": {"AWS": "210987654321", "Service": "ec2.amazonaws.com"}}, {"Effect": "Allow", "Action": "sqs:SendMessage", "Resource": "*"}]}`,
+			Expected: `{"Version": "2012-10-17", "Statement": [{"Sid": "Read", "Effect": "Allow", "Action": ["s3:GetObject", "s3:GetObjectVersion"], "Resource": ["arn:aws:s3:::first/*", "arn:aws:s3:::second/*"], "Principal": {"AWS": ["123456789012", "210987654321"], "Service": "ec2.amazonaws.com"}}, {"Sid": "", "Effect": "Allow", "Action": "sqs:SendMessage", "Resource": "*"}]}`,
+		},
+		{
+			Name:          "different effects",
+			Doc:           `{"Version": "2012-10-17", "Statement": [{"Sid": "Read", "Effect": "Allow", "Action": "s3:GetObject", "Resource": "*"}]}`,
+			NewDoc:        `{"Version": "2012-10-17", "Statement": [{"Sid": "Read", "Effect": "Deny", "Action": "s3:GetObject", "Resource": "*"}]}`,
+			ExpectedError: "effects differ",
+		},
+		{
+			Name:          "different conditions",
+			Doc:           `{"Version": "2012-10-17", "Statement": [{"Sid": "Read", "Effect": "Allow", "Action": "s3:GetObject", "Resource": "*"}]}`,
+			NewDoc:        `{"Version": "2012-10-17", "Statement": [{"Sid": "Read", "Effect": "Allow", "Action": "s3:GetObject", "Resource": "*", "Condition": {"Bool": {"aws:SecureTransport": "true"}}}]}`,
+			ExpectedError: "conditions differ",
+		},
+		{
+			Name:          "actions and not actions",
+			Doc:           `{"Version": "2012-10-17", "Statement": [{"Sid": "Read", "Effect": "Allow", "Action": "s3:GetObject", "Resource": "*"}]}`,
+			NewDoc:        `{"Version": "2012-10-17", "Statement": [{"Sid": "Read", "Effect": "Allow", "NotAction": "s3:GetObject", "Resource": "*"}]}`,
+			ExpectedError: "not_actions",
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			doc, newDoc := &policy.IAMPolicyDoc{}, &policy.IAMPolicyDoc{}
+
+			if err := json.Unmarshal([]byte(testCase.Doc), doc); err != nil {
+				t.Fatal(err)
+			}
+
+			if err := json.Unmarshal([]byte(testCase.NewDoc), newDoc); err != nil {
+				t.Fatal(err)
+			}
+
+			err := doc.MergeUnion(newDoc)
+
+			if testCase.ExpectedError != "" {
+				if err == nil || !strings.Contains(err.Error(), testCase.ExpectedError) {
+					t.Fatalf("got error %v, expected error containing %q", err, testCase.ExpectedError)
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			testPolicyDocEquivalent(t, doc, testCase.Expected)
+		})
+	}
+}
+
+func TestIAMPolicyDocDeduplicate(t *testing.T) {
+	doc := &policy.IAMPolicyDoc{}
+	document := `{"Version": "2012-10-17", "Statement": [{"Effect": "Allow", "Action": ["s3:GetObject", "s3:ListBucket"], "Resource": "*"}, {"Effect": "Allow", "Action": ["s3:ListBucket", "s3:GetObject"], "Resource": ["*"]}, {"Effect": "Deny", "Action": "s3:GetObject", "Resource": "*"}]}`
+
+	if err := json.Unmarshal([]byte(document), doc); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := doc.Deduplicate(); err != nil {
+		t.Fatal(err)
+	}
+
+	testPolicyDocEquivalent(t, doc, `{"Version": "2012-10-17", "Statement": [{"Sid": "", "Effect": "Allow", "Action": ["s3:GetObject", "s3:ListBucket"], "Resource": "*"}, {"Sid": "", "Effect": "Deny", "Action": "s3:GetObject", "Resource": "*"}]}`)
+}
+
+func TestIAMPolicyDocSplit(t *testing.T) {
+	var resources []string
+
+	for i := 0; i < 8; i++ {
+		resources = append(resources, fmt.Sprintf(`"arn:aws:s3:::bucket-%d/*"`, i))
+	}
+
+	document := fmt.Sprintf(`{"Version": "2012-10-17", "Statement": [{"Sid": "Read", "Effect": "Allow", "Action": "s3:GetObject", "Resource": [%s]}, {"Effect": "Allow", "Action": "sqs:SendMessage", "Resource": "*"}]}`, strings.Join(resources, ", "))
+
+	doc := &policy.IAMPolicyDoc{}
+
+	if err := json.Unmarshal([]byte(document), doc); err != nil {
+		t.Fatal(err)
+	}
+
+	docs, err := doc.Split(200)
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(docs) < 2 {
+		t.Fatalf("got %d documents, expected at least 2", len(docs))
+	}
+
+	var sids []string
+	var got []string
+
+	for _, doc := range docs {
+		b, err := json.Marshal(doc)
+
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if size := len(strings.Join(strings.Fields(string(b)), "")); size > 200 {
+			t.Errorf("document size %d exceeds 200: %s", size, b)
+		}
+
+		for _, statement := range doc.Statements {
+			sids = append(sids, statement.Sid)
+
+			switch v := statement.Resources.(type) {
+			case string:
+				got = append(got, v)
+			case []string:
+				got = append(got, v...)
+			}
+		}
+	}
+
+	if len(got) != len(resources)+1 {
+		t.Errorf("got %d resources, expected %d", len(got), len(resources)+1)
+	}
+
+	if sids[0] != "Read0" || sids[len(sids)-1] != "" {
+		t.Errorf("got Sids %v", sids)
+	}
+
+	if _, err := doc.Split(50); err == nil {
+		t.Error("expected error splitting into documents smaller than a statement")
+	}
+}
+
+func TestIAMPolicyDocUnmarshal(t *testing.T) {
+	testCases := []struct {
+		Name          string
+		Doc           string
+		Expected      string
+		ExpectedError bool
+	}{
+		{
+			Name:     "condition values",
+			Doc:      `{"Version": "2012-10-17", "Statement": [{"Effect": "Allow", "Action": "s3:ListBucket", "Resource": "*", "Condition": {"NumericLessThanEquals": {"s3:max-keys": 10}, "Bool": {"aws:SecureTransport": [true]}}}]}`,
+			Expected: `{"Version": "2012-10-17", "Statement": [{"Sid": "", "Effect": "Allow", "Action": "s3:ListBucket", "Resource": "*", "Condition": {"NumericLessThanEquals": {"s3:max-keys": "10"}, "Bool": {"aws:SecureTransport": ["true"]}}}]}`,
+		},
+		{
+			Name:          "invalid condition value",
+			Doc:           `{"Version": "2012-10-17", "Statement": [{"Effect": "Allow", "Action": "s3:ListBucket", "Resource": "*", "Condition": {"Bool": {"aws:SecureTransport": {"x": "y"}}}}]}`,
+			ExpectedError: true,
+		},
+		{
+			Name:          "invalid principal identifier",
+			Doc:           `{"Version": "2012-10-17", "Statement": [{"Effect": "Allow", "Action": "s3:ListBucket", "Resource": "*", "Principal": {"AWS": [123]}}]}`,
+			ExpectedError: true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			doc := &policy.IAMPolicyDoc{}
+			err := json.Unmarshal([]byte(testCase.Doc), doc)
+
+			if testCase.ExpectedError {
+				if err == nil {
+					t.Fatal("expected error")
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			testPolicyDocEquivalent(t, doc, testCase.Expected)
+		})
+	}
+}
+
+func testPolicyDocEquivalent(t *testing.T, doc *policy.IAMPolicyDoc, expected string) {
+	t.Helper()
+
+	b, err := json.Marshal(doc)
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	got, err := verify.NormalizePolicy(string(b))
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want, err := verify.NormalizePolicy(expected)
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if got != want {
+		t.Errorf("got policy:\n%s\nexpected:\n%s", got, want)
+	}
+}
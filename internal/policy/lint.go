@@ -0,0 +1,613 @@
+package policy
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	_ "embed"
+	"encoding/json"
+	"fmt"
+	"path"
+	"regexp"
+	"sort"
+	"strings"
+	"sync"
+	"unicode"
+
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"golang.org/x/exp/maps"
+	"golang.org/x/exp/slices"
+)
+
+// Type is the kind of policy a document is linted as.
+type Type string
+
+const (
+	TypeIdentity Type = "IDENTITY_POLICY"
+	TypeResource Type = "RESOURCE_POLICY"
+	TypeTrust    Type = "TRUST_POLICY"
+)
+
+func Type_Values() []string {
+	return []string{
+		string(TypeIdentity),
+		string(TypeResource),
+		string(TypeTrust),
+	}
+}
+
+const (
+	LintSeverityError   = "ERROR"
+	LintSeverityWarning = "WARNING"
+)
+
+// LintFinding is a problem found in a policy document.
+// StatementIndex is the zero-based index of the statement the finding applies to, or -1 for the whole document.
+type LintFinding struct {
+	Code           string
+	Severity       string
+	Message        string
+	Sid            string
+	StatementIndex int
+}
+
+func (f LintFinding) String() string {
+	var where string
+
+	if f.StatementIndex >= 0 {
+		where = fmt.Sprintf("statement %d", f.StatementIndex)
+
+		if f.Sid != "" {
+			where += fmt.Sprintf(" (Sid %q)", f.Sid)
+		}
+
+		where += ": "
+	}
+
+	return fmt.Sprintf("%s%s: %s", where, f.Code, f.Message)
+}
+
+//go:embed lint_catalogue.txt
+var lintCatalogueData []byte
+
+var (
+	lintCatalogue     map[string]map[string]struct{}
+	lintCatalogueOnce sync.Once
+)
+
+// lintServiceActions returns the known actions of the service with the specified lower-case prefix, keyed by lower-case name.
+// ok is false if the prefix is unknown. A nil map means that the service's actions are not checked.
+func lintServiceActions(prefix string) (actions map[string]struct{}, ok bool) {
+	lintCatalogueOnce.Do(func() {
+		lintCatalogue = make(map[string]map[string]struct{})
+
+		scanner := bufio.NewScanner(bytes.NewReader(lintCatalogueData))
+		scanner.Buffer(nil, len(lintCatalogueData))
+
+		for scanner.Scan() {
+			fields := strings.Fields(scanner.Text())
+
+			if len(fields) == 0 || strings.HasPrefix(fields[0], "#") {
+				continue
+			}
+
+			var actions map[string]struct{}
+
+			if len(fields) > 1 {
+				actions = make(map[string]struct{}, len(fields)-1)
+
+				for _, action := range fields[1:] {
+					actions[strings.ToLower(action)] = struct{}{}
+				}
+			}
+
+			lintCatalogue[fields[0]] = actions
+		}
+	})
+
+	actions, ok = lintCatalogue[prefix]
+
+	return actions, ok
+}
+
+var (
+	lintActionRegexp           = regexp.MustCompile(`^([A-Za-z0-9-]+):([A-Za-z0-9*?]+)$`)
+	lintAccountIDRegexp        = regexp.MustCompile(`^\d{12}$`)
+	lintCanonicalUserRegexp    = regexp.MustCompile(`^[0-9a-f]{64}$`)
+	lintIdentitySidRegexp      = regexp.MustCompile(`^[A-Za-z0-9]*$`)
+	lintServicePrincipalRegexp = regexp.MustCompile(`^[a-z0-9.-]+\.amazonaws\.com(\.cn)?$`)
+	lintVariableRegexp         = regexp.MustCompile(`\$\{[^}]*\}`)
+)
+
+var (
+	lintConditionOperators = []string{
+		"ArnEquals",
+		"ArnLike",
+		"ArnNotEquals",
+		"ArnNotLike",
+		"BinaryEquals",
+		"Bool",
+		"DateEquals",
+		"DateGreaterThan",
+		"DateGreaterThanEquals",
+		"DateLessThan",
+		"DateLessThanEquals",
+		"DateNotEquals",
+		"IpAddress",
+		"NotIpAddress",
+		"Null",
+		"NumericEquals",
+		"NumericGreaterThan",
+		"NumericGreaterThanEquals",
+		"NumericLessThan",
+		"NumericLessThanEquals",
+		"NumericNotEquals",
+		"StringEquals",
+		"StringEqualsIgnoreCase",
+		"StringLike",
+		"StringNotEquals",
+		"StringNotEqualsIgnoreCase",
+		"StringNotLike",
+	}
+	lintPartitions = []string{
+		"aws",
+		"aws-cn",
+		"aws-iso",
+		"aws-iso-b",
+		"aws-iso-e",
+		"aws-iso-f",
+		"aws-us-gov",
+	}
+	lintPolicyElements    = []string{"Id", "Statement", "Version"}
+	lintPrincipalTypes    = []string{"AWS", "CanonicalUser", "Federated", "Service"}
+	lintStatementElements = []string{"Action", "Condition", "Effect", "NotAction", "NotPrincipal", "NotResource", "Principal", "Resource", "Sid"}
+)
+
+type linter struct {
+	findings   []LintFinding
+	policyType Type
+}
+
+func (l *linter) add(severity string, index int, sid, code, format string, a ...interface{}) {
+	l.findings = append(l.findings, LintFinding{
+		Code:           code,
+		Severity:       severity,
+		Message:        fmt.Sprintf(format, a...),
+		Sid:            sid,
+		StatementIndex: index,
+	})
+}
+
+// Lint checks a policy document offline and returns any problems found.
+// A maxSize of 0 disables the size check.
+func Lint(policy string, policyType Type, maxSize int) []LintFinding {
+	l := &linter{policyType: policyType}
+
+	var raw map[string]json.RawMessage
+
+	if err := json.Unmarshal([]byte(policy), &raw); err != nil {
+		l.add(LintSeverityError, -1, "", "INVALID_JSON", "policy is not a JSON object: %s", err)
+
+		return l.findings
+	}
+
+	if size := Size(policy); maxSize > 0 && size > maxSize {
+		l.add(LintSeverityError, -1, "", "POLICY_SIZE_EXCEEDED", "policy is %d characters long, not counting white space, which exceeds the limit of %d", size, maxSize)
+	}
+
+	for _, key := range sortedKeys(raw) {
+		if !slices.Contains(lintPolicyElements, key) {
+			l.add(LintSeverityError, -1, "", "UNKNOWN_ELEMENT", "unknown policy element %q", key)
+		}
+	}
+
+	var version string
+
+	if v, ok := raw["Version"]; !ok {
+		l.add(LintSeverityWarning, -1, "", "MISSING_VERSION", "policy has no Version, policy variables are not supported")
+	} else if err := json.Unmarshal(v, &version); err != nil || (version != "2012-10-17" && version != "2008-10-17") {
+		l.add(LintSeverityError, -1, "", "INVALID_VERSION", "Version must be \"2012-10-17\" or \"2008-10-17\", got %s", v)
+	}
+
+	v, ok := raw["Statement"]
+
+	if !ok {
+		l.add(LintSeverityError, -1, "", "MISSING_STATEMENT", "policy has no Statement")
+
+		return l.findings
+	}
+
+	var statements []map[string]json.RawMessage
+
+	// Statement can be a single statement object or an array of them.
+	if v := bytes.TrimSpace(v); len(v) > 0 && v[0] == '{' {
+		var statement map[string]json.RawMessage
+
+		if err := json.Unmarshal(v, &statement); err != nil {
+			l.add(LintSeverityError, -1, "", "INVALID_STATEMENT", "Statement must be an object or an array of objects")
+
+			return l.findings
+		}
+
+		statements = append(statements, statement)
+	} else if err := json.Unmarshal(v, &statements); err != nil {
+		l.add(LintSeverityError, -1, "", "INVALID_STATEMENT", "Statement must be an object or an array of objects")
+
+		return l.findings
+	}
+
+	if len(statements) == 0 {
+		l.add(LintSeverityError, -1, "", "MISSING_STATEMENT", "policy has no statements")
+
+		return l.findings
+	}
+
+	sids := make(map[string]int)
+
+	for i, raw := range statements {
+		statement := l.decodeStatement(i, raw)
+
+		if statement.Sid != "" {
+			if j, ok := sids[statement.Sid]; ok {
+				l.add(LintSeverityError, i, statement.Sid, "DUPLICATE_SID", "Sid is also used by statement %d", j)
+			} else {
+				sids[statement.Sid] = i
+			}
+		}
+	}
+
+	return l.findings
+}
+
+// decodeStatement checks a statement's elements and decodes the well-formed ones into the policy model.
+func (l *linter) decodeStatement(i int, raw map[string]json.RawMessage) *IAMPolicyStatement {
+	statement := &IAMPolicyStatement{}
+
+	if v, ok := raw["Sid"]; ok {
+		if err := json.Unmarshal(v, &statement.Sid); err != nil {
+			l.add(LintSeverityError, i, "", "INVALID_SID", "Sid must be a string")
+		}
+	}
+
+	sid := statement.Sid
+
+	for _, key := range sortedKeys(raw) {
+		if !slices.Contains(lintStatementElements, key) {
+			l.add(LintSeverityError, i, sid, "UNKNOWN_ELEMENT", "unknown statement element %q", key)
+		}
+	}
+
+	if (l.policyType == TypeIdentity || l.policyType == TypeTrust) && !lintIdentitySidRegexp.MatchString(sid) {
+		l.add(LintSeverityError, i, sid, "INVALID_SID", "Sid must contain only letters and digits")
+	}
+
+	if v, ok := raw["Effect"]; !ok {
+		l.add(LintSeverityError, i, sid, "MISSING_EFFECT", "statement has no Effect")
+	} else if err := json.Unmarshal(v, &statement.Effect); err != nil || (statement.Effect != "Allow" && statement.Effect != "Deny") {
+		l.add(LintSeverityError, i, sid, "INVALID_EFFECT", "Effect must be \"Allow\" or \"Deny\", got %s", v)
+	}
+
+	statement.Actions = l.decodeStrings(i, sid, raw, "Action")
+	statement.NotActions = l.decodeStrings(i, sid, raw, "NotAction")
+	statement.Resources = l.decodeStrings(i, sid, raw, "Resource")
+	statement.NotResources = l.decodeStrings(i, sid, raw, "NotResource")
+	statement.Principals = l.decodePrincipals(i, sid, raw, "Principal")
+	statement.NotPrincipals = l.decodePrincipals(i, sid, raw, "NotPrincipal")
+
+	_, hasAction := raw["Action"]
+	_, hasNotAction := raw["NotAction"]
+
+	switch {
+	case hasAction && hasNotAction:
+		l.add(LintSeverityError, i, sid, "INVALID_ACTION", "statement cannot have both Action and NotAction")
+	case !hasAction && !hasNotAction:
+		l.add(LintSeverityError, i, sid, "MISSING_ACTION", "statement has no Action or NotAction")
+	}
+
+	for _, v := range append(policyStrings(statement.Actions), policyStrings(statement.NotActions)...) {
+		l.lintAction(i, sid, v)
+	}
+
+	_, hasResource := raw["Resource"]
+	_, hasNotResource := raw["NotResource"]
+
+	switch {
+	case hasResource && hasNotResource:
+		l.add(LintSeverityError, i, sid, "INVALID_RESOURCE", "statement cannot have both Resource and NotResource")
+	case l.policyType == TypeTrust && (hasResource || hasNotResource):
+		l.add(LintSeverityError, i, sid, "UNSUPPORTED_RESOURCE", "trust policy statements cannot have Resource or NotResource")
+	case l.policyType != TypeTrust && !hasResource && !hasNotResource:
+		l.add(LintSeverityError, i, sid, "MISSING_RESOURCE", "statement has no Resource or NotResource")
+	}
+
+	for _, v := range append(policyStrings(statement.Resources), policyStrings(statement.NotResources)...) {
+		if !lintValidARN(v) {
+			l.add(LintSeverityError, i, sid, "INVALID_ARN", "%q is not \"*\" or a valid ARN", v)
+		}
+	}
+
+	_, hasPrincipal := raw["Principal"]
+	_, hasNotPrincipal := raw["NotPrincipal"]
+
+	switch {
+	case hasPrincipal && hasNotPrincipal:
+		l.add(LintSeverityError, i, sid, "INVALID_PRINCIPAL", "statement cannot have both Principal and NotPrincipal")
+	case l.policyType == TypeIdentity && (hasPrincipal || hasNotPrincipal):
+		l.add(LintSeverityError, i, sid, "UNSUPPORTED_PRINCIPAL", "identity policy statements cannot have Principal or NotPrincipal")
+	case l.policyType != TypeIdentity && !hasPrincipal && !hasNotPrincipal:
+		l.add(LintSeverityError, i, sid, "MISSING_PRINCIPAL", "statement has no Principal or NotPrincipal")
+	}
+
+	for _, p := range append(statement.Principals, statement.NotPrincipals...) {
+		l.lintPrincipal(i, sid, p)
+	}
+
+	if v, ok := raw["Condition"]; ok {
+		l.lintCondition(i, sid, v)
+	}
+
+	return statement
+}
+
+// decodeStrings decodes a statement element that is a string or an array of strings.
+func (l *linter) decodeStrings(i int, sid string, raw map[string]json.RawMessage, key string) interface{} {
+	v, ok := raw[key]
+
+	if !ok {
+		return nil
+	}
+
+	var value interface{}
+
+	if err := json.Unmarshal(v, &value); err == nil {
+		switch value := value.(type) {
+		case string:
+			return value
+		case []interface{}:
+			// policyStrings skips values that aren't strings.
+			if ss := policyStrings(value); len(ss) > 0 && len(ss) == len(value) {
+				return value
+			}
+		}
+	}
+
+	l.add(LintSeverityError, i, sid, fmt.Sprintf("INVALID_%s", strings.ToUpper(strings.TrimPrefix(key, "Not"))), "%s must be a string or a non-empty array of strings", key)
+
+	return nil
+}
+
+// decodePrincipals decodes a statement's Principal or NotPrincipal element.
+func (l *linter) decodePrincipals(i int, sid string, raw map[string]json.RawMessage, key string) IAMPolicyStatementPrincipalSet {
+	v, ok := raw[key]
+
+	if !ok {
+		return nil
+	}
+
+	var s string
+
+	// The policy model reads any string as "*".
+	if err := json.Unmarshal(v, &s); err == nil && s != "*" {
+		l.add(LintSeverityError, i, sid, "INVALID_PRINCIPAL", "%s must be \"*\" or an object, got %q", key, s)
+
+		return nil
+	}
+
+	var principals IAMPolicyStatementPrincipalSet
+
+	if err := json.Unmarshal(v, &principals); err != nil {
+		l.add(LintSeverityError, i, sid, "INVALID_PRINCIPAL", "%s must be \"*\" or an object of principal types to strings or arrays of strings", key)
+
+		return nil
+	}
+
+	sort.Slice(principals, func(i, j int) bool {
+		return principals[i].Type < principals[j].Type
+	})
+
+	return principals
+}
+
+func (l *linter) lintAction(i int, sid, action string) {
+	if action == "*" {
+		return
+	}
+
+	m := lintActionRegexp.FindStringSubmatch(action)
+
+	if m == nil {
+		l.add(LintSeverityError, i, sid, "INVALID_ACTION", "%q is not \"*\" or of the form \"service:Action\"", action)
+
+		return
+	}
+
+	prefix, name := strings.ToLower(m[1]), strings.ToLower(m[2])
+	actions, ok := lintServiceActions(prefix)
+
+	if !ok {
+		l.add(LintSeverityWarning, i, sid, "UNKNOWN_SERVICE", "%q has an unknown service prefix %q", action, m[1])
+
+		return
+	}
+
+	if actions == nil {
+		return
+	}
+
+	if !strings.ContainsAny(name, "*?") {
+		if _, ok := actions[name]; !ok {
+			l.add(LintSeverityWarning, i, sid, "UNKNOWN_ACTION", "%q is not a known %s action", action, prefix)
+		}
+
+		return
+	}
+
+	for v := range actions {
+		if ok, _ := path.Match(name, v); ok {
+			return
+		}
+	}
+
+	l.add(LintSeverityWarning, i, sid, "UNKNOWN_ACTION", "%q matches no known %s action", action, prefix)
+}
+
+func (l *linter) lintPrincipal(i int, sid string, p IAMPolicyStatementPrincipal) {
+	if p.Type == "*" {
+		return
+	}
+
+	if !slices.Contains(lintPrincipalTypes, p.Type) {
+		l.add(LintSeverityError, i, sid, "INVALID_PRINCIPAL", "unknown principal type %q, expected one of %s", p.Type, strings.Join(lintPrincipalTypes, ", "))
+
+		return
+	}
+
+	for _, v := range policyStrings(p.Identifiers) {
+		switch p.Type {
+		case "AWS":
+			if v != "*" && !lintAccountIDRegexp.MatchString(v) && !lintValidARN(v) {
+				l.add(LintSeverityError, i, sid, "INVALID_PRINCIPAL", "AWS principal %q is not \"*\", an account ID or an ARN", v)
+			}
+		case "CanonicalUser":
+			if !lintCanonicalUserRegexp.MatchString(v) {
+				l.add(LintSeverityError, i, sid, "INVALID_PRINCIPAL", "CanonicalUser principal %q is not a canonical user ID", v)
+			}
+		case "Federated":
+			if v == "" {
+				l.add(LintSeverityError, i, sid, "INVALID_PRINCIPAL", "Federated principal is empty")
+			}
+		case "Service":
+			if !lintServicePrincipalRegexp.MatchString(v) {
+				l.add(LintSeverityWarning, i, sid, "UNKNOWN_SERVICE_PRINCIPAL", "Service principal %q is not of the form \"service.amazonaws.com\"", v)
+			}
+		}
+	}
+}
+
+// lintCondition checks a statement's Condition element.
+func (l *linter) lintCondition(i int, sid string, raw json.RawMessage) {
+	var conditions map[string]map[string]interface{}
+
+	if err := json.Unmarshal(raw, &conditions); err != nil {
+		l.add(LintSeverityError, i, sid, "INVALID_CONDITION", "Condition must be an object of condition operators to objects of condition keys and values")
+
+		return
+	}
+
+	for _, operator := range sortedKeys(conditions) {
+		keys := conditions[operator]
+
+		if !lintValidConditionOperator(operator) {
+			l.add(LintSeverityError, i, sid, "INVALID_CONDITION_OPERATOR", "unknown condition operator %q", operator)
+		}
+
+		for _, key := range sortedKeys(keys) {
+			v := keys[key]
+
+			if key == "" {
+				l.add(LintSeverityError, i, sid, "INVALID_CONDITION", "condition operator %q has an empty condition key", operator)
+			}
+
+			if _, err := decodeConditionValues(v); err != nil {
+				l.add(LintSeverityError, i, sid, "INVALID_CONDITION", "condition key %q values must be strings, numbers or booleans", key)
+			}
+		}
+	}
+}
+
+func lintValidConditionOperator(operator string) bool {
+	if v := strings.TrimPrefix(operator, "ForAllValues:"); v != operator {
+		operator = v
+	} else {
+		operator = strings.TrimPrefix(operator, "ForAnyValue:")
+	}
+
+	if v := strings.TrimSuffix(operator, "IfExists"); v != operator {
+		if strings.EqualFold(v, "Null") {
+			return false
+		}
+
+		operator = v
+	}
+
+	for _, v := range lintConditionOperators {
+		if strings.EqualFold(v, operator) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// lintValidARN returns whether s is "*" or has the form of an ARN, allowing wildcards and policy variables.
+func lintValidARN(s string) bool {
+	if s == "*" {
+		return true
+	}
+
+	// Policy variables, e.g. ${aws:username}, can contain colons.
+	parts := strings.SplitN(lintVariableRegexp.ReplaceAllString(s, "${}"), ":", 6)
+
+	if len(parts) != 6 || parts[0] != "arn" || parts[2] == "" {
+		return false
+	}
+
+	if partition := parts[1]; !slices.Contains(lintPartitions, partition) && !strings.ContainsAny(partition, "*?") && !strings.Contains(partition, "${}") {
+		return false
+	}
+
+	return true
+}
+
+// Size returns the size of a policy as counted by IAM, which ignores white space.
+func Size(policy string) int {
+	var n int
+
+	for _, r := range policy {
+		if !unicode.IsSpace(r) {
+			n++
+		}
+	}
+
+	return n
+}
+
+// sortedKeys returns a map's keys in order, so that findings are reported in a stable order.
+func sortedKeys[V any](m map[string]V) []string {
+	keys := maps.Keys(m)
+	slices.Sort(keys)
+
+	return keys
+}
+
+// LintCustomizeDiff returns a CustomizeDiffFunc that fails the plan if the policy document in the specified attribute has errors.
+// The check is made only if the provider's lint_iam_policies argument is set.
+func LintCustomizeDiff(key string, policyType Type, maxSize int) schema.CustomizeDiffFunc {
+	return func(_ context.Context, d *schema.ResourceDiff, meta interface{}) error {
+		if client, ok := meta.(*conns.AWSClient); !ok || !client.LintIAMPolicies() {
+			return nil
+		}
+
+		if !d.HasChange(key) || !d.NewValueKnown(key) {
+			return nil
+		}
+
+		policy := d.Get(key).(string)
+
+		if policy == "" {
+			return nil
+		}
+
+		var errs *multierror.Error
+
+		for _, finding := range Lint(policy, policyType, maxSize) {
+			if finding.Severity == LintSeverityError {
+				errs = multierror.Append(errs, fmt.Errorf("%s: %s", key, finding))
+			}
+		}
+
+		return errs.ErrorOrNil()
+	}
+}
@@ -0,0 +1,130 @@
+package policy
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+)
+
+func TestLint(t *testing.T) {
+	testCases := []struct {
+		Name       string
+		Policy     string
+		PolicyType Type
+		MaxSize    int
+		Expected   []string
+	}{
+		{
+			Name: "valid identity policy",
+			Policy: `{
+  "Version": "2012-10-17",
+  "Statement": [{
+    "Sid": "Read",
+    "Effect": "Allow",
+    "Action": ["s3:GetObject", "kms:Decrypt", "sqs:Receive*"],
+    "Resource": "arn:aws:s3:::example/${aws:username}/*",
+    "Condition": {"ForAnyValue:StringLikeIfExists": {"aws:PrincipalTag/team": ["a", "b"]}, "NumericLessThan": {"aws:MultiFactorAuthAge": 3600}}
+  }]
+}`,
+			PolicyType: TypeIdentity,
+		},
+		{
+			Name:       "valid trust policy",
+			Policy:     `{"Version": "2012-10-17", "Statement": {"Effect": "Allow", "Action": "sts:AssumeRole", "Principal": {"Service": "ec2.amazonaws.com", "AWS": ["123456789012", "arn:aws:iam::123456789012:root"]}}}`,
+			PolicyType: TypeTrust,
+		},
+		{
+			Name:       "invalid JSON",
+			Policy:     `[]`,
+			PolicyType: TypeIdentity,
+			Expected:   []string{"ERROR INVALID_JSON -1"},
+		},
+		{
+			Name:       "document elements",
+			Policy:     `{"Version": "2012-10-18", "statement": []}`,
+			PolicyType: TypeIdentity,
+			Expected:   []string{"ERROR UNKNOWN_ELEMENT -1", "ERROR INVALID_VERSION -1", "ERROR MISSING_STATEMENT -1"},
+		},
+		{
+			Name:       "missing version",
+			Policy:     `{"Statement": [{"Effect": "Allow", "Action": "*", "Resource": "*"}]}`,
+			PolicyType: TypeIdentity,
+			Expected:   []string{"WARNING MISSING_VERSION -1"},
+		},
+		{
+			Name:       "statement elements",
+			Policy:     `{"Version": "2012-10-17", "Statement": [{"Sid": "Not valid", "Effect": "allow", "Actions": "s3:GetObject", "Resource": "*"}]}`,
+			PolicyType: TypeIdentity,
+			Expected:   []string{"ERROR UNKNOWN_ELEMENT 0", "ERROR INVALID_SID 0", "ERROR INVALID_EFFECT 0", "ERROR MISSING_ACTION 0"},
+		},
+		{
+			Name:       "actions",
+			Policy:     `{"Version": "2012-10-17", "Statement": [{"Effect": "Allow", "Action": ["s3GetObject", "nosuchservice:Get", "kms:NoSuchAction", "sts:Nope*", "ec2:AnythingGoes"], "Resource": "*"}]}`,
+			PolicyType: TypeIdentity,
+			Expected:   []string{"ERROR INVALID_ACTION 0", "WARNING UNKNOWN_SERVICE 0", "WARNING UNKNOWN_ACTION 0", "WARNING UNKNOWN_ACTION 0"},
+		},
+		{
+			Name:       "resources",
+			Policy:     `{"Version": "2012-10-17", "Statement": [{"Effect": "Allow", "Action": "s3:*", "Resource": ["example", "arn:aws-nope:s3:::example", "arn:${aws:partition}:s3:::example"]}, {"Effect": "Allow", "Action": "s3:*"}]}`,
+			PolicyType: TypeIdentity,
+			Expected:   []string{"ERROR INVALID_ARN 0", "ERROR INVALID_ARN 0", "ERROR MISSING_RESOURCE 1"},
+		},
+		{
+			Name:       "identity policy principal",
+			Policy:     `{"Version": "2012-10-17", "Statement": [{"Effect": "Allow", "Action": "s3:*", "Resource": "*", "Principal": "*"}]}`,
+			PolicyType: TypeIdentity,
+			Expected:   []string{"ERROR UNSUPPORTED_PRINCIPAL 0"},
+		},
+		{
+			Name:       "principals",
+			Policy:     `{"Version": "2012-10-17", "Statement": [{"Effect": "Allow", "Action": "s3:*", "Resource": "*", "Principal": {"AWS": "someone", "CanonicalUser": "abc", "Service": "example.com", "User": "x"}}, {"Effect": "Allow", "Action": "s3:*", "Resource": "*"}]}`,
+			PolicyType: TypeResource,
+			Expected:   []string{"ERROR INVALID_PRINCIPAL 0", "ERROR INVALID_PRINCIPAL 0", "WARNING UNKNOWN_SERVICE_PRINCIPAL 0", "ERROR INVALID_PRINCIPAL 0", "ERROR MISSING_PRINCIPAL 1"},
+		},
+		{
+			Name:       "malformed principal",
+			Policy:     `{"Version": "2012-10-17", "Statement": [{"Effect": "Allow", "Action": "s3:*", "Resource": "*", "Principal": {"AWS": [123]}}]}`,
+			PolicyType: TypeResource,
+			Expected:   []string{"ERROR INVALID_PRINCIPAL 0"},
+		},
+		{
+			Name:       "trust policy resource",
+			Policy:     `{"Version": "2012-10-17", "Statement": [{"Effect": "Allow", "Action": "sts:AssumeRole", "Resource": "*", "Principal": {"Service": "ec2.amazonaws.com"}}]}`,
+			PolicyType: TypeTrust,
+			Expected:   []string{"ERROR UNSUPPORTED_RESOURCE 0"},
+		},
+		{
+			Name:       "conditions",
+			Policy:     `{"Version": "2012-10-17", "Statement": [{"Effect": "Allow", "Action": "s3:*", "Resource": "*", "Condition": {"StringEqualz": {"aws:username": "x"}, "NullIfExists": {"aws:username": true}, "Bool": {"aws:SecureTransport": {"x": "y"}}}}]}`,
+			PolicyType: TypeIdentity,
+			Expected:   []string{"ERROR INVALID_CONDITION 0", "ERROR INVALID_CONDITION_OPERATOR 0", "ERROR INVALID_CONDITION_OPERATOR 0"},
+		},
+		{
+			Name:       "duplicate Sids",
+			Policy:     `{"Version": "2012-10-17", "Statement": [{"Sid": "A", "Effect": "Allow", "Action": "s3:*", "Resource": "*"}, {"Sid": "A", "Effect": "Deny", "Action": "s3:*", "Resource": "*"}]}`,
+			PolicyType: TypeIdentity,
+			Expected:   []string{"ERROR DUPLICATE_SID 1"},
+		},
+		{
+			Name:       "size",
+			Policy:     fmt.Sprintf(`{"Version": "2012-10-17", "Statement": [{"Effect": "Allow", "Action": "s3:*", "Resource": %q}]}`, "arn:aws:s3:::"+strings.Repeat("x", 100)),
+			PolicyType: TypeIdentity,
+			MaxSize:    100,
+			Expected:   []string{"ERROR POLICY_SIZE_EXCEEDED -1"},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			var got []string
+
+			for _, finding := range Lint(testCase.Policy, testCase.PolicyType, testCase.MaxSize) {
+				got = append(got, fmt.Sprintf("%s %s %d", finding.Severity, finding.Code, finding.StatementIndex))
+			}
+
+			if strings.Join(got, "\n") != strings.Join(testCase.Expected, "\n") {
+				t.Errorf("got findings:\n%s\nexpected:\n%s", strings.Join(got, "\n"), strings.Join(testCase.Expected, "\n"))
+			}
+		})
+	}
+}
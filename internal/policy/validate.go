@@ -0,0 +1,146 @@
+package policy
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/accessanalyzer"
+	"github.com/aws/aws-sdk-go/service/accessanalyzer/accessanalyzeriface"
+	multierror "github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"golang.org/x/exp/slices"
+)
+
+// ValidationFindingType_Values returns the types of Access Analyzer policy validation finding.
+func ValidationFindingType_Values() []string {
+	return accessanalyzer.ValidatePolicyFindingType_Values()
+}
+
+// FindValidationFindings returns the findings of validating a policy with Access Analyzer.
+// Custom policy checks, such as CheckNoNewAccess, aren't supported: they need AWS SDK for Go v1.48.4 or later,
+// which drops the Macie Classic API still used by the macie service package.
+// conn is an interface so that tests can substitute a stand-in that returns canned findings.
+func FindValidationFindings(ctx context.Context, conn accessanalyzeriface.AccessAnalyzerAPI, input *accessanalyzer.ValidatePolicyInput) ([]*accessanalyzer.ValidatePolicyFinding, error) {
+	var output []*accessanalyzer.ValidatePolicyFinding
+
+	err := conn.ValidatePolicyPagesWithContext(ctx, input, func(page *accessanalyzer.ValidatePolicyOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.Findings {
+			if v != nil {
+				output = append(output, v)
+			}
+		}
+
+		return !lastPage
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return output, nil
+}
+
+// ValidationError returns an error describing each finding of one of the specified types, or nil if there are none.
+func ValidationError(key string, findings []*accessanalyzer.ValidatePolicyFinding, findingTypes []string) error {
+	var errs *multierror.Error
+
+	for _, finding := range findings {
+		if !slices.Contains(findingTypes, aws.StringValue(finding.FindingType)) {
+			continue
+		}
+
+		err := fmt.Errorf("%s: Access Analyzer %s %s: %s", key, aws.StringValue(finding.FindingType), aws.StringValue(finding.IssueCode), aws.StringValue(finding.FindingDetails))
+
+		if paths := ValidationFindingPaths(finding); len(paths) > 0 {
+			err = fmt.Errorf("%w (%s)", err, strings.Join(paths, ", "))
+		}
+
+		errs = multierror.Append(errs, err)
+	}
+
+	return errs.ErrorOrNil()
+}
+
+// ValidateCustomizeDiff returns a CustomizeDiffFunc that fails the plan if Access Analyzer policy validation of the policy document
+// in the specified attribute has findings of the types in the provider's validate_with_access_analyzer argument.
+// validatePolicyResourceType is optional.
+func ValidateCustomizeDiff(key, policyType, validatePolicyResourceType string) schema.CustomizeDiffFunc {
+	return func(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+		client, ok := meta.(*conns.AWSClient)
+
+		if !ok || len(client.ValidateWithAccessAnalyzer()) == 0 {
+			return nil
+		}
+
+		if !d.HasChange(key) || !d.NewValueKnown(key) {
+			return nil
+		}
+
+		policy := d.Get(key).(string)
+
+		if policy == "" {
+			return nil
+		}
+
+		input := &accessanalyzer.ValidatePolicyInput{
+			PolicyDocument: aws.String(policy),
+			PolicyType:     aws.String(policyType),
+		}
+
+		if validatePolicyResourceType != "" {
+			input.ValidatePolicyResourceType = aws.String(validatePolicyResourceType)
+		}
+
+		findings, err := FindValidationFindings(ctx, client.AccessAnalyzerConn(), input)
+
+		if err != nil {
+			return fmt.Errorf("validating %s with Access Analyzer: %w", key, err)
+		}
+
+		return ValidationError(key, findings, client.ValidateWithAccessAnalyzer())
+	}
+}
+
+// ValidationFindingPaths returns the location of each of a finding's locations in the policy, e.g. "Statement[0].Action[1]".
+func ValidationFindingPaths(finding *accessanalyzer.ValidatePolicyFinding) []string {
+	var paths []string
+
+	for _, location := range finding.Locations {
+		if location == nil {
+			continue
+		}
+
+		var b strings.Builder
+
+		for _, element := range location.Path {
+			switch {
+			case element == nil:
+			case element.Index != nil:
+				fmt.Fprintf(&b, "[%d]", aws.Int64Value(element.Index))
+			case element.Key != nil:
+				if b.Len() > 0 {
+					b.WriteString(".")
+				}
+				b.WriteString(aws.StringValue(element.Key))
+			case element.Value != nil:
+				fmt.Fprintf(&b, "[%q]", aws.StringValue(element.Value))
+			case element.Substring != nil:
+				start := aws.Int64Value(element.Substring.Start)
+				fmt.Fprintf(&b, "[%d:%d]", start, start+aws.Int64Value(element.Substring.Length))
+			}
+		}
+
+		if b.Len() > 0 {
+			paths = append(paths, b.String())
+		}
+	}
+
+	return paths
+}
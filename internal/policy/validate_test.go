@@ -0,0 +1,112 @@
+package policy
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/aws-sdk-go/service/accessanalyzer"
+	"github.com/aws/aws-sdk-go/service/accessanalyzer/accessanalyzeriface"
+)
+
+// policyValidationStandIn is a stand-in for the Access Analyzer API that returns canned policy validation findings.
+type policyValidationStandIn struct {
+	accessanalyzeriface.AccessAnalyzerAPI
+
+	pages [][]*accessanalyzer.ValidatePolicyFinding
+	input *accessanalyzer.ValidatePolicyInput
+}
+
+func (c *policyValidationStandIn) ValidatePolicyPagesWithContext(_ aws.Context, input *accessanalyzer.ValidatePolicyInput, fn func(*accessanalyzer.ValidatePolicyOutput, bool) bool, _ ...request.Option) error {
+	c.input = input
+
+	for i, findings := range c.pages {
+		if !fn(&accessanalyzer.ValidatePolicyOutput{Findings: findings}, i == len(c.pages)-1) {
+			break
+		}
+	}
+
+	return nil
+}
+
+func TestValidation(t *testing.T) {
+	conn := &policyValidationStandIn{
+		pages: [][]*accessanalyzer.ValidatePolicyFinding{
+			{
+				{
+					FindingDetails: aws.String("The action s3:GetObjekt does not exist."),
+					FindingType:    aws.String(accessanalyzer.ValidatePolicyFindingTypeError),
+					IssueCode:      aws.String("INVALID_ACTION"),
+					Locations: []*accessanalyzer.Location{{
+						Path: []*accessanalyzer.PathElement{
+							{Key: aws.String("Statement")},
+							{Index: aws.Int64(0)},
+							{Key: aws.String("Action")},
+							{Index: aws.Int64(1)},
+						},
+					}},
+				},
+			},
+			{
+				{
+					FindingDetails: aws.String("Using PassRole with a wildcard resource is overly permissive."),
+					FindingType:    aws.String(accessanalyzer.ValidatePolicyFindingTypeSecurityWarning),
+					IssueCode:      aws.String("PASS_ROLE_WITH_STAR_IN_RESOURCE"),
+				},
+				{
+					FindingDetails: aws.String("Add a value to the empty array."),
+					FindingType:    aws.String(accessanalyzer.ValidatePolicyFindingTypeSuggestion),
+					IssueCode:      aws.String("EMPTY_ARRAY_ACTION"),
+				},
+			},
+		},
+	}
+
+	input := &accessanalyzer.ValidatePolicyInput{
+		PolicyDocument: aws.String(`{"Version": "2012-10-17", "Statement": []}`),
+		PolicyType:     aws.String(accessanalyzer.PolicyTypeIdentityPolicy),
+	}
+
+	findings, err := FindValidationFindings(context.Background(), conn, input)
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if conn.input != input {
+		t.Error("input not passed to ValidatePolicy")
+	}
+
+	if got, expected := len(findings), 3; got != expected {
+		t.Fatalf("got %d findings, expected %d", got, expected)
+	}
+
+	if err := ValidationError("policy", findings, nil); err != nil {
+		t.Errorf("got error with no finding types: %s", err)
+	}
+
+	if err := ValidationError("policy", findings, []string{accessanalyzer.ValidatePolicyFindingTypeWarning}); err != nil {
+		t.Errorf("got error with no findings of the finding types: %s", err)
+	}
+
+	err = ValidationError("policy", findings, []string{accessanalyzer.ValidatePolicyFindingTypeError, accessanalyzer.ValidatePolicyFindingTypeSecurityWarning})
+
+	if err == nil {
+		t.Fatal("expected error")
+	}
+
+	for _, expected := range []string{
+		"policy: Access Analyzer ERROR INVALID_ACTION: The action s3:GetObjekt does not exist. (Statement[0].Action[1])",
+		"policy: Access Analyzer SECURITY_WARNING PASS_ROLE_WITH_STAR_IN_RESOURCE",
+	} {
+		if !strings.Contains(err.Error(), expected) {
+			t.Errorf("error %q does not contain %q", err, expected)
+		}
+	}
+
+	if strings.Contains(err.Error(), "EMPTY_ARRAY_ACTION") {
+		t.Errorf("error %q contains a finding of another type", err)
+	}
+}
@@ -0,0 +1,93 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+)
+
+// tagsAllAttributeName is the name of the attribute that marks a resource as supporting provider default tags.
+const tagsAllAttributeName = "tags_all"
+
+// addDefaultTagsResourceType ensures that taggable resources are called with an AWSClient
+// whose default tags are those for the resource's type.
+func addDefaultTagsResourceType(resources map[string]*schema.Resource) {
+	for typeName, r := range resources {
+		if _, ok := r.Schema[tagsAllAttributeName]; !ok {
+			continue
+		}
+
+		wrapDefaultTagsResource(typeName, r)
+	}
+}
+
+func wrapDefaultTagsResource(typeName string, r *schema.Resource) {
+	resourceTypeClient := func(meta any) any {
+		if client, ok := meta.(*conns.AWSClient); ok {
+			return client.ResourceTypeClient(typeName)
+		}
+
+		return meta
+	}
+
+	wrap := func(f func(*schema.ResourceData, any) error) func(*schema.ResourceData, any) error {
+		if f == nil {
+			return nil
+		}
+
+		return func(d *schema.ResourceData, meta any) error {
+			return f(d, resourceTypeClient(meta))
+		}
+	}
+
+	wrapContext := func(f func(context.Context, *schema.ResourceData, any) diag.Diagnostics) func(context.Context, *schema.ResourceData, any) diag.Diagnostics {
+		if f == nil {
+			return nil
+		}
+
+		return func(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+			return f(ctx, d, resourceTypeClient(meta))
+		}
+	}
+
+	r.Create = wrap(r.Create)
+	r.Read = wrap(r.Read)
+	r.Update = wrap(r.Update)
+	r.Delete = wrap(r.Delete)
+	r.CreateContext = wrapContext(r.CreateContext)
+	r.ReadContext = wrapContext(r.ReadContext)
+	r.UpdateContext = wrapContext(r.UpdateContext)
+	r.DeleteContext = wrapContext(r.DeleteContext)
+	r.CreateWithoutTimeout = wrapContext(r.CreateWithoutTimeout)
+	r.ReadWithoutTimeout = wrapContext(r.ReadWithoutTimeout)
+	r.UpdateWithoutTimeout = wrapContext(r.UpdateWithoutTimeout)
+	r.DeleteWithoutTimeout = wrapContext(r.DeleteWithoutTimeout)
+
+	if f := r.Exists; f != nil {
+		r.Exists = func(d *schema.ResourceData, meta any) (bool, error) {
+			return f(d, resourceTypeClient(meta))
+		}
+	}
+
+	if f := r.CustomizeDiff; f != nil {
+		r.CustomizeDiff = func(ctx context.Context, d *schema.ResourceDiff, meta any) error {
+			return f(ctx, d, resourceTypeClient(meta))
+		}
+	}
+
+	if importer := r.Importer; importer != nil {
+		if f := importer.State; f != nil {
+			importer.State = func(d *schema.ResourceData, meta any) ([]*schema.ResourceData, error) {
+				return f(d, resourceTypeClient(meta))
+			}
+		}
+
+		if f := importer.StateContext; f != nil {
+			importer.StateContext = func(ctx context.Context, d *schema.ResourceData, meta any) ([]*schema.ResourceData, error) {
+				return f(ctx, d, resourceTypeClient(meta))
+			}
+		}
+	}
+}
@@ -6,6 +6,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/providerserver"
 	"github.com/hashicorp/terraform-plugin-go/tfprotov5"
 	"github.com/hashicorp/terraform-plugin-mux/tf5muxserver"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-provider-aws/internal/provider/fwprovider"
 )
 
@@ -18,6 +19,12 @@ func ProtoV5ProviderServerFactory(ctx context.Context) (func() tfprotov5.Provide
 		return nil, err
 	}
 
+	return MuxedProtoV5ProviderServerFactory(ctx, primary)
+}
+
+// MuxedProtoV5ProviderServerFactory returns a terraform-plugin-go protocol v5 provider factory function
+// muxing the specified Plugin SDK provider with the Plugin Framework provider that shares its configuration.
+func MuxedProtoV5ProviderServerFactory(ctx context.Context, primary *schema.Provider) (func() tfprotov5.ProviderServer, error) {
 	servers := []func() tfprotov5.ProviderServer{
 		primary.GRPCProvider,
 		providerserver.NewProtocol5(fwprovider.New(primary)),
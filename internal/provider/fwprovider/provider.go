@@ -15,7 +15,6 @@ import (
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/fwtypes"
-	"github.com/hashicorp/terraform-provider-aws/internal/service/medialive"
 	"github.com/hashicorp/terraform-provider-aws/names"
 )
 
@@ -47,6 +46,26 @@ func (p *fwprovider) GetSchema(ctx context.Context) (tfsdk.Schema, diag.Diagnost
 				Type:     types.SetType{ElemType: types.StringType},
 				Optional: true,
 			},
+			"allowed_organization_ids": {
+				Type:        types.SetType{ElemType: types.StringType},
+				Optional:    true,
+				Description: "List of allowed AWS Organization IDs. The provider's account must be a member of one of the organizations.",
+			},
+			"allowed_organizational_unit_paths": {
+				Type:        types.SetType{ElemType: types.StringType},
+				Optional:    true,
+				Description: "List of allowed AWS Organizations organizational unit paths, e.g. `o-a1b2c3d4e5/r-ab12/ou-ab12-11111111/`. The provider's account must be in, or nested beneath, one of the organizational units.",
+			},
+			"allowed_partitions": {
+				Type:        types.SetType{ElemType: types.StringType},
+				Optional:    true,
+				Description: "List of allowed AWS partitions.",
+			},
+			"allowed_regions": {
+				Type:        types.SetType{ElemType: types.StringType},
+				Optional:    true,
+				Description: "List of allowed AWS Regions.",
+			},
 			"custom_ca_bundle": {
 				Type:        types.StringType,
 				Optional:    true,
@@ -76,6 +95,11 @@ func (p *fwprovider) GetSchema(ctx context.Context) (tfsdk.Schema, diag.Diagnost
 				Optional:    true,
 				Description: "Explicitly allow the provider to perform \"insecure\" SSL requests. If omitted, default value is `false`",
 			},
+			"lint_iam_policies": {
+				Type:        types.BoolType,
+				Optional:    true,
+				Description: "Check IAM policy documents offline during plan and fail on errors such as malformed actions, invalid condition operators or principals and exceeded size limits.",
+			},
 			"max_retries": {
 				Type:        types.Int64Type,
 				Optional:    true,
@@ -168,6 +192,11 @@ func (p *fwprovider) GetSchema(ctx context.Context) (tfsdk.Schema, diag.Diagnost
 				Optional:    true,
 				Description: "Resolve an endpoint with FIPS capability",
 			},
+			"validate_with_access_analyzer": {
+				Type:        types.SetType{ElemType: types.StringType},
+				Optional:    true,
+				Description: "Access Analyzer policy validation finding types, such as `ERROR` and `SECURITY_WARNING`, that fail the plan of IAM resources with policy documents.",
+			},
 		},
 		Blocks: map[string]tfsdk.Block{
 			"assume_role": {
@@ -268,12 +297,35 @@ func (p *fwprovider) GetSchema(ctx context.Context) (tfsdk.Schema, diag.Diagnost
 			},
 			"default_tags": {
 				Attributes: map[string]tfsdk.Attribute{
+					"required_tag_keys": {
+						Type:        types.SetType{ElemType: types.StringType},
+						Optional:    true,
+						Description: "Resource tag keys that all taggable resources must have after resource tags are merged with default tags.",
+					},
 					"tags": {
 						Type:        types.MapType{ElemType: types.StringType},
 						Optional:    true,
 						Description: "Resource tags to default across all resources",
 					},
 				},
+				Blocks: map[string]tfsdk.Block{
+					"rule": {
+						Attributes: map[string]tfsdk.Attribute{
+							"resource_types": {
+								Type:        types.SetType{ElemType: types.StringType},
+								Required:    true,
+								Description: "Resource type patterns to which the rule applies, e.g. `aws_s3_*`.",
+							},
+							"tags": {
+								Type:        types.MapType{ElemType: types.StringType},
+								Required:    true,
+								Description: "Resource tags to default across resources of matching types.",
+							},
+						},
+						NestingMode: tfsdk.BlockNestingModeList,
+						Description: "Configuration blocks with resource tags to default across resources of specific types. Later rules override earlier ones.",
+					},
+				},
 				NestingMode: tfsdk.BlockNestingModeList,
 				MaxItems:    1,
 				Description: "Configuration block with settings to default resource tags across all resources.",
@@ -296,6 +348,7 @@ func (p *fwprovider) GetSchema(ctx context.Context) (tfsdk.Schema, diag.Diagnost
 				MaxItems:    1,
 				Description: "Configuration block with settings to ignore resource tags across all resources.",
 			},
+			"rate_limit": rateLimitBlock(),
 		},
 	}
 
@@ -350,10 +403,6 @@ func (p *fwprovider) DataSources(ctx context.Context) []func() datasource.DataSo
 func (p *fwprovider) Resources(ctx context.Context) []func() resource.Resource {
 	var resources []func() resource.Resource
 
-	resources = append(resources, func() resource.Resource {
-		return medialive.NewResourceMultiplexProgram(ctx)
-	})
-
 	for _, sp := range p.Primary.Meta().(*conns.AWSClient).ServicePackages {
 		for _, v := range sp.FrameworkResources(ctx) {
 			v, err := v(ctx)
@@ -394,6 +443,49 @@ func endpointsBlock() tfsdk.Block {
 	}
 }
 
+func rateLimitBlock() tfsdk.Block {
+	rateLimitAttributes := func() map[string]tfsdk.Attribute {
+		return map[string]tfsdk.Attribute{
+			"burst": {
+				Type:        types.Int64Type,
+				Optional:    true,
+				Description: "The maximum number of API requests that can be made without pacing. Defaults to `requests_per_second`, rounded up.",
+			},
+			"mode": {
+				Type:        types.StringType,
+				Optional:    true,
+				Description: "The rate limiting mode. Valid values are `adaptive`, `none` and `token_bucket`.",
+			},
+			"requests_per_second": {
+				Type:        types.Float64Type,
+				Optional:    true,
+				Description: "The rate at which API requests are made in `token_bucket` mode, or the maximum rate in `adaptive` mode.",
+			},
+		}
+	}
+
+	serviceAttributes := rateLimitAttributes()
+	serviceAttributes["name"] = tfsdk.Attribute{
+		Type:        types.StringType,
+		Required:    true,
+		Description: "The service whose rate limiting settings are overridden, as used in the `endpoints` configuration block.",
+	}
+
+	return tfsdk.Block{
+		Attributes: rateLimitAttributes(),
+		Blocks: map[string]tfsdk.Block{
+			"service": {
+				Attributes:  serviceAttributes,
+				NestingMode: tfsdk.BlockNestingModeSet,
+				Description: "Configuration blocks with settings that override the provider-level rate limiting settings for a service.",
+			},
+		},
+		NestingMode: tfsdk.BlockNestingModeList,
+		MaxItems:    1,
+		Description: "Configuration block with settings to limit the rate of AWS API requests made by all resources.",
+	}
+}
+
 // wrappedDataSource wraps a data source, adding common functionality.
 type wrappedDataSource struct {
 	inner    datasource.DataSourceWithConfigure
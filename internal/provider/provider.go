@@ -14,9 +14,9 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
-	"github.com/hashicorp/terraform-provider-aws/internal/experimental/intf"
 	"github.com/hashicorp/terraform-provider-aws/internal/experimental/nullable"
 	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/policy"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/accessanalyzer"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/account"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/acm"
@@ -131,7 +131,6 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/service/mediapackage"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/mediastore"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/memorydb"
-	"github.com/hashicorp/terraform-provider-aws/internal/service/meta"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/mq"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/mwaa"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/neptune"
@@ -175,13 +174,11 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/service/sfn"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/shield"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/signer"
-	"github.com/hashicorp/terraform-provider-aws/internal/service/simpledb"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/sns"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/sqs"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/ssm"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/ssoadmin"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/storagegateway"
-	"github.com/hashicorp/terraform-provider-aws/internal/service/sts"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/swf"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/synthetics"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/timestreamwrite"
@@ -218,6 +215,34 @@ func New(_ context.Context) (*schema.Provider, error) {
 				ConflictsWith: []string{"forbidden_account_ids"},
 				Set:           schema.HashString,
 			},
+			"allowed_organization_ids": {
+				Type:        schema.TypeSet,
+				Elem:        &schema.Schema{Type: schema.TypeString, ValidateFunc: validation.StringMatch(regexp.MustCompile(`^o-[0-9a-z]{10,32}$`), "must be an AWS Organization ID")},
+				Optional:    true,
+				Set:         schema.HashString,
+				Description: "List of allowed AWS Organization IDs. The provider's account must be a member of one of the organizations.",
+			},
+			"allowed_organizational_unit_paths": {
+				Type:        schema.TypeSet,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Optional:    true,
+				Set:         schema.HashString,
+				Description: "List of allowed AWS Organizations organizational unit paths, e.g. `o-a1b2c3d4e5/r-ab12/ou-ab12-11111111/`. The provider's account must be in, or nested beneath, one of the organizational units.",
+			},
+			"allowed_partitions": {
+				Type:        schema.TypeSet,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Optional:    true,
+				Set:         schema.HashString,
+				Description: "List of allowed AWS partitions.",
+			},
+			"allowed_regions": {
+				Type:        schema.TypeSet,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Optional:    true,
+				Set:         schema.HashString,
+				Description: "List of allowed AWS Regions.",
+			},
 			"assume_role":                   assumeRoleSchema(),
 			"assume_role_with_web_identity": assumeRoleWithWebIdentitySchema(),
 			"custom_ca_bundle": {
@@ -234,6 +259,35 @@ func New(_ context.Context) (*schema.Provider, error) {
 				Description: "Configuration block with settings to default resource tags across all resources.",
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
+						"required_tag_keys": {
+							Type:        schema.TypeSet,
+							Optional:    true,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+							Set:         schema.HashString,
+							Description: "Resource tag keys that all taggable resources must have after resource tags are merged with default tags.",
+						},
+						"rule": {
+							Type:        schema.TypeList,
+							Optional:    true,
+							Description: "Configuration blocks with resource tags to default across resources of specific types. Later rules override earlier ones.",
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"resource_types": {
+										Type:        schema.TypeSet,
+										Required:    true,
+										Elem:        &schema.Schema{Type: schema.TypeString},
+										Set:         schema.HashString,
+										Description: "Resource type patterns to which the rule applies, e.g. `aws_s3_*`.",
+									},
+									"tags": {
+										Type:        schema.TypeMap,
+										Required:    true,
+										Elem:        &schema.Schema{Type: schema.TypeString},
+										Description: "Resource tags to default across resources of matching types.",
+									},
+								},
+							},
+						},
 						"tags": {
 							Type:        schema.TypeMap,
 							Optional:    true,
@@ -299,6 +353,11 @@ func New(_ context.Context) (*schema.Provider, error) {
 				Description: "Explicitly allow the provider to perform \"insecure\" SSL requests. If omitted, " +
 					"default value is `false`",
 			},
+			"lint_iam_policies": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Description: "Check IAM policy documents offline during plan and fail on errors such as malformed actions, invalid condition operators or principals and exceeded size limits.",
+			},
 			"max_retries": {
 				Type:     schema.TypeInt,
 				Optional: true,
@@ -312,6 +371,7 @@ func New(_ context.Context) (*schema.Provider, error) {
 				Description: "The profile for API operations. If not set, the default profile\n" +
 					"created with `aws configure` will be used.",
 			},
+			"rate_limit": rateLimitSchema(),
 			"region": {
 				Type:     schema.TypeString,
 				Optional: true,
@@ -414,9 +474,20 @@ func New(_ context.Context) (*schema.Provider, error) {
 				Optional:    true,
 				Description: "Resolve an endpoint with FIPS capability",
 			},
+			"validate_with_access_analyzer": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.StringInSlice(policy.ValidationFindingType_Values(), false),
+				},
+				Description: "Access Analyzer policy validation finding types, such as `ERROR` and `SECURITY_WARNING`, that fail the plan of IAM resources with policy documents.",
+			},
 		},
 
 		DataSourcesMap: map[string]*schema.Resource{
+			"aws_accessanalyzer_policy_validation": accessanalyzer.DataSourcePolicyValidation(),
+
 			"aws_acm_certificate": acm.DataSourceCertificate(),
 
 			"aws_acmpca_certificate_authority": acmpca.DataSourceCertificateAuthority(),
@@ -679,6 +750,7 @@ func New(_ context.Context) (*schema.Provider, error) {
 			"aws_iam_openid_connect_provider": iam.DataSourceOpenIDConnectProvider(),
 			"aws_iam_policy":                  iam.DataSourcePolicy(),
 			"aws_iam_policy_document":         iam.DataSourcePolicyDocument(),
+			"aws_iam_policy_lint":             iam.DataSourcePolicyLint(),
 			"aws_iam_role":                    iam.DataSourceRole(),
 			"aws_iam_roles":                   iam.DataSourceRoles(),
 			"aws_iam_saml_provider":           iam.DataSourceSAMLProvider(),
@@ -1581,32 +1653,38 @@ func New(_ context.Context) (*schema.Provider, error) {
 			"aws_guardduty_publishing_destination":     guardduty.ResourcePublishingDestination(),
 			"aws_guardduty_threatintelset":             guardduty.ResourceThreatintelset(),
 
-			"aws_iam_access_key":                  iam.ResourceAccessKey(),
-			"aws_iam_account_alias":               iam.ResourceAccountAlias(),
-			"aws_iam_account_password_policy":     iam.ResourceAccountPasswordPolicy(),
-			"aws_iam_group":                       iam.ResourceGroup(),
-			"aws_iam_group_membership":            iam.ResourceGroupMembership(),
-			"aws_iam_group_policy":                iam.ResourceGroupPolicy(),
-			"aws_iam_group_policy_attachment":     iam.ResourceGroupPolicyAttachment(),
-			"aws_iam_instance_profile":            iam.ResourceInstanceProfile(),
-			"aws_iam_openid_connect_provider":     iam.ResourceOpenIDConnectProvider(),
-			"aws_iam_policy":                      iam.ResourcePolicy(),
-			"aws_iam_policy_attachment":           iam.ResourcePolicyAttachment(),
-			"aws_iam_role":                        iam.ResourceRole(),
-			"aws_iam_role_policy":                 iam.ResourceRolePolicy(),
-			"aws_iam_role_policy_attachment":      iam.ResourceRolePolicyAttachment(),
-			"aws_iam_saml_provider":               iam.ResourceSAMLProvider(),
-			"aws_iam_server_certificate":          iam.ResourceServerCertificate(),
-			"aws_iam_service_linked_role":         iam.ResourceServiceLinkedRole(),
-			"aws_iam_service_specific_credential": iam.ResourceServiceSpecificCredential(),
-			"aws_iam_signing_certificate":         iam.ResourceSigningCertificate(),
-			"aws_iam_user":                        iam.ResourceUser(),
-			"aws_iam_user_group_membership":       iam.ResourceUserGroupMembership(),
-			"aws_iam_user_login_profile":          iam.ResourceUserLoginProfile(),
-			"aws_iam_user_policy":                 iam.ResourceUserPolicy(),
-			"aws_iam_user_policy_attachment":      iam.ResourceUserPolicyAttachment(),
-			"aws_iam_user_ssh_key":                iam.ResourceUserSSHKey(),
-			"aws_iam_virtual_mfa_device":          iam.ResourceVirtualMFADevice(),
+			"aws_iam_access_key":                         iam.ResourceAccessKey(),
+			"aws_iam_account_alias":                      iam.ResourceAccountAlias(),
+			"aws_iam_account_password_policy":            iam.ResourceAccountPasswordPolicy(),
+			"aws_iam_group":                              iam.ResourceGroup(),
+			"aws_iam_group_membership":                   iam.ResourceGroupMembership(),
+			"aws_iam_group_policies_exclusive":           iam.ResourceGroupPoliciesExclusive(),
+			"aws_iam_group_policy":                       iam.ResourceGroupPolicy(),
+			"aws_iam_group_policy_attachment":            iam.ResourceGroupPolicyAttachment(),
+			"aws_iam_group_policy_attachments_exclusive": iam.ResourceGroupPolicyAttachmentsExclusive(),
+			"aws_iam_instance_profile":                   iam.ResourceInstanceProfile(),
+			"aws_iam_openid_connect_provider":            iam.ResourceOpenIDConnectProvider(),
+			"aws_iam_policy":                             iam.ResourcePolicy(),
+			"aws_iam_policy_attachment":                  iam.ResourcePolicyAttachment(),
+			"aws_iam_role":                               iam.ResourceRole(),
+			"aws_iam_role_policies_exclusive":            iam.ResourceRolePoliciesExclusive(),
+			"aws_iam_role_policy":                        iam.ResourceRolePolicy(),
+			"aws_iam_role_policy_attachment":             iam.ResourceRolePolicyAttachment(),
+			"aws_iam_role_policy_attachments_exclusive":  iam.ResourceRolePolicyAttachmentsExclusive(),
+			"aws_iam_saml_provider":                      iam.ResourceSAMLProvider(),
+			"aws_iam_server_certificate":                 iam.ResourceServerCertificate(),
+			"aws_iam_service_linked_role":                iam.ResourceServiceLinkedRole(),
+			"aws_iam_service_specific_credential":        iam.ResourceServiceSpecificCredential(),
+			"aws_iam_signing_certificate":                iam.ResourceSigningCertificate(),
+			"aws_iam_user":                               iam.ResourceUser(),
+			"aws_iam_user_group_membership":              iam.ResourceUserGroupMembership(),
+			"aws_iam_user_login_profile":                 iam.ResourceUserLoginProfile(),
+			"aws_iam_user_policies_exclusive":            iam.ResourceUserPoliciesExclusive(),
+			"aws_iam_user_policy":                        iam.ResourceUserPolicy(),
+			"aws_iam_user_policy_attachment":             iam.ResourceUserPolicyAttachment(),
+			"aws_iam_user_policy_attachments_exclusive":  iam.ResourceUserPolicyAttachmentsExclusive(),
+			"aws_iam_user_ssh_key":                       iam.ResourceUserSSHKey(),
+			"aws_iam_virtual_mfa_device":                 iam.ResourceVirtualMFADevice(),
 
 			"aws_identitystore_group":            identitystore.ResourceGroup(),
 			"aws_identitystore_user":             identitystore.ResourceUser(),
@@ -2175,20 +2253,20 @@ func New(_ context.Context) (*schema.Provider, error) {
 		},
 	}
 
+	// Resolve default tags for each taggable resource's type.
+	// This must happen before the Region is overridden so that dynamic tag values use the resource's Region.
+	addDefaultTagsResourceType(provider.ResourcesMap)
+
+	// Allow regional resources and data sources to override the provider's Region.
+	addRegionAttribute(provider.ResourcesMap, provider.DataSourcesMap)
+
 	provider.ConfigureContextFunc = func(ctx context.Context, d *schema.ResourceData) (interface{}, diag.Diagnostics) {
 		return configure(ctx, provider, d)
 	}
 
 	providerData := &conns.AWSClient{
-		// TODO: This should be generated.
-
 		// ServicePackageData is used before configuration to determine the provider's exported resources and data sources.
-		ServicePackages: []intf.ServicePackageData{
-			globalaccelerator.ServicePackageData,
-			meta.ServicePackageData,
-			simpledb.ServicePackageData,
-			sts.ServicePackageData,
-		},
+		ServicePackages: servicePackages(),
 	}
 
 	// Set the provider Meta (instance data) here.
@@ -2214,7 +2292,9 @@ func configure(ctx context.Context, provider *schema.Provider, d *schema.Resourc
 		EC2MetadataServiceEndpointMode: d.Get("ec2_metadata_service_endpoint_mode").(string),
 		Endpoints:                      make(map[string]string),
 		HTTPProxy:                      d.Get("http_proxy").(string),
+		HTTPTransport:                  conns.HTTPTransportFromContext(ctx),
 		Insecure:                       d.Get("insecure").(bool),
+		LintIAMPolicies:                d.Get("lint_iam_policies").(bool),
 		MaxRetries:                     25, // Set default here, not in schema (muxing with v6 provider).
 		Profile:                        d.Get("profile").(string),
 		Region:                         d.Get("region").(string),
@@ -2231,10 +2311,30 @@ func configure(ctx context.Context, provider *schema.Provider, d *schema.Resourc
 		UseFIPSEndpoint:                d.Get("use_fips_endpoint").(bool),
 	}
 
+	if v, ok := d.GetOk("validate_with_access_analyzer"); ok && v.(*schema.Set).Len() > 0 {
+		config.ValidateWithAccessAnalyzer = flex.ExpandStringValueSet(v.(*schema.Set))
+	}
+
 	if v, ok := d.GetOk("allowed_account_ids"); ok && v.(*schema.Set).Len() > 0 {
 		config.AllowedAccountIds = flex.ExpandStringValueSet(v.(*schema.Set))
 	}
 
+	if v, ok := d.GetOk("allowed_organization_ids"); ok && v.(*schema.Set).Len() > 0 {
+		config.AllowedOrganizationIDs = flex.ExpandStringValueSet(v.(*schema.Set))
+	}
+
+	if v, ok := d.GetOk("allowed_organizational_unit_paths"); ok && v.(*schema.Set).Len() > 0 {
+		config.AllowedOrganizationalUnitPaths = flex.ExpandStringValueSet(v.(*schema.Set))
+	}
+
+	if v, ok := d.GetOk("allowed_partitions"); ok && v.(*schema.Set).Len() > 0 {
+		config.AllowedPartitions = flex.ExpandStringValueSet(v.(*schema.Set))
+	}
+
+	if v, ok := d.GetOk("allowed_regions"); ok && v.(*schema.Set).Len() > 0 {
+		config.AllowedRegions = flex.ExpandStringValueSet(v.(*schema.Set))
+	}
+
 	if v, ok := d.GetOk("assume_role"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
 		config.AssumeRole = expandAssumeRole(v.([]interface{})[0].(map[string]interface{}))
 		log.Printf("[INFO] assume_role configuration set: (ARN: %q, SessionID: %q, ExternalID: %q, SourceIdentity: %q)", config.AssumeRole.RoleARN, config.AssumeRole.SessionName, config.AssumeRole.ExternalID, config.AssumeRole.SourceIdentity)
@@ -2271,6 +2371,16 @@ func configure(ctx context.Context, provider *schema.Provider, d *schema.Resourc
 		config.MaxRetries = v.(int)
 	}
 
+	if v, ok := d.GetOk("rate_limit"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		rateLimitConfig, err := expandRateLimit(v.([]interface{})[0].(map[string]interface{}))
+
+		if err != nil {
+			return nil, diag.FromErr(err)
+		}
+
+		config.RateLimitConfig = rateLimitConfig
+	}
+
 	if v, ok := d.GetOk("shared_credentials_file"); ok {
 		config.SharedCredentialsFiles = []string{v.(string)}
 	} else if v, ok := d.GetOk("shared_credentials_files"); ok && len(v.([]interface{})) > 0 {
@@ -2467,6 +2577,59 @@ func endpointsSchema() *schema.Schema {
 	}
 }
 
+func rateLimitSchema() *schema.Schema {
+	rateLimitAttributes := func() map[string]*schema.Schema {
+		return map[string]*schema.Schema{
+			"burst": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ValidateFunc: validation.IntAtLeast(1),
+				Description:  "The maximum number of API requests that can be made without pacing. Defaults to `requests_per_second`, rounded up.",
+			},
+			"mode": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringInSlice(conns.RateLimitMode_Values(), false),
+				Description:  "The rate limiting mode. Valid values are `adaptive`, `none` and `token_bucket`.",
+			},
+			"requests_per_second": {
+				Type:         schema.TypeFloat,
+				Optional:     true,
+				ValidateFunc: validation.FloatAtLeast(0),
+				Description:  "The rate at which API requests are made in `token_bucket` mode, or the maximum rate in `adaptive` mode.",
+			},
+		}
+	}
+
+	serviceAttributes := rateLimitAttributes()
+	serviceAttributes["name"] = &schema.Schema{
+		Type:         schema.TypeString,
+		Required:     true,
+		ValidateFunc: validation.StringInSlice(names.Aliases(), false),
+		Description:  "The service whose rate limiting settings are overridden, as used in the `endpoints` configuration block.",
+	}
+
+	attributes := rateLimitAttributes()
+	attributes["service"] = &schema.Schema{
+		Type:        schema.TypeSet,
+		Optional:    true,
+		Description: "Configuration blocks with settings that override the provider-level rate limiting settings for a service.",
+		Elem: &schema.Resource{
+			Schema: serviceAttributes,
+		},
+	}
+
+	return &schema.Schema{
+		Type:        schema.TypeList,
+		Optional:    true,
+		MaxItems:    1,
+		Description: "Configuration block with settings to limit the rate of AWS API requests made by all resources.",
+		Elem: &schema.Resource{
+			Schema: attributes,
+		},
+	}
+}
+
 func expandAssumeRole(tfMap map[string]interface{}) *awsbase.AssumeRole {
 	if tfMap == nil {
 		return nil
@@ -2568,6 +2731,32 @@ func expandDefaultTags(tfMap map[string]interface{}) *tftags.DefaultConfig {
 		defaultConfig.Tags = tftags.New(v)
 	}
 
+	if v, ok := tfMap["rule"].([]interface{}); ok {
+		for _, tfMapRaw := range v {
+			tfMap, ok := tfMapRaw.(map[string]interface{})
+
+			if !ok {
+				continue
+			}
+
+			rule := tftags.DefaultRule{}
+
+			if v, ok := tfMap["resource_types"].(*schema.Set); ok {
+				rule.ResourceTypes = flex.ExpandStringValueSet(v)
+			}
+
+			if v, ok := tfMap["tags"].(map[string]interface{}); ok {
+				rule.Tags = tftags.New(v)
+			}
+
+			defaultConfig.Rules = append(defaultConfig.Rules, rule)
+		}
+	}
+
+	if v, ok := tfMap["required_tag_keys"].(*schema.Set); ok && v.Len() > 0 {
+		defaultConfig.RequiredTagKeys = flex.ExpandStringValueSet(v)
+	}
+
 	return defaultConfig
 }
 
@@ -2589,6 +2778,65 @@ func expandIgnoreTags(tfMap map[string]interface{}) *tftags.IgnoreConfig {
 	return ignoreConfig
 }
 
+func expandRateLimit(tfMap map[string]interface{}) (*conns.RateLimitConfig, error) {
+	if tfMap == nil {
+		return nil, nil
+	}
+
+	rateLimitConfig := &conns.RateLimitConfig{
+		RateLimit: expandRateLimitSettings(tfMap),
+	}
+
+	if err := rateLimitConfig.Validate(); err != nil {
+		return nil, fmt.Errorf("rate_limit: %w", err)
+	}
+
+	if v, ok := tfMap["service"].(*schema.Set); ok && v.Len() > 0 {
+		rateLimitConfig.Services = make(map[string]conns.RateLimit)
+
+		for _, tfMapRaw := range v.List() {
+			tfMap, ok := tfMapRaw.(map[string]interface{})
+
+			if !ok {
+				continue
+			}
+
+			alias := tfMap["name"].(string)
+			pkg, err := names.ProviderPackageForAlias(alias)
+
+			if err != nil {
+				return nil, fmt.Errorf("rate_limit: service (%s): %w", alias, err)
+			}
+
+			rateLimitConfig.Services[pkg] = expandRateLimitSettings(tfMap)
+
+			if err := rateLimitConfig.ForService(pkg).Validate(); err != nil {
+				return nil, fmt.Errorf("rate_limit: service (%s): %w", alias, err)
+			}
+		}
+	}
+
+	return rateLimitConfig, nil
+}
+
+func expandRateLimitSettings(tfMap map[string]interface{}) conns.RateLimit {
+	rateLimit := conns.RateLimit{}
+
+	if v, ok := tfMap["burst"].(int); ok && v != 0 {
+		rateLimit.Burst = v
+	}
+
+	if v, ok := tfMap["mode"].(string); ok && v != "" {
+		rateLimit.Mode = v
+	}
+
+	if v, ok := tfMap["requests_per_second"].(float64); ok && v != 0 {
+		rateLimit.RequestsPerSecond = v
+	}
+
+	return rateLimit
+}
+
 func expandEndpoints(tfList []interface{}) (map[string]string, error) {
 	if len(tfList) == 0 {
 		return nil, nil
@@ -5,6 +5,11 @@ import (
 	"strings"
 	"testing"
 
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
 	"github.com/hashicorp/terraform-provider-aws/names"
 )
 
@@ -182,3 +187,124 @@ func popEnv(env []string) {
 		os.Setenv(k, v)
 	}
 }
+
+func TestExpandRateLimit(t *testing.T) {
+	testcases := []struct {
+		name        string
+		tfMap       map[string]interface{}
+		expected    *conns.RateLimitConfig
+		expectError bool
+	}{
+		{
+			name: "provider-level",
+			tfMap: map[string]interface{}{
+				"mode":                conns.RateLimitModeTokenBucket,
+				"requests_per_second": 10.0,
+				"burst":               20,
+			},
+			expected: &conns.RateLimitConfig{
+				RateLimit: conns.RateLimit{Mode: conns.RateLimitModeTokenBucket, RequestsPerSecond: 10, Burst: 20},
+			},
+		},
+		{
+			name: "service alias",
+			tfMap: map[string]interface{}{
+				"mode": conns.RateLimitModeAdaptive,
+				"service": schema.NewSet(schema.HashResource(rateLimitSchema().Elem.(*schema.Resource).Schema["service"].Elem.(*schema.Resource)), []interface{}{
+					map[string]interface{}{
+						"name":                "transcribeservice",
+						"mode":                conns.RateLimitModeTokenBucket,
+						"requests_per_second": 2.0,
+						"burst":               0,
+					},
+				}),
+			},
+			expected: &conns.RateLimitConfig{
+				RateLimit: conns.RateLimit{Mode: conns.RateLimitModeAdaptive},
+				Services: map[string]conns.RateLimit{
+					names.Transcribe: {Mode: conns.RateLimitModeTokenBucket, RequestsPerSecond: 2},
+				},
+			},
+		},
+		{
+			name: "token bucket without rate",
+			tfMap: map[string]interface{}{
+				"mode": conns.RateLimitModeTokenBucket,
+			},
+			expectError: true,
+		},
+	}
+
+	for _, testcase := range testcases {
+		t.Run(testcase.name, func(t *testing.T) {
+			got, err := expandRateLimit(testcase.tfMap)
+
+			if testcase.expectError {
+				if err == nil {
+					t.Fatal("expected error")
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if diff := cmp.Diff(got, testcase.expected); diff != "" {
+				t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+			}
+		})
+	}
+}
+
+func TestExpandDefaultTags(t *testing.T) {
+	testcases := []struct {
+		name     string
+		tfMap    map[string]interface{}
+		expected *tftags.DefaultConfig
+	}{
+		{
+			name: "tags",
+			tfMap: map[string]interface{}{
+				"tags": map[string]interface{}{"key1": "value1"},
+			},
+			expected: &tftags.DefaultConfig{
+				Tags: tftags.New(map[string]interface{}{"key1": "value1"}),
+			},
+		},
+		{
+			name: "rules and required tag keys",
+			tfMap: map[string]interface{}{
+				"tags": map[string]interface{}{"key1": "value1"},
+				"rule": []interface{}{
+					map[string]interface{}{
+						"resource_types": schema.NewSet(schema.HashString, []interface{}{"aws_s3_*"}),
+						"tags":           map[string]interface{}{"key2": "{{resource_type}}"},
+					},
+				},
+				"required_tag_keys": schema.NewSet(schema.HashString, []interface{}{"key1", "key2"}),
+			},
+			expected: &tftags.DefaultConfig{
+				Tags: tftags.New(map[string]interface{}{"key1": "value1"}),
+				Rules: []tftags.DefaultRule{
+					{
+						ResourceTypes: []string{"aws_s3_*"},
+						Tags:          tftags.New(map[string]interface{}{"key2": "{{resource_type}}"}),
+					},
+				},
+				RequiredTagKeys: []string{"key1", "key2"},
+			},
+		},
+	}
+
+	for _, testcase := range testcases {
+		t.Run(testcase.name, func(t *testing.T) {
+			got := expandDefaultTags(testcase.tfMap)
+
+			if diff := cmp.Diff(got, testcase.expected, cmpopts.SortSlices(func(a, b string) bool { return a < b })); diff != "" {
+				t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+			}
+		})
+	}
+}
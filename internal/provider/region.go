@@ -0,0 +1,285 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+)
+
+const (
+	// regionAttributeName is the name of the optional top-level argument used to override the provider's Region.
+	regionAttributeName = "region"
+
+	// regionalImportIDSeparator separates a resource's import ID from an optional Region, e.g. `vpc-12345678@us-west-2`.
+	regionalImportIDSeparator = "@"
+)
+
+var regionRegexp = regexp.MustCompile(`^[a-z]{2}(-[a-z]+)+-\d$`)
+
+// globalResourceTypePrefixes are the type name prefixes of resources and data sources
+// for global (or single-Region) services that do not support overriding the Region.
+var globalResourceTypePrefixes = []string{
+	"aws_account_",
+	"aws_budgets_",
+	"aws_ce_",
+	"aws_cloudfront_",
+	"aws_cur_",
+	"aws_ecrpublic_",
+	"aws_globalaccelerator_",
+	"aws_iam_",
+	"aws_organizations_",
+	"aws_pricing_",
+	"aws_route53_",
+	"aws_route53domains_",
+	"aws_route53recoverycontrolconfig_",
+	"aws_route53recoveryreadiness_",
+	"aws_s3control_multi_region_access_point",
+	"aws_shield_",
+	"aws_waf_",
+}
+
+// regionalResourceTypePrefixes are exceptions to globalResourceTypePrefixes.
+var regionalResourceTypePrefixes = []string{
+	"aws_route53_resolver_",
+}
+
+// isRegionalResourceType returns whether or not the specified resource or data source type supports overriding the Region.
+func isRegionalResourceType(typeName string) bool {
+	for _, prefix := range regionalResourceTypePrefixes {
+		if strings.HasPrefix(typeName, prefix) {
+			return true
+		}
+	}
+
+	for _, prefix := range globalResourceTypePrefixes {
+		if strings.HasPrefix(typeName, prefix) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// parseRegionalImportID splits an import ID of the form `<id>@<region>`.
+func parseRegionalImportID(id string) (string, string, bool) {
+	i := strings.LastIndex(id, regionalImportIDSeparator)
+
+	if i <= 0 {
+		return id, "", false
+	}
+
+	region := id[i+len(regionalImportIDSeparator):]
+
+	if !regionRegexp.MatchString(region) {
+		return id, "", false
+	}
+
+	return id[:i], region, true
+}
+
+// regionalClient returns the AWSClient for the Region configured on a resource or data source.
+func regionalClient(getter interface{ Get(string) any }, meta any) (*conns.AWSClient, error) {
+	client := meta.(*conns.AWSClient)
+	region, _ := getter.Get(regionAttributeName).(string)
+
+	return client.RegionalClient(region)
+}
+
+// addRegionAttribute adds an optional `region` argument to all regional resources and data sources.
+// Resources and data sources that already define a top-level `region` attribute are left unchanged.
+// Only Plugin SDK resources and data sources are handled; those implemented with the Plugin Framework
+// (registered via fwprovider) don't yet support overriding the Region.
+func addRegionAttribute(resources, dataSources map[string]*schema.Resource) {
+	for typeName, r := range resources {
+		if !isRegionalResourceType(typeName) {
+			continue
+		}
+
+		if _, ok := r.Schema[regionAttributeName]; ok {
+			continue
+		}
+
+		wrapRegionalResource(r)
+	}
+
+	for typeName, r := range dataSources {
+		if !isRegionalResourceType(typeName) {
+			continue
+		}
+
+		if _, ok := r.Schema[regionAttributeName]; ok {
+			continue
+		}
+
+		wrapRegionalDataSource(r)
+	}
+}
+
+func regionSchema(forceNew bool) *schema.Schema {
+	return &schema.Schema{
+		Type:         schema.TypeString,
+		Optional:     true,
+		Computed:     true,
+		ForceNew:     forceNew,
+		ValidateFunc: validRegion,
+		Description:  "The Region in which the resource is managed. Defaults to the Region set in the provider configuration.",
+	}
+}
+
+func validRegion(v any, k string) (ws []string, errors []error) {
+	if value := v.(string); value != "" && !regionRegexp.MatchString(value) {
+		errors = append(errors, fmt.Errorf("%q (%s) is not a valid AWS Region name", k, value))
+	}
+
+	return
+}
+
+func wrapRegionalResource(r *schema.Resource) {
+	r.Schema[regionAttributeName] = regionSchema(true)
+
+	r.Create = wrapCRUDFunc(r.Create, true)
+	r.Read = wrapCRUDFunc(r.Read, true)
+	r.Update = wrapCRUDFunc(r.Update, false)
+	r.Delete = wrapCRUDFunc(r.Delete, false)
+	r.CreateContext = wrapCRUDContextFunc(r.CreateContext, true)
+	r.ReadContext = wrapCRUDContextFunc(r.ReadContext, true)
+	r.UpdateContext = wrapCRUDContextFunc(r.UpdateContext, false)
+	r.DeleteContext = wrapCRUDContextFunc(r.DeleteContext, false)
+	r.CreateWithoutTimeout = wrapCRUDContextFunc(r.CreateWithoutTimeout, true)
+	r.ReadWithoutTimeout = wrapCRUDContextFunc(r.ReadWithoutTimeout, true)
+	r.UpdateWithoutTimeout = wrapCRUDContextFunc(r.UpdateWithoutTimeout, false)
+	r.DeleteWithoutTimeout = wrapCRUDContextFunc(r.DeleteWithoutTimeout, false)
+
+	if f := r.Exists; f != nil {
+		r.Exists = func(d *schema.ResourceData, meta any) (bool, error) {
+			client, err := regionalClient(d, meta)
+
+			if err != nil {
+				return false, err
+			}
+
+			return f(d, client)
+		}
+	}
+
+	if f := r.CustomizeDiff; f != nil {
+		r.CustomizeDiff = func(ctx context.Context, d *schema.ResourceDiff, meta any) error {
+			client, err := regionalClient(d, meta)
+
+			if err != nil {
+				return err
+			}
+
+			return f(ctx, d, client)
+		}
+	}
+
+	if importer := r.Importer; importer != nil {
+		if f := importer.State; f != nil {
+			importer.State = func(d *schema.ResourceData, meta any) ([]*schema.ResourceData, error) {
+				client, err := setRegionFromImportID(d, meta)
+
+				if err != nil {
+					return nil, err
+				}
+
+				return f(d, client)
+			}
+		}
+
+		if f := importer.StateContext; f != nil {
+			importer.StateContext = func(ctx context.Context, d *schema.ResourceData, meta any) ([]*schema.ResourceData, error) {
+				client, err := setRegionFromImportID(d, meta)
+
+				if err != nil {
+					return nil, err
+				}
+
+				return f(ctx, d, client)
+			}
+		}
+	}
+}
+
+func wrapRegionalDataSource(r *schema.Resource) {
+	r.Schema[regionAttributeName] = regionSchema(false)
+
+	r.Read = wrapCRUDFunc(r.Read, true)
+	r.ReadContext = wrapCRUDContextFunc(r.ReadContext, true)
+	r.ReadWithoutTimeout = wrapCRUDContextFunc(r.ReadWithoutTimeout, true)
+}
+
+// wrapCRUDFunc wraps a CRUD function so that it is called with the AWSClient for the configured Region.
+// If setRegion is true the effective Region is recorded in state.
+func wrapCRUDFunc(f func(*schema.ResourceData, any) error, setRegion bool) func(*schema.ResourceData, any) error {
+	if f == nil {
+		return nil
+	}
+
+	return func(d *schema.ResourceData, meta any) error {
+		client, err := regionalClient(d, meta)
+
+		if err != nil {
+			return err
+		}
+
+		if err := f(d, client); err != nil {
+			return err
+		}
+
+		if setRegion && d.Id() != "" {
+			return d.Set(regionAttributeName, client.Region)
+		}
+
+		return nil
+	}
+}
+
+// wrapCRUDContextFunc wraps a context-aware CRUD function so that it is called with the AWSClient for the configured Region.
+// If setRegion is true the effective Region is recorded in state.
+func wrapCRUDContextFunc(f func(context.Context, *schema.ResourceData, any) diag.Diagnostics, setRegion bool) func(context.Context, *schema.ResourceData, any) diag.Diagnostics {
+	if f == nil {
+		return nil
+	}
+
+	return func(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+		client, err := regionalClient(d, meta)
+
+		if err != nil {
+			return diag.FromErr(err)
+		}
+
+		diags := f(ctx, d, client)
+
+		if diags.HasError() {
+			return diags
+		}
+
+		if setRegion && d.Id() != "" {
+			if err := d.Set(regionAttributeName, client.Region); err != nil {
+				return append(diags, diag.FromErr(err)...)
+			}
+		}
+
+		return diags
+	}
+}
+
+// setRegionFromImportID handles import IDs of the form `<id>@<region>`,
+// returning the AWSClient for the resource's Region.
+func setRegionFromImportID(d *schema.ResourceData, meta any) (*conns.AWSClient, error) {
+	if id, region, ok := parseRegionalImportID(d.Id()); ok {
+		d.SetId(id)
+
+		if err := d.Set(regionAttributeName, region); err != nil {
+			return nil, err
+		}
+	}
+
+	return regionalClient(d, meta)
+}
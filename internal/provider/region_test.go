@@ -0,0 +1,129 @@
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func TestParseRegionalImportID(t *testing.T) {
+	testCases := []struct {
+		Name           string
+		ID             string
+		ExpectedID     string
+		ExpectedRegion string
+		ExpectedOK     bool
+	}{
+		{
+			Name:       "no Region",
+			ID:         "vpc-12345678",
+			ExpectedID: "vpc-12345678",
+		},
+		{
+			Name:           "Region",
+			ID:             "vpc-12345678@us-west-2", //lintignore:AWSAT003
+			ExpectedID:     "vpc-12345678",
+			ExpectedRegion: "us-west-2", //lintignore:AWSAT003
+			ExpectedOK:     true,
+		},
+		{
+			Name:           "GovCloud Region",
+			ID:             "vpc-12345678@us-gov-west-1", //lintignore:AWSAT003
+			ExpectedID:     "vpc-12345678",
+			ExpectedRegion: "us-gov-west-1", //lintignore:AWSAT003
+			ExpectedOK:     true,
+		},
+		{
+			Name:       "email address",
+			ID:         "user@example.com",
+			ExpectedID: "user@example.com",
+		},
+		{
+			Name:           "email address and Region",
+			ID:             "user@example.com@eu-west-1", //lintignore:AWSAT003
+			ExpectedID:     "user@example.com",
+			ExpectedRegion: "eu-west-1", //lintignore:AWSAT003
+			ExpectedOK:     true,
+		},
+		{
+			Name:       "only Region",
+			ID:         "@us-west-2", //lintignore:AWSAT003
+			ExpectedID: "@us-west-2", //lintignore:AWSAT003
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			id, region, ok := parseRegionalImportID(testCase.ID)
+
+			if id != testCase.ExpectedID {
+				t.Errorf("got ID %s, expected %s", id, testCase.ExpectedID)
+			}
+
+			if region != testCase.ExpectedRegion {
+				t.Errorf("got Region %s, expected %s", region, testCase.ExpectedRegion)
+			}
+
+			if ok != testCase.ExpectedOK {
+				t.Errorf("got %t, expected %t", ok, testCase.ExpectedOK)
+			}
+		})
+	}
+}
+
+func TestIsRegionalResourceType(t *testing.T) {
+	testCases := []struct {
+		TypeName string
+		Expected bool
+	}{
+		{TypeName: "aws_vpc", Expected: true},
+		{TypeName: "aws_s3_bucket", Expected: true},
+		{TypeName: "aws_iam_role", Expected: false},
+		{TypeName: "aws_route53_zone", Expected: false},
+		{TypeName: "aws_route53_resolver_endpoint", Expected: true},
+		{TypeName: "aws_cloudfront_distribution", Expected: false},
+		{TypeName: "aws_wafregional_web_acl", Expected: true},
+		{TypeName: "aws_waf_web_acl", Expected: false},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.TypeName, func(t *testing.T) {
+			if got := isRegionalResourceType(testCase.TypeName); got != testCase.Expected {
+				t.Errorf("got %t, expected %t", got, testCase.Expected)
+			}
+		})
+	}
+}
+
+func TestProviderRegionAttribute(t *testing.T) {
+	p, err := New(context.Background())
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	testCases := []struct {
+		TypeName string
+		Resource *schema.Resource
+		Expected bool
+	}{
+		{TypeName: "aws_vpc", Resource: p.ResourcesMap["aws_vpc"], Expected: true},
+		{TypeName: "aws_iam_role", Resource: p.ResourcesMap["aws_iam_role"], Expected: false},
+		{TypeName: "data.aws_vpc", Resource: p.DataSourcesMap["aws_vpc"], Expected: true},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.TypeName, func(t *testing.T) {
+			v, ok := testCase.Resource.Schema[regionAttributeName]
+
+			if ok != testCase.Expected {
+				t.Fatalf("got %t, expected %t", ok, testCase.Expected)
+			}
+
+			if ok && !v.Optional {
+				t.Errorf("expected %s to be Optional", regionAttributeName)
+			}
+		})
+	}
+}
@@ -0,0 +1,374 @@
+// Code generated by internal/generate/servicepackages/main.go; DO NOT EDIT.
+
+package provider
+
+import (
+	"github.com/hashicorp/terraform-provider-aws/internal/experimental/intf"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/accessanalyzer"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/account"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/acm"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/acmpca"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/amp"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/amplify"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/apigateway"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/apigatewayv2"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/appautoscaling"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/appconfig"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/appflow"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/appintegrations"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/applicationinsights"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/appmesh"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/apprunner"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/appstream"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/appsync"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/athena"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/autoscaling"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/autoscalingplans"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/backup"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/batch"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/budgets"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/ce"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/chime"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/cloud9"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/cloudcontrol"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/cloudformation"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/cloudfront"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/cloudhsmv2"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/cloudsearch"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/cloudtrail"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/cloudwatch"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/codeartifact"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/codebuild"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/codecommit"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/codepipeline"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/codestarconnections"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/codestarnotifications"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/cognitoidentity"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/cognitoidp"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/comprehend"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/configservice"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/connect"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/cur"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/dataexchange"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/datapipeline"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/datasync"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/dax"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/deploy"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/detective"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/devicefarm"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/directconnect"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/dlm"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/dms"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/docdb"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/ds"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/dynamodb"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/ec2"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/ecr"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/ecrpublic"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/ecs"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/efs"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/eks"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/elasticache"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/elasticbeanstalk"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/elasticsearch"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/elastictranscoder"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/elb"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/elbv2"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/emr"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/emrcontainers"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/emrserverless"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/events"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/evidently"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/firehose"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/fis"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/fms"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/fsx"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/gamelift"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/glacier"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/globalaccelerator"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/glue"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/grafana"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/greengrass"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/guardduty"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/iam"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/identitystore"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/imagebuilder"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/inspector"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/inspector2"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/iot"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/iotanalytics"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/iotevents"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/kafka"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/kafkaconnect"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/kendra"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/keyspaces"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/kinesis"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/kinesisanalytics"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/kinesisanalyticsv2"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/kinesisvideo"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/kms"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/lakeformation"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/lambda"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/lexmodels"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/licensemanager"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/lightsail"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/location"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/logs"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/macie"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/macie2"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/mediaconnect"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/mediaconvert"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/medialive"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/mediapackage"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/mediastore"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/memorydb"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/meta"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/mq"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/mwaa"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/neptune"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/networkfirewall"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/networkmanager"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/opensearch"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/opsworks"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/organizations"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/outposts"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/pinpoint"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/pricing"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/qldb"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/quicksight"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/ram"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/rds"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/redshift"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/redshiftdata"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/redshiftserverless"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/resourcegroups"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/resourcegroupstaggingapi"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/rolesanywhere"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/route53"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/route53domains"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/route53recoverycontrolconfig"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/route53recoveryreadiness"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/route53resolver"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/rum"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/s3"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/s3control"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/s3outposts"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/sagemaker"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/schemas"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/secretsmanager"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/securityhub"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/serverlessrepo"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/servicecatalog"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/servicediscovery"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/servicequotas"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/ses"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/sesv2"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/sfn"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/shield"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/signer"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/simpledb"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/sns"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/sqs"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/ssm"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/ssoadmin"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/storagegateway"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/sts"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/swf"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/synthetics"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/timestreamwrite"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/transcribe"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/transfer"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/waf"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/wafregional"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/wafv2"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/worklink"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/workspaces"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/xray"
+)
+
+// servicePackages returns the data for all service packages.
+// Service package data is used before configuration to determine the provider's exported resources and data sources.
+func servicePackages() []intf.ServicePackageData {
+	return []intf.ServicePackageData{
+		accessanalyzer.ServicePackageData,
+		account.ServicePackageData,
+		acm.ServicePackageData,
+		acmpca.ServicePackageData,
+		amp.ServicePackageData,
+		amplify.ServicePackageData,
+		apigateway.ServicePackageData,
+		apigatewayv2.ServicePackageData,
+		appautoscaling.ServicePackageData,
+		appconfig.ServicePackageData,
+		appflow.ServicePackageData,
+		appintegrations.ServicePackageData,
+		applicationinsights.ServicePackageData,
+		appmesh.ServicePackageData,
+		apprunner.ServicePackageData,
+		appstream.ServicePackageData,
+		appsync.ServicePackageData,
+		athena.ServicePackageData,
+		autoscaling.ServicePackageData,
+		autoscalingplans.ServicePackageData,
+		backup.ServicePackageData,
+		batch.ServicePackageData,
+		budgets.ServicePackageData,
+		ce.ServicePackageData,
+		chime.ServicePackageData,
+		cloud9.ServicePackageData,
+		cloudcontrol.ServicePackageData,
+		cloudformation.ServicePackageData,
+		cloudfront.ServicePackageData,
+		cloudhsmv2.ServicePackageData,
+		cloudsearch.ServicePackageData,
+		cloudtrail.ServicePackageData,
+		cloudwatch.ServicePackageData,
+		codeartifact.ServicePackageData,
+		codebuild.ServicePackageData,
+		codecommit.ServicePackageData,
+		codepipeline.ServicePackageData,
+		codestarconnections.ServicePackageData,
+		codestarnotifications.ServicePackageData,
+		cognitoidentity.ServicePackageData,
+		cognitoidp.ServicePackageData,
+		comprehend.ServicePackageData,
+		configservice.ServicePackageData,
+		connect.ServicePackageData,
+		cur.ServicePackageData,
+		dataexchange.ServicePackageData,
+		datapipeline.ServicePackageData,
+		datasync.ServicePackageData,
+		dax.ServicePackageData,
+		deploy.ServicePackageData,
+		detective.ServicePackageData,
+		devicefarm.ServicePackageData,
+		directconnect.ServicePackageData,
+		dlm.ServicePackageData,
+		dms.ServicePackageData,
+		docdb.ServicePackageData,
+		ds.ServicePackageData,
+		dynamodb.ServicePackageData,
+		ec2.ServicePackageData,
+		ecr.ServicePackageData,
+		ecrpublic.ServicePackageData,
+		ecs.ServicePackageData,
+		efs.ServicePackageData,
+		eks.ServicePackageData,
+		elasticache.ServicePackageData,
+		elasticbeanstalk.ServicePackageData,
+		elasticsearch.ServicePackageData,
+		elastictranscoder.ServicePackageData,
+		elb.ServicePackageData,
+		elbv2.ServicePackageData,
+		emr.ServicePackageData,
+		emrcontainers.ServicePackageData,
+		emrserverless.ServicePackageData,
+		events.ServicePackageData,
+		evidently.ServicePackageData,
+		firehose.ServicePackageData,
+		fis.ServicePackageData,
+		fms.ServicePackageData,
+		fsx.ServicePackageData,
+		gamelift.ServicePackageData,
+		glacier.ServicePackageData,
+		globalaccelerator.ServicePackageData,
+		glue.ServicePackageData,
+		grafana.ServicePackageData,
+		greengrass.ServicePackageData,
+		guardduty.ServicePackageData,
+		iam.ServicePackageData,
+		identitystore.ServicePackageData,
+		imagebuilder.ServicePackageData,
+		inspector.ServicePackageData,
+		inspector2.ServicePackageData,
+		iot.ServicePackageData,
+		iotanalytics.ServicePackageData,
+		iotevents.ServicePackageData,
+		kafka.ServicePackageData,
+		kafkaconnect.ServicePackageData,
+		kendra.ServicePackageData,
+		keyspaces.ServicePackageData,
+		kinesis.ServicePackageData,
+		kinesisanalytics.ServicePackageData,
+		kinesisanalyticsv2.ServicePackageData,
+		kinesisvideo.ServicePackageData,
+		kms.ServicePackageData,
+		lakeformation.ServicePackageData,
+		lambda.ServicePackageData,
+		lexmodels.ServicePackageData,
+		licensemanager.ServicePackageData,
+		lightsail.ServicePackageData,
+		location.ServicePackageData,
+		logs.ServicePackageData,
+		macie.ServicePackageData,
+		macie2.ServicePackageData,
+		mediaconnect.ServicePackageData,
+		mediaconvert.ServicePackageData,
+		medialive.ServicePackageData,
+		mediapackage.ServicePackageData,
+		mediastore.ServicePackageData,
+		memorydb.ServicePackageData,
+		meta.ServicePackageData,
+		mq.ServicePackageData,
+		mwaa.ServicePackageData,
+		neptune.ServicePackageData,
+		networkfirewall.ServicePackageData,
+		networkmanager.ServicePackageData,
+		opensearch.ServicePackageData,
+		opsworks.ServicePackageData,
+		organizations.ServicePackageData,
+		outposts.ServicePackageData,
+		pinpoint.ServicePackageData,
+		pricing.ServicePackageData,
+		qldb.ServicePackageData,
+		quicksight.ServicePackageData,
+		ram.ServicePackageData,
+		rds.ServicePackageData,
+		redshift.ServicePackageData,
+		redshiftdata.ServicePackageData,
+		redshiftserverless.ServicePackageData,
+		resourcegroups.ServicePackageData,
+		resourcegroupstaggingapi.ServicePackageData,
+		rolesanywhere.ServicePackageData,
+		route53.ServicePackageData,
+		route53domains.ServicePackageData,
+		route53recoverycontrolconfig.ServicePackageData,
+		route53recoveryreadiness.ServicePackageData,
+		route53resolver.ServicePackageData,
+		rum.ServicePackageData,
+		s3.ServicePackageData,
+		s3control.ServicePackageData,
+		s3outposts.ServicePackageData,
+		sagemaker.ServicePackageData,
+		schemas.ServicePackageData,
+		secretsmanager.ServicePackageData,
+		securityhub.ServicePackageData,
+		serverlessrepo.ServicePackageData,
+		servicecatalog.ServicePackageData,
+		servicediscovery.ServicePackageData,
+		servicequotas.ServicePackageData,
+		ses.ServicePackageData,
+		sesv2.ServicePackageData,
+		sfn.ServicePackageData,
+		shield.ServicePackageData,
+		signer.ServicePackageData,
+		simpledb.ServicePackageData,
+		sns.ServicePackageData,
+		sqs.ServicePackageData,
+		ssm.ServicePackageData,
+		ssoadmin.ServicePackageData,
+		storagegateway.ServicePackageData,
+		sts.ServicePackageData,
+		swf.ServicePackageData,
+		synthetics.ServicePackageData,
+		timestreamwrite.ServicePackageData,
+		transcribe.ServicePackageData,
+		transfer.ServicePackageData,
+		waf.ServicePackageData,
+		wafregional.ServicePackageData,
+		wafv2.ServicePackageData,
+		worklink.ServicePackageData,
+		workspaces.ServicePackageData,
+		xray.ServicePackageData,
+	}
+}
@@ -0,0 +1,122 @@
+package accessanalyzer
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/accessanalyzer"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/policy"
+)
+
+func DataSourcePolicyValidation() *schema.Resource {
+	return &schema.Resource{
+		ReadWithoutTimeout: dataSourcePolicyValidationRead,
+
+		Schema: map[string]*schema.Schema{
+			"findings": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"finding_details": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"finding_type": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"issue_code": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"learn_more_link": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"locations": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+					},
+				},
+			},
+			"locale": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringInSlice(accessanalyzer.Locale_Values(), false),
+			},
+			"policy_document": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringIsJSON,
+			},
+			"policy_type": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringInSlice(accessanalyzer.PolicyType_Values(), false),
+			},
+			"validate_policy_resource_type": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringInSlice(accessanalyzer.ValidatePolicyResourceType_Values(), false),
+			},
+		},
+	}
+}
+
+func dataSourcePolicyValidationRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).AccessAnalyzerConn()
+
+	document := d.Get("policy_document").(string)
+	input := &accessanalyzer.ValidatePolicyInput{
+		PolicyDocument: aws.String(document),
+		PolicyType:     aws.String(d.Get("policy_type").(string)),
+	}
+
+	if v, ok := d.GetOk("locale"); ok {
+		input.Locale = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("validate_policy_resource_type"); ok {
+		input.ValidatePolicyResourceType = aws.String(v.(string))
+	}
+
+	findings, err := policy.FindValidationFindings(ctx, conn, input)
+
+	if err != nil {
+		return diag.Errorf("validating Access Analyzer policy: %s", err)
+	}
+
+	d.SetId(strconv.Itoa(create.StringHashcode(document)))
+
+	if err := d.Set("findings", flattenValidatePolicyFindings(findings)); err != nil {
+		return diag.Errorf("setting findings: %s", err)
+	}
+
+	return nil
+}
+
+func flattenValidatePolicyFindings(apiObjects []*accessanalyzer.ValidatePolicyFinding) []interface{} {
+	tfList := make([]interface{}, 0, len(apiObjects))
+
+	for _, apiObject := range apiObjects {
+		tfList = append(tfList, map[string]interface{}{
+			"finding_details": aws.StringValue(apiObject.FindingDetails),
+			"finding_type":    aws.StringValue(apiObject.FindingType),
+			"issue_code":      aws.StringValue(apiObject.IssueCode),
+			"learn_more_link": aws.StringValue(apiObject.LearnMoreLink),
+			"locations":       flex.FlattenStringValueList(policy.ValidationFindingPaths(apiObject)),
+		})
+	}
+
+	return tfList
+}
@@ -0,0 +1,80 @@
+package accessanalyzer_test
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/accessanalyzer"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+)
+
+func TestAccAccessAnalyzerPolicyValidationDataSource_basic(t *testing.T) {
+	dataSourceName := "data.aws_accessanalyzer_policy_validation.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, accessanalyzer.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPolicyValidationDataSourceConfig_basic,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "findings.#", "1"),
+					resource.TestCheckResourceAttr(dataSourceName, "findings.0.finding_type", "ERROR"),
+					resource.TestCheckResourceAttr(dataSourceName, "findings.0.issue_code", "INVALID_ACTION"),
+					resource.TestCheckResourceAttr(dataSourceName, "findings.0.locations.0", "Statement[0].Action"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "findings.0.learn_more_link"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAccessAnalyzerPolicyValidationDataSource_valid(t *testing.T) {
+	dataSourceName := "data.aws_accessanalyzer_policy_validation.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, accessanalyzer.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPolicyValidationDataSourceConfig_valid,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "findings.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+const testAccPolicyValidationDataSourceConfig_basic = `
+data "aws_accessanalyzer_policy_validation" "test" {
+  policy_type = "IDENTITY_POLICY"
+
+  policy_document = jsonencode({
+    Version = "2012-10-17"
+    Statement = [{
+      Effect   = "Allow"
+      Action   = "s3:GetObjekt"
+      Resource = "arn:aws:s3:::example/*"
+    }]
+  })
+}
+`
+
+const testAccPolicyValidationDataSourceConfig_valid = `
+data "aws_accessanalyzer_policy_validation" "test" {
+  policy_type                   = "RESOURCE_POLICY"
+  validate_policy_resource_type = "AWS::IAM::AssumeRolePolicyDocument"
+
+  policy_document = jsonencode({
+    Version = "2012-10-17"
+    Statement = [{
+      Effect    = "Allow"
+      Action    = "sts:AssumeRole"
+      Principal = { Service = "ec2.amazonaws.com" }
+    }]
+  })
+}
+`
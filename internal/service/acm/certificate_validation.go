@@ -45,7 +45,7 @@ func ResourceCertificateValidation() *schema.Resource {
 }
 
 func resourceCertificateValidationCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	conn := meta.(*conns.AWSClient).ACMConn
+	conn := meta.(*conns.AWSClient).ACMConn()
 
 	arn := d.Get("certificate_arn").(string)
 	certificate, err := FindCertificateByARN(ctx, conn, arn)
@@ -98,7 +98,7 @@ func resourceCertificateValidationCreate(ctx context.Context, d *schema.Resource
 }
 
 func resourceCertificateValidationRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	conn := meta.(*conns.AWSClient).ACMConn
+	conn := meta.(*conns.AWSClient).ACMConn()
 
 	arn := d.Get("certificate_arn").(string)
 	certificate, err := FindCertificateValidationByARN(ctx, conn, arn)
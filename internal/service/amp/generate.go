@@ -1,4 +1,5 @@
 //go:generate go run ../../generate/tags/main.go -ListTags -ListTagsInIDElem=ResourceArn -ServiceTagsMap -TagInIDElem=ResourceArn -UpdateTags
+//go:generate go run ../../generate/servicepackagedata/main.go
 // ONLY generate directives and package declaration! Do not add anything else to this file.
 
 package amp
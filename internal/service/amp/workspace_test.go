@@ -218,7 +218,7 @@ func testAccCheckWorkspaceExists(n string, v *prometheusservice.WorkspaceDescrip
 			return fmt.Errorf("No Prometheus Workspace ID is set")
 		}
 
-		conn := acctest.Provider.Meta().(*conns.AWSClient).AMPConn
+		conn := acctest.Provider.Meta().(*conns.AWSClient).AMPConn()
 
 		output, err := tfamp.FindWorkspaceByID(context.Background(), conn, rs.Primary.ID)
 
@@ -233,7 +233,7 @@ func testAccCheckWorkspaceExists(n string, v *prometheusservice.WorkspaceDescrip
 }
 
 func testAccCheckWorkspaceDestroy(s *terraform.State) error {
-	conn := acctest.Provider.Meta().(*conns.AWSClient).AMPConn
+	conn := acctest.Provider.Meta().(*conns.AWSClient).AMPConn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_prometheus_workspace" {
@@ -0,0 +1,17 @@
+package apigateway
+
+import (
+	"github.com/aws/aws-sdk-go/service/apigateway"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func init() {
+	// Many operations can return an error such as:
+	//   ConflictException: Unable to complete operation due to concurrent modification. Please try again later.
+	// Handle them all globally for the service client.
+	conns.RegisterRetryRules(names.APIGateway, conns.RetryRule{
+		ErrorCode:    apigateway.ErrCodeConflictException,
+		ErrorMessage: "try again later",
+	})
+}
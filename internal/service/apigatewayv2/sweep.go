@@ -4,6 +4,7 @@
 package apigatewayv2
 
 import (
+	"context"
 	"fmt"
 	"log"
 
@@ -11,13 +12,12 @@ import (
 	"github.com/aws/aws-sdk-go/service/apigatewayv2"
 	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
 	"github.com/hashicorp/go-multierror"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/sweep"
 )
 
 func init() {
-	resource.AddTestSweepers("aws_apigatewayv2_api", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_apigatewayv2_api", &sweep.Sweeper{
 		Name: "aws_apigatewayv2_api",
 		F:    sweepAPIs,
 		Dependencies: []string{
@@ -25,33 +25,33 @@ func init() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_apigatewayv2_domain_name", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_apigatewayv2_domain_name", &sweep.Sweeper{
 		Name: "aws_apigatewayv2_domain_name",
 		F:    sweepDomainNames,
 	})
 
-	resource.AddTestSweepers("aws_apigatewayv2_vpc_link", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_apigatewayv2_vpc_link", &sweep.Sweeper{
 		Name: "aws_apigatewayv2_vpc_link",
 		F:    sweepVPCLinks,
 	})
 
-	resource.AddTestSweepers("aws_apigatewayv2_api_mapping", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_apigatewayv2_api_mapping", &sweep.Sweeper{
 		Name: "aws_apigatewayv2_api_mapping",
 		F:    sweepAPIMappings,
 	})
 
-	resource.AddTestSweepers("aws_apigatewayv2_stage", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_apigatewayv2_stage", &sweep.Sweeper{
 		Name: "aws_apigatewayv2_stage",
 		F:    sweepStages,
 	})
 }
 
-func sweepAPIs(region string) error {
+func sweepAPIs(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
 	}
-	conn := client.(*conns.AWSClient).APIGatewayV2Conn
+	conn := client.(*conns.AWSClient).APIGatewayV2Conn()
 	input := &apigatewayv2.GetApisInput{}
 	var sweeperErrs *multierror.Error
 
@@ -90,12 +90,12 @@ func sweepAPIs(region string) error {
 	return sweeperErrs.ErrorOrNil()
 }
 
-func sweepDomainNames(region string) error {
+func sweepDomainNames(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %w", err)
 	}
-	conn := client.(*conns.AWSClient).APIGatewayV2Conn
+	conn := client.(*conns.AWSClient).APIGatewayV2Conn()
 	input := &apigatewayv2.GetDomainNamesInput{}
 	var sweeperErrs *multierror.Error
 
@@ -132,12 +132,12 @@ func sweepDomainNames(region string) error {
 	return sweeperErrs.ErrorOrNil()
 }
 
-func sweepVPCLinks(region string) error {
+func sweepVPCLinks(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
 	}
-	conn := client.(*conns.AWSClient).APIGatewayV2Conn
+	conn := client.(*conns.AWSClient).APIGatewayV2Conn()
 	input := &apigatewayv2.GetVpcLinksInput{}
 	var sweeperErrs *multierror.Error
 
@@ -187,12 +187,12 @@ func sweepVPCLinks(region string) error {
 	return sweeperErrs.ErrorOrNil()
 }
 
-func sweepAPIMappings(region string) error {
+func sweepAPIMappings(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %w", err)
 	}
-	conn := client.(*conns.AWSClient).APIGatewayV2Conn
+	conn := client.(*conns.AWSClient).APIGatewayV2Conn()
 	var sweeperErrs *multierror.Error
 	log.Printf("[INFO] API Gateway v2 API Mapping")
 
@@ -228,12 +228,12 @@ func sweepAPIMappings(region string) error {
 	return sweeperErrs.ErrorOrNil()
 }
 
-func sweepStages(region string) error {
+func sweepStages(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %w", err)
 	}
-	conn := client.(*conns.AWSClient).APIGatewayV2Conn
+	conn := client.(*conns.AWSClient).APIGatewayV2Conn()
 	var sweeperErrs *multierror.Error
 	log.Printf("[INFO] API Gateway v2 Stages")
 
@@ -0,0 +1,15 @@
+package appautoscaling
+
+import (
+	"github.com/aws/aws-sdk-go/service/applicationautoscaling"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func init() {
+	// Workaround for https://github.com/aws/aws-sdk-go/issues/1472
+	conns.RegisterRetryRules(names.AppAutoScaling, conns.RetryRule{
+		OperationPrefixes: []string{"Describe", "List"},
+		ErrorCode:         applicationautoscaling.ErrCodeFailedResourceAccessException,
+	})
+}
@@ -37,7 +37,7 @@ const (
 )
 
 func dataSourceEnvironmentsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	conn := meta.(*conns.AWSClient).AppConfigConn
+	conn := meta.(*conns.AWSClient).AppConfigConn()
 	appID := d.Get("application_id").(string)
 
 	out, err := findEnvironmentsByApplication(ctx, conn, appID)
@@ -0,0 +1,17 @@
+package appconfig
+
+import (
+	"github.com/aws/aws-sdk-go/service/appconfig"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func init() {
+	// StartDeployment operations can return a ConflictException
+	// if ongoing deployments are in-progress, thus we handle them
+	// here for the service client.
+	conns.RegisterRetryRules(names.AppConfig, conns.RetryRule{
+		Operations: []string{"StartDeployment"},
+		ErrorCode:  appconfig.ErrCodeConflictException,
+	})
+}
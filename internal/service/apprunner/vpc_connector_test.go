@@ -102,7 +102,7 @@ func testAccCheckVPCConnectorDestroy(s *terraform.State) error {
 			continue
 		}
 
-		conn := acctest.Provider.Meta().(*conns.AWSClient).AppRunnerConn
+		conn := acctest.Provider.Meta().(*conns.AWSClient).AppRunnerConn()
 
 		input := &apprunner.DescribeVpcConnectorInput{
 			VpcConnectorArn: aws.String(rs.Primary.ID),
@@ -137,7 +137,7 @@ func testAccCheckVPCConnectorExists(n string) resource.TestCheckFunc {
 			return fmt.Errorf("No App Runner Vpc Connector ID is set")
 		}
 
-		conn := acctest.Provider.Meta().(*conns.AWSClient).AppRunnerConn
+		conn := acctest.Provider.Meta().(*conns.AWSClient).AppRunnerConn()
 
 		input := &apprunner.DescribeVpcConnectorInput{
 			VpcConnectorArn: aws.String(rs.Primary.ID),
@@ -234,7 +234,7 @@ resource "aws_apprunner_vpc_connector" "test" {
 }
 
 func testAccPreCheckVPCConnector(t *testing.T) {
-	conn := acctest.Provider.Meta().(*conns.AWSClient).AppRunnerConn
+	conn := acctest.Provider.Meta().(*conns.AWSClient).AppRunnerConn()
 	ctx := context.Background()
 
 	input := &apprunner.ListVpcConnectorsInput{}
@@ -4,44 +4,44 @@
 package appstream
 
 import (
+	"context"
 	"fmt"
 	"log"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/appstream"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/sweep"
 )
 
 func init() {
-	resource.AddTestSweepers("aws_appstream_directory_config", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_appstream_directory_config", &sweep.Sweeper{
 		Name: "aws_appstream_directory_config",
 		F:    sweepDirectoryConfigs,
 	})
 
-	resource.AddTestSweepers("aws_appstream_fleet", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_appstream_fleet", &sweep.Sweeper{
 		Name: "aws_appstream_fleet",
 		F:    sweepFleets,
 	})
 
-	resource.AddTestSweepers("aws_appstream_image_builder", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_appstream_image_builder", &sweep.Sweeper{
 		Name: "aws_appstream_image_builder",
 		F:    sweepImageBuilders,
 	})
 
-	resource.AddTestSweepers("aws_appstream_stack", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_appstream_stack", &sweep.Sweeper{
 		Name: "aws_appstream_stack",
 		F:    sweepStacks,
 	})
 }
 
-func sweepDirectoryConfigs(region string) error {
+func sweepDirectoryConfigs(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %w", err)
 	}
-	conn := client.(*conns.AWSClient).AppStreamConn
+	conn := client.(*conns.AWSClient).AppStreamConn()
 	input := &appstream.DescribeDirectoryConfigsInput{}
 	sweepResources := make([]sweep.Sweepable, 0)
 
@@ -70,7 +70,7 @@ func sweepDirectoryConfigs(region string) error {
 		return fmt.Errorf("error listing AppStream Directory Configs (%s): %w", region, err)
 	}
 
-	err = sweep.SweepOrchestrator(sweepResources)
+	err = sweep.SweepOrchestrator(ctx, sweepResources)
 
 	if err != nil {
 		return fmt.Errorf("error sweeping AppStream Directory Configs (%s): %w", region, err)
@@ -79,12 +79,12 @@ func sweepDirectoryConfigs(region string) error {
 	return nil
 }
 
-func sweepFleets(region string) error {
+func sweepFleets(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %w", err)
 	}
-	conn := client.(*conns.AWSClient).AppStreamConn
+	conn := client.(*conns.AWSClient).AppStreamConn()
 	input := &appstream.DescribeFleetsInput{}
 	sweepResources := make([]sweep.Sweepable, 0)
 
@@ -113,7 +113,7 @@ func sweepFleets(region string) error {
 		return fmt.Errorf("error listing AppStream Fleets (%s): %w", region, err)
 	}
 
-	err = sweep.SweepOrchestrator(sweepResources)
+	err = sweep.SweepOrchestrator(ctx, sweepResources)
 
 	if err != nil {
 		return fmt.Errorf("error sweeping AppStream Fleets (%s): %w", region, err)
@@ -122,12 +122,12 @@ func sweepFleets(region string) error {
 	return nil
 }
 
-func sweepImageBuilders(region string) error {
+func sweepImageBuilders(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %w", err)
 	}
-	conn := client.(*conns.AWSClient).AppStreamConn
+	conn := client.(*conns.AWSClient).AppStreamConn()
 	input := &appstream.DescribeImageBuildersInput{}
 	sweepResources := make([]sweep.Sweepable, 0)
 
@@ -156,7 +156,7 @@ func sweepImageBuilders(region string) error {
 		return fmt.Errorf("error listing AppStream Image Builders (%s): %w", region, err)
 	}
 
-	err = sweep.SweepOrchestrator(sweepResources)
+	err = sweep.SweepOrchestrator(ctx, sweepResources)
 
 	if err != nil {
 		return fmt.Errorf("error sweeping AppStream Image Builders (%s): %w", region, err)
@@ -165,12 +165,12 @@ func sweepImageBuilders(region string) error {
 	return nil
 }
 
-func sweepStacks(region string) error {
+func sweepStacks(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %w", err)
 	}
-	conn := client.(*conns.AWSClient).AppStreamConn
+	conn := client.(*conns.AWSClient).AppStreamConn()
 	input := &appstream.DescribeStacksInput{}
 	sweepResources := make([]sweep.Sweepable, 0)
 
@@ -199,7 +199,7 @@ func sweepStacks(region string) error {
 		return fmt.Errorf("error listing AppStream Stacks (%s): %w", region, err)
 	}
 
-	err = sweep.SweepOrchestrator(sweepResources)
+	err = sweep.SweepOrchestrator(ctx, sweepResources)
 
 	if err != nil {
 		return fmt.Errorf("error sweeping AppStream Stacks (%s): %w", region, err)
@@ -79,7 +79,7 @@ func testAccDomainNameAPIAssociation_disappears(t *testing.T) {
 }
 
 func testAccCheckDomainNameAPIAssociationDestroy(s *terraform.State) error {
-	conn := acctest.Provider.Meta().(*conns.AWSClient).AppSyncConn
+	conn := acctest.Provider.Meta().(*conns.AWSClient).AppSyncConn()
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_appsync_domain_name" {
 			continue
@@ -108,7 +108,7 @@ func testAccCheckDomainNameAPIAssociationExists(resourceName string, DomainNameA
 		if !ok {
 			return fmt.Errorf("Appsync Domain Name Not found in state: %s", resourceName)
 		}
-		conn := acctest.Provider.Meta().(*conns.AWSClient).AppSyncConn
+		conn := acctest.Provider.Meta().(*conns.AWSClient).AppSyncConn()
 
 		association, err := tfappsync.FindDomainNameAPIAssociationByID(conn, rs.Primary.ID)
 		if err != nil {
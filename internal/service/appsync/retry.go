@@ -0,0 +1,15 @@
+package appsync
+
+import (
+	"github.com/aws/aws-sdk-go/service/appsync"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func init() {
+	conns.RegisterRetryRules(names.AppSync, conns.RetryRule{
+		Operations:   []string{"CreateGraphqlApi"},
+		ErrorCode:    appsync.ErrCodeConcurrentModificationException,
+		ErrorMessage: "a GraphQL API creation is already in progress",
+	})
+}
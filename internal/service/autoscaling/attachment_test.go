@@ -113,7 +113,7 @@ func TestAccAutoScalingAttachment_multipleALBTargetGroups(t *testing.T) {
 }
 
 func testAccCheckAttachmentDestroy(s *terraform.State) error {
-	conn := acctest.Provider.Meta().(*conns.AWSClient).AutoScalingConn
+	conn := acctest.Provider.Meta().(*conns.AWSClient).AutoScalingConn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_autoscaling_attachment" {
@@ -151,7 +151,7 @@ func testAccCheckAttachmentByLoadBalancerNameExists(n string) resource.TestCheck
 			return fmt.Errorf("Not found: %s", n)
 		}
 
-		conn := acctest.Provider.Meta().(*conns.AWSClient).AutoScalingConn
+		conn := acctest.Provider.Meta().(*conns.AWSClient).AutoScalingConn()
 
 		return tfautoscaling.FindAttachmentByLoadBalancerName(conn, rs.Primary.Attributes["autoscaling_group_name"], rs.Primary.Attributes["elb"])
 	}
@@ -164,7 +164,7 @@ func testAccCheckAttachmentByTargetGroupARNExists(n string) resource.TestCheckFu
 			return fmt.Errorf("Not found: %s", n)
 		}
 
-		conn := acctest.Provider.Meta().(*conns.AWSClient).AutoScalingConn
+		conn := acctest.Provider.Meta().(*conns.AWSClient).AutoScalingConn()
 
 		targetGroupARN := rs.Primary.Attributes["lb_target_group_arn"]
 		if targetGroupARN == "" {
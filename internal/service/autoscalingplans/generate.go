@@ -1,4 +1,5 @@
 //go:generate go run ../../generate/listpages/main.go -ListOps=DescribeScalingPlans
+//go:generate go run ../../generate/servicepackagedata/main.go
 // ONLY generate directives and package declaration! Do not add anything else to this file.
 
 package autoscalingplans
@@ -226,7 +226,7 @@ func ResourceBudget() *schema.Resource {
 }
 
 func resourceBudgetCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).BudgetsConn
+	conn := meta.(*conns.AWSClient).BudgetsConn()
 
 	budget, err := expandBudgetUnmarshal(d)
 	if err != nil {
@@ -265,7 +265,7 @@ func resourceBudgetCreate(d *schema.ResourceData, meta interface{}) error {
 }
 
 func resourceBudgetRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).BudgetsConn
+	conn := meta.(*conns.AWSClient).BudgetsConn()
 
 	accountID, budgetName, err := BudgetParseResourceID(d.Id())
 
@@ -385,7 +385,7 @@ func resourceBudgetRead(d *schema.ResourceData, meta interface{}) error {
 }
 
 func resourceBudgetUpdate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).BudgetsConn
+	conn := meta.(*conns.AWSClient).BudgetsConn()
 
 	accountID, _, err := BudgetParseResourceID(d.Id())
 
@@ -416,7 +416,7 @@ func resourceBudgetUpdate(d *schema.ResourceData, meta interface{}) error {
 }
 
 func resourceBudgetDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).BudgetsConn
+	conn := meta.(*conns.AWSClient).BudgetsConn()
 
 	accountID, budgetName, err := BudgetParseResourceID(d.Id())
 
@@ -442,7 +442,7 @@ func resourceBudgetDelete(d *schema.ResourceData, meta interface{}) error {
 }
 
 func resourceBudgetNotificationsCreate(notifications []*budgets.Notification, subscribers [][]*budgets.Subscriber, budgetName string, accountID string, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).BudgetsConn
+	conn := meta.(*conns.AWSClient).BudgetsConn()
 
 	for i, notification := range notifications {
 		subscribers := subscribers[i]
@@ -465,7 +465,7 @@ func resourceBudgetNotificationsCreate(notifications []*budgets.Notification, su
 }
 
 func resourceBudgetNotificationsUpdate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).BudgetsConn
+	conn := meta.(*conns.AWSClient).BudgetsConn()
 
 	accountID, budgetName, err := BudgetParseResourceID(d.Id())
 
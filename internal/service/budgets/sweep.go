@@ -4,6 +4,7 @@
 package budgets
 
 import (
+	"context"
 	"fmt"
 	"log"
 
@@ -11,29 +12,28 @@ import (
 	"github.com/aws/aws-sdk-go/service/budgets"
 	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
 	"github.com/hashicorp/go-multierror"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/sweep"
 )
 
 func init() {
-	resource.AddTestSweepers("aws_budgets_budget_action", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_budgets_budget_action", &sweep.Sweeper{
 		Name: "aws_budgets_budget_action",
 		F:    sweepBudgetActions,
 	})
 
-	resource.AddTestSweepers("aws_budgets_budget", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_budgets_budget", &sweep.Sweeper{
 		Name: "aws_budgets_budget",
 		F:    sweepBudgets,
 	})
 }
 
-func sweepBudgetActions(region string) error {
+func sweepBudgetActions(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %w", err)
 	}
-	conn := client.(*conns.AWSClient).BudgetsConn
+	conn := client.(*conns.AWSClient).BudgetsConn()
 	accountID := client.(*conns.AWSClient).AccountID
 	input := &budgets.DescribeBudgetActionsForAccountInput{
 		AccountId: aws.String(accountID),
@@ -78,12 +78,12 @@ func sweepBudgetActions(region string) error {
 	return sweeperErrs.ErrorOrNil()
 }
 
-func sweepBudgets(region string) error { // nosemgrep:ci.budgets-in-func-name
+func sweepBudgets(ctx context.Context, region string) error { // nosemgrep:ci.budgets-in-func-name
 	client, err := sweep.SharedRegionalSweepClient(region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %w", err)
 	}
-	conn := client.(*conns.AWSClient).BudgetsConn
+	conn := client.(*conns.AWSClient).BudgetsConn()
 	accountID := client.(*conns.AWSClient).AccountID
 	input := &budgets.DescribeBudgetsInput{
 		AccountId: aws.String(accountID),
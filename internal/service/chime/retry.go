@@ -0,0 +1,17 @@
+package chime
+
+import (
+	"github.com/aws/aws-sdk-go/service/chime"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func init() {
+	// When calling CreateVoiceConnector across multiple resources,
+	// the API can randomly return a BadRequestException without explanation
+	conns.RegisterRetryRules(names.Chime, conns.RetryRule{
+		Operations:   []string{"CreateVoiceConnector"},
+		ErrorCode:    chime.ErrCodeBadRequestException,
+		ErrorMessage: "Service received a bad request",
+	})
+}
@@ -0,0 +1,301 @@
+package cloudcontrol
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+
+	cfschema "github.com/hashicorp/aws-cloudformation-resource-schema-sdk-go"
+	"github.com/mattbaird/jsonpatch"
+)
+
+// Functions for normalising a resource's desired state using its CloudFormation resource type schema.
+
+// propertyPathWildcard is the JSON Pointer reference token used in resource type schemas to match any array element.
+const propertyPathWildcard = "*"
+
+// resourceTypeSchema parses the CloudFormation resource type schema JSON.
+func resourceTypeSchema(s string) (*cfschema.Resource, error) {
+	s, err := cfschema.Sanitize(s)
+
+	if err != nil {
+		return nil, fmt.Errorf("sanitizing CloudFormation Resource Schema JSON: %w", err)
+	}
+
+	doc, err := cfschema.NewResourceJsonSchemaDocument(s)
+
+	if err != nil {
+		return nil, fmt.Errorf("parsing CloudFormation Resource Schema JSON: %w", err)
+	}
+
+	resource, err := doc.Resource()
+
+	if err != nil {
+		return nil, fmt.Errorf("converting CloudFormation Resource Schema JSON: %w", err)
+	}
+
+	return resource, nil
+}
+
+// normalizeDesiredState returns the desired state in canonical form (sorted keys, no insignificant whitespace)
+// with any read-only properties, which are ignored by Cloud Control API, removed.
+// If resource is nil, the desired state is only canonicalised.
+func normalizeDesiredState(resource *cfschema.Resource, desiredState string) (string, error) {
+	var v map[string]interface{}
+
+	if err := json.Unmarshal([]byte(desiredState), &v); err != nil {
+		return "", err
+	}
+
+	if resource != nil {
+		for _, pointer := range resource.ReadOnlyProperties {
+			removePropertyPath(v, pointer.Path())
+		}
+	}
+
+	b, err := json.Marshal(v)
+
+	if err != nil {
+		return "", err
+	}
+
+	return string(b), nil
+}
+
+// desiredStatesEqual returns whether two desired states are equivalent once normalised.
+func desiredStatesEqual(resource *cfschema.Resource, old, new string) bool {
+	oldNormalized, err := normalizeDesiredState(resource, old)
+
+	if err != nil {
+		return false
+	}
+
+	newNormalized, err := normalizeDesiredState(resource, new)
+
+	if err != nil {
+		return false
+	}
+
+	return oldNormalized == newNormalized
+}
+
+// observedDesiredState returns the desired state refreshed from the resource's current properties, so that drift can be detected.
+// Only properties present in the desired state are refreshed; properties set outside Terraform or defaulted by the service are ignored.
+// Write-only properties are never returned by Cloud Control API and properties not returned are kept as desired.
+func observedDesiredState(resource *cfschema.Resource, desiredState, properties string) (string, error) {
+	var desired, observed map[string]interface{}
+
+	if err := json.Unmarshal([]byte(desiredState), &desired); err != nil {
+		return "", fmt.Errorf("parsing desired state: %w", err)
+	}
+
+	if err := json.Unmarshal([]byte(properties), &observed); err != nil {
+		return "", fmt.Errorf("parsing properties: %w", err)
+	}
+
+	v := refreshObject(resource, nil, desired, observed)
+
+	b, err := json.Marshal(v)
+
+	if err != nil {
+		return "", err
+	}
+
+	return normalizeDesiredState(resource, string(b))
+}
+
+func refreshObject(resource *cfschema.Resource, path []string, desired, observed map[string]interface{}) map[string]interface{} {
+	result := make(map[string]interface{}, len(desired))
+
+	for k, desiredValue := range desired {
+		path := append(path[:len(path):len(path)], k)
+		observedValue, ok := observed[k]
+
+		if !ok || (resource != nil && isPropertyPath(resource.WriteOnlyProperties, path)) {
+			result[k] = desiredValue
+			continue
+		}
+
+		result[k] = refreshValue(resource, path, desiredValue, observedValue)
+	}
+
+	return result
+}
+
+func refreshValue(resource *cfschema.Resource, path []string, desired, observed interface{}) interface{} {
+	switch desired := desired.(type) {
+	case map[string]interface{}:
+		if observed, ok := observed.(map[string]interface{}); ok {
+			return refreshObject(resource, path, desired, observed)
+		}
+	case []interface{}:
+		// Refresh array elements in place if the number of elements is unchanged.
+		if observed, ok := observed.([]interface{}); ok && len(observed) == len(desired) {
+			result := make([]interface{}, len(desired))
+			path := append(path[:len(path):len(path)], propertyPathWildcard)
+
+			for i := range desired {
+				result[i] = refreshValue(resource, path, desired[i], observed[i])
+			}
+
+			return result
+		}
+	default:
+		// Numbers may be represented as strings and vice versa.
+		if scalarsEqual(desired, observed) {
+			return desired
+		}
+	}
+
+	return observed
+}
+
+func scalarsEqual(v1, v2 interface{}) bool {
+	if reflect.DeepEqual(v1, v2) {
+		return true
+	}
+
+	s1, ok1 := scalarString(v1)
+	s2, ok2 := scalarString(v2)
+
+	return ok1 && ok2 && s1 == s2
+}
+
+func scalarString(v interface{}) (string, bool) {
+	switch v := v.(type) {
+	case string:
+		return v, true
+	case float64:
+		return strconv.FormatFloat(v, 'f', -1, 64), true
+	case bool:
+		return strconv.FormatBool(v), true
+	default:
+		return "", false
+	}
+}
+
+// propertiesMap returns the resource's top-level properties as a map of strings.
+// Nested objects and arrays are JSON-encoded.
+func propertiesMap(properties string) (map[string]string, error) {
+	var v map[string]interface{}
+
+	if err := json.Unmarshal([]byte(properties), &v); err != nil {
+		return nil, err
+	}
+
+	result := make(map[string]string, len(v))
+
+	for k, v := range v {
+		if v == nil {
+			continue
+		}
+
+		if s, ok := scalarString(v); ok {
+			result[k] = s
+			continue
+		}
+
+		b, err := json.Marshal(v)
+
+		if err != nil {
+			return nil, err
+		}
+
+		result[k] = string(b)
+	}
+
+	return result, nil
+}
+
+// patchDocument returns a JSON Patch document describing the difference between the `old` and `new` desired states.
+// Read-only properties are ignored.
+func patchDocument(resource *cfschema.Resource, old, new string) (string, error) {
+	old, err := normalizeDesiredState(resource, old)
+
+	if err != nil {
+		return "", err
+	}
+
+	new, err = normalizeDesiredState(resource, new)
+
+	if err != nil {
+		return "", err
+	}
+
+	patch, err := jsonpatch.CreatePatch([]byte(old), []byte(new))
+
+	if err != nil {
+		return "", err
+	}
+
+	b, err := json.Marshal(patch)
+
+	if err != nil {
+		return "", err
+	}
+
+	return string(b), nil
+}
+
+// isPropertyPath returns whether the property path matches any of the JSON Pointers.
+func isPropertyPath(pointers cfschema.PropertyJsonPointers, path []string) bool {
+	for _, pointer := range pointers {
+		pointerPath := pointer.Path()
+
+		if len(pointerPath) != len(path) {
+			continue
+		}
+
+		match := true
+
+		for i, segment := range pointerPath {
+			if segment = unescapePathSegment(segment); segment != propertyPathWildcard && segment != path[i] {
+				match = false
+				break
+			}
+		}
+
+		if match {
+			return true
+		}
+	}
+
+	return false
+}
+
+// removePropertyPath removes the property at the JSON Pointer path from the decoded JSON value.
+func removePropertyPath(v interface{}, path []string) {
+	if len(path) == 0 {
+		return
+	}
+
+	segment := unescapePathSegment(path[0])
+
+	switch v := v.(type) {
+	case map[string]interface{}:
+		if len(path) == 1 {
+			delete(v, segment)
+			return
+		}
+
+		removePropertyPath(v[segment], path[1:])
+	case []interface{}:
+		if segment != propertyPathWildcard {
+			if i, err := strconv.Atoi(segment); err == nil && i >= 0 && i < len(v) {
+				removePropertyPath(v[i], path[1:])
+			}
+			return
+		}
+
+		for _, v := range v {
+			removePropertyPath(v, path[1:])
+		}
+	}
+}
+
+// unescapePathSegment decodes an RFC 6901 JSON Pointer reference token.
+func unescapePathSegment(s string) string {
+	return strings.ReplaceAll(strings.ReplaceAll(s, "~1", "/"), "~0", "~")
+}
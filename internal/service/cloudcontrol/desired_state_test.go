@@ -0,0 +1,216 @@
+package cloudcontrol
+
+import (
+	"reflect"
+	"testing"
+)
+
+const testResourceTypeSchema = `{
+  "typeName": "Example::Service::Thing",
+  "description": "Example",
+  "properties": {
+    "Arn": {"type": "string"},
+    "Name": {"type": "string"},
+    "Password": {"type": "string"},
+    "Size": {"type": "integer"},
+    "Config": {
+      "type": "object",
+      "properties": {
+        "Enabled": {"type": "boolean"},
+        "Id": {"type": "string"}
+      }
+    },
+    "Rules": {
+      "type": "array",
+      "items": {
+        "type": "object",
+        "properties": {
+          "Name": {"type": "string"},
+          "Secret": {"type": "string"}
+        }
+      }
+    }
+  },
+  "primaryIdentifier": ["/properties/Name"],
+  "createOnlyProperties": ["/properties/Name"],
+  "readOnlyProperties": ["/properties/Arn", "/properties/Config/Id"],
+  "writeOnlyProperties": ["/properties/Password", "/properties/Rules/*/Secret"],
+  "additionalProperties": false
+}`
+
+func TestNormalizeDesiredState(t *testing.T) {
+	cfResource, err := resourceTypeSchema(testResourceTypeSchema)
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	testCases := []struct {
+		Name         string
+		DesiredState string
+		Expected     string
+	}{
+		{
+			Name:         "canonical",
+			DesiredState: `{"Name":"test","Size":1}`,
+			Expected:     `{"Name":"test","Size":1}`,
+		},
+		{
+			Name:         "key order and whitespace",
+			DesiredState: "{\n  \"Size\": 1,\n  \"Name\": \"test\"\n}",
+			Expected:     `{"Name":"test","Size":1}`,
+		},
+		{
+			Name:         "read-only properties",
+			DesiredState: `{"Arn":"arn:aws:example","Config":{"Enabled":true,"Id":"1"},"Name":"test"}`,
+			Expected:     `{"Config":{"Enabled":true},"Name":"test"}`,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			got, err := normalizeDesiredState(cfResource, testCase.DesiredState)
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if got != testCase.Expected {
+				t.Errorf("got %s, expected %s", got, testCase.Expected)
+			}
+		})
+	}
+}
+
+func TestObservedDesiredState(t *testing.T) {
+	cfResource, err := resourceTypeSchema(testResourceTypeSchema)
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	testCases := []struct {
+		Name         string
+		DesiredState string
+		Properties   string
+		Expected     string
+	}{
+		{
+			Name:         "no drift",
+			DesiredState: `{"Name":"test","Size":1}`,
+			Properties:   `{"Arn":"arn:aws:example","Name":"test","Size":1}`,
+			Expected:     `{"Name":"test","Size":1}`,
+		},
+		{
+			Name:         "nested drift",
+			DesiredState: `{"Config":{"Enabled":true},"Name":"test"}`,
+			Properties:   `{"Config":{"Enabled":false,"Id":"1"},"Name":"test"}`,
+			Expected:     `{"Config":{"Enabled":false},"Name":"test"}`,
+		},
+		{
+			Name:         "string number",
+			DesiredState: `{"Name":"test","Size":"1"}`,
+			Properties:   `{"Name":"test","Size":1}`,
+			Expected:     `{"Name":"test","Size":"1"}`,
+		},
+		{
+			Name:         "write-only",
+			DesiredState: `{"Name":"test","Password":"secret","Rules":[{"Name":"rule","Secret":"secret"}]}`,
+			Properties:   `{"Name":"test","Rules":[{"Name":"rule"}]}`,
+			Expected:     `{"Name":"test","Password":"secret","Rules":[{"Name":"rule","Secret":"secret"}]}`,
+		},
+		{
+			Name:         "array drift",
+			DesiredState: `{"Name":"test","Rules":[{"Name":"rule"}]}`,
+			Properties:   `{"Name":"test","Rules":[{"Name":"rule"},{"Name":"other"}]}`,
+			Expected:     `{"Name":"test","Rules":[{"Name":"rule"},{"Name":"other"}]}`,
+		},
+		{
+			Name:         "unmanaged properties",
+			DesiredState: `{"Name":"test"}`,
+			Properties:   `{"Name":"test","Size":10}`,
+			Expected:     `{"Name":"test"}`,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			got, err := observedDesiredState(cfResource, testCase.DesiredState, testCase.Properties)
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if got != testCase.Expected {
+				t.Errorf("got %s, expected %s", got, testCase.Expected)
+			}
+		})
+	}
+}
+
+func TestPatchDocument(t *testing.T) {
+	cfResource, err := resourceTypeSchema(testResourceTypeSchema)
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	testCases := []struct {
+		Name     string
+		Old      string
+		New      string
+		Expected string
+	}{
+		{
+			Name:     "unchanged",
+			Old:      `{"Name":"test","Size":1}`,
+			New:      "{\n  \"Size\": 1,\n  \"Name\": \"test\"\n}",
+			Expected: `[]`,
+		},
+		{
+			Name:     "nested change",
+			Old:      `{"Config":{"Enabled":true},"Name":"test","Size":1}`,
+			New:      `{"Config":{"Enabled":false},"Name":"test","Size":1}`,
+			Expected: `[{"op":"replace","path":"/Config/Enabled","value":false}]`,
+		},
+		{
+			Name:     "read-only change",
+			Old:      `{"Arn":"arn:aws:example:1","Name":"test"}`,
+			New:      `{"Arn":"arn:aws:example:2","Name":"test"}`,
+			Expected: `[]`,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			got, err := patchDocument(cfResource, testCase.Old, testCase.New)
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if got != testCase.Expected {
+				t.Errorf("got %s, expected %s", got, testCase.Expected)
+			}
+		})
+	}
+}
+
+func TestPropertiesMap(t *testing.T) {
+	got, err := propertiesMap(`{"Config":{"Enabled":true},"Empty":null,"Name":"test","Rules":[{"Name":"rule"}],"Size":1}`)
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	expected := map[string]string{
+		"Config": `{"Enabled":true}`,
+		"Name":   "test",
+		"Rules":  `[{"Name":"rule"}]`,
+		"Size":   "1",
+	}
+
+	if !reflect.DeepEqual(got, expected) {
+		t.Errorf("got %v, expected %v", got, expected)
+	}
+}
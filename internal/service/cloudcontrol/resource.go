@@ -2,7 +2,6 @@ package cloudcontrol
 
 import (
 	"context"
-	"encoding/json"
 	"fmt"
 	"log"
 	"regexp"
@@ -37,13 +36,19 @@ func ResourceResource() *schema.Resource {
 
 		Schema: map[string]*schema.Schema{
 			"desired_state": {
-				Type:     schema.TypeString,
-				Required: true,
+				Type:             schema.TypeString,
+				Required:         true,
+				DiffSuppressFunc: suppressEquivalentDesiredStateDiffs,
 			},
 			"properties": {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+			"properties_map": {
+				Type:     schema.TypeMap,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
 			"role_arn": {
 				Type:     schema.TypeString,
 				Optional: true,
@@ -72,12 +77,15 @@ func ResourceResource() *schema.Resource {
 			customdiff.ComputedIf("properties", func(ctx context.Context, diff *schema.ResourceDiff, meta interface{}) bool {
 				return diff.HasChange("desired_state")
 			}),
+			customdiff.ComputedIf("properties_map", func(ctx context.Context, diff *schema.ResourceDiff, meta interface{}) bool {
+				return diff.HasChange("desired_state")
+			}),
 		),
 	}
 }
 
 func resourceResourceCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	conn := meta.(*conns.AWSClient).CloudControlConn
+	conn := meta.(*conns.AWSClient).CloudControlConn()
 
 	typeName := d.Get("type_name").(string)
 	input := &cloudcontrolapi.CreateResourceInput{
@@ -122,7 +130,7 @@ func resourceResourceCreate(ctx context.Context, d *schema.ResourceData, meta in
 }
 
 func resourceResourceRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	conn := meta.(*conns.AWSClient).CloudControlConn
+	conn := meta.(*conns.AWSClient).CloudControlConn()
 
 	resourceDescription, err := FindResourceByID(ctx, conn,
 		d.Id(),
@@ -141,18 +149,57 @@ func resourceResourceRead(ctx context.Context, d *schema.ResourceData, meta inte
 		return diag.FromErr(fmt.Errorf("error reading Cloud Control API Resource (%s): %w", d.Id(), err))
 	}
 
-	d.Set("properties", resourceDescription.Properties)
+	properties := aws.StringValue(resourceDescription.Properties)
+	d.Set("properties", properties)
+
+	tfMap, err := propertiesMap(properties)
+
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("error reading Cloud Control API Resource (%s): parsing properties: %w", d.Id(), err))
+	}
+
+	d.Set("properties_map", tfMap)
+
+	// Refresh desired state from the current properties so that drift is detected.
+	if desiredState, resourceSchema := d.Get("desired_state").(string), d.Get("schema").(string); !d.IsNewResource() && desiredState != "" && resourceSchema != "" {
+		cfResource, err := resourceTypeSchema(resourceSchema)
+
+		if err != nil {
+			return diag.FromErr(fmt.Errorf("error reading Cloud Control API Resource (%s): %w", d.Id(), err))
+		}
+
+		v, err := observedDesiredState(cfResource, desiredState, properties)
+
+		if err != nil {
+			return diag.FromErr(fmt.Errorf("error reading Cloud Control API Resource (%s): %w", d.Id(), err))
+		}
+
+		if !desiredStatesEqual(cfResource, desiredState, v) {
+			d.Set("desired_state", v)
+		}
+	}
 
 	return nil
 }
 
 func resourceResourceUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	conn := meta.(*conns.AWSClient).CloudControlConn
+	conn := meta.(*conns.AWSClient).CloudControlConn()
 
 	if d.HasChange("desired_state") {
 		oldRaw, newRaw := d.GetChange("desired_state")
 
-		patchDocument, err := patchDocument(oldRaw.(string), newRaw.(string))
+		// Without a schema, read-only properties can't be ignored.
+		var cfResource *cfschema.Resource
+
+		if v := d.Get("schema").(string); v != "" {
+			var err error
+
+			if cfResource, err = resourceTypeSchema(v); err != nil {
+				return diag.FromErr(fmt.Errorf("error updating Cloud Control API Resource (%s): %w", d.Id(), err))
+			}
+		}
+
+		patchDocument, err := patchDocument(cfResource, oldRaw.(string), newRaw.(string))
 
 		if err != nil {
 			return diag.Diagnostics{
@@ -198,7 +245,7 @@ func resourceResourceUpdate(ctx context.Context, d *schema.ResourceData, meta in
 }
 
 func resourceResourceDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	conn := meta.(*conns.AWSClient).CloudControlConn
+	conn := meta.(*conns.AWSClient).CloudControlConn()
 
 	input := &cloudcontrolapi.DeleteResourceInput{
 		ClientToken: aws.String(resource.UniqueId()),
@@ -238,7 +285,7 @@ func resourceResourceDelete(ctx context.Context, d *schema.ResourceData, meta in
 }
 
 func resourceResourceCustomizeDiffGetSchema(ctx context.Context, diff *schema.ResourceDiff, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).CloudFormationConn
+	conn := meta.(*conns.AWSClient).CloudFormationConn()
 
 	resourceSchema := diff.Get("schema").(string)
 
@@ -303,7 +350,20 @@ func resourceResourceCustomizeDiffSchemaDiff(ctx context.Context, diff *schema.R
 		return fmt.Errorf("error converting CloudFormation Resource Schema JSON: %w", err)
 	}
 
-	patches, err := jsonpatch.CreatePatch([]byte(oldDesiredStateRaw.(string)), []byte(newDesiredStateRaw.(string)))
+	// Only changes to properties that would be patched can force replacement.
+	oldDesiredState, err := normalizeDesiredState(cfResource, oldDesiredStateRaw.(string))
+
+	if err != nil {
+		return fmt.Errorf("error normalizing desired_state: %w", err)
+	}
+
+	newDesiredState, err = normalizeDesiredState(cfResource, newDesiredState)
+
+	if err != nil {
+		return fmt.Errorf("error normalizing desired_state: %w", err)
+	}
+
+	patches, err := jsonpatch.CreatePatch([]byte(oldDesiredState), []byte(newDesiredState))
 
 	if err != nil {
 		return fmt.Errorf("error creating desired_state JSON Patch: %w", err)
@@ -322,19 +382,19 @@ func resourceResourceCustomizeDiffSchemaDiff(ctx context.Context, diff *schema.R
 	return nil
 }
 
-// patchDocument returns a JSON Patch document describing the difference between `old` and `new`.
-func patchDocument(old, new string) (string, error) {
-	patch, err := jsonpatch.CreatePatch([]byte(old), []byte(new))
-
-	if err != nil {
-		return "", err
+// suppressEquivalentDesiredStateDiffs suppresses differences between desired states that are equivalent
+// once normalised using the CloudFormation resource type schema, if known.
+func suppressEquivalentDesiredStateDiffs(k, old, new string, d *schema.ResourceData) bool {
+	if old == "" || new == "" {
+		return false
 	}
 
-	b, err := json.Marshal(patch)
+	var cfResource *cfschema.Resource
 
-	if err != nil {
-		return "", err
+	if v, ok := d.Get("schema").(string); ok && v != "" {
+		// Differences are shown if the schema is invalid.
+		cfResource, _ = resourceTypeSchema(v)
 	}
 
-	return string(b), nil
+	return desiredStatesEqual(cfResource, old, new)
 }
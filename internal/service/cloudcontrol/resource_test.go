@@ -41,6 +41,7 @@ func TestAccCloudControlResource_basic(t *testing.T) {
 				Config: testAccResourceConfig_basic(rName),
 				Check: resource.ComposeAggregateTestCheckFunc(
 					resource.TestMatchResourceAttr(resourceName, "properties", regexp.MustCompile(`^\{.*\}$`)),
+					resource.TestCheckResourceAttr(resourceName, "properties_map.LogGroupName", rName),
 					resource.TestMatchResourceAttr(resourceName, "schema", regexp.MustCompile(`^\{.*`)),
 				),
 			},
@@ -469,7 +470,7 @@ func TestAccCloudControlResource_resourceSchema(t *testing.T) {
 }
 
 func testAccCheckResourceDestroy(s *terraform.State) error {
-	conn := acctest.Provider.Meta().(*conns.AWSClient).CloudControlConn
+	conn := acctest.Provider.Meta().(*conns.AWSClient).CloudControlConn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_cloudcontrolapi_resource" {
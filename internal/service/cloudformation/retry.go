@@ -0,0 +1,14 @@
+package cloudformation
+
+import (
+	"github.com/aws/aws-sdk-go/service/cloudformation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func init() {
+	conns.RegisterRetryRules(names.CloudFormation, conns.RetryRule{
+		ErrorCode:    cloudformation.ErrCodeOperationInProgressException,
+		ErrorMessage: "Another Operation on StackSet",
+	})
+}
@@ -69,7 +69,7 @@ const (
 )
 
 func resourceOriginAccessControlCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	conn := meta.(*conns.AWSClient).CloudFrontConn
+	conn := meta.(*conns.AWSClient).CloudFrontConn()
 
 	in := &cloudfront.CreateOriginAccessControlInput{
 		OriginAccessControlConfig: &cloudfront.OriginAccessControlConfig{
@@ -96,7 +96,7 @@ func resourceOriginAccessControlCreate(ctx context.Context, d *schema.ResourceDa
 }
 
 func resourceOriginAccessControlRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	conn := meta.(*conns.AWSClient).CloudFrontConn
+	conn := meta.(*conns.AWSClient).CloudFrontConn()
 
 	out, err := findOriginAccessControlByID(ctx, conn, d.Id())
 
@@ -127,7 +127,7 @@ func resourceOriginAccessControlRead(ctx context.Context, d *schema.ResourceData
 }
 
 func resourceOriginAccessControlUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	conn := meta.(*conns.AWSClient).CloudFrontConn
+	conn := meta.(*conns.AWSClient).CloudFrontConn()
 
 	in := &cloudfront.UpdateOriginAccessControlInput{
 		Id:      aws.String(d.Id()),
@@ -151,7 +151,7 @@ func resourceOriginAccessControlUpdate(ctx context.Context, d *schema.ResourceDa
 }
 
 func resourceOriginAccessControlDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	conn := meta.(*conns.AWSClient).CloudFrontConn
+	conn := meta.(*conns.AWSClient).CloudFrontConn()
 
 	log.Printf("[INFO] Deleting CloudFront Origin Access Control %s", d.Id())
 
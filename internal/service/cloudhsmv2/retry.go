@@ -0,0 +1,14 @@
+package cloudhsmv2
+
+import (
+	"github.com/aws/aws-sdk-go/service/cloudhsmv2"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func init() {
+	conns.RegisterRetryRules(names.CloudHSMV2, conns.RetryRule{
+		ErrorCode:    cloudhsmv2.ErrCodeCloudHsmInternalFailureException,
+		ErrorMessage: "request was rejected because of an AWS CloudHSM internal failure",
+	})
+}
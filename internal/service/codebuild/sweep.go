@@ -4,42 +4,42 @@
 package codebuild
 
 import (
+	"context"
 	"fmt"
 	"log"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/codebuild"
 	"github.com/hashicorp/go-multierror"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/sweep"
 )
 
 func init() {
-	resource.AddTestSweepers("aws_codebuild_report_group", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_codebuild_report_group", &sweep.Sweeper{
 		Name: "aws_codebuild_report_group",
 		F:    sweepReportGroups,
 	})
 
-	resource.AddTestSweepers("aws_codebuild_project", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_codebuild_project", &sweep.Sweeper{
 		Name: "aws_codebuild_project",
 		F:    sweepProjects,
 	})
 
-	resource.AddTestSweepers("aws_codebuild_source_credential", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_codebuild_source_credential", &sweep.Sweeper{
 		Name: "aws_codebuild_source_credential",
 		F:    sweepSourceCredentials,
 	})
 }
 
-func sweepReportGroups(region string) error {
+func sweepReportGroups(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(region)
 
 	if err != nil {
 		return fmt.Errorf("error getting client: %w", err)
 	}
 
-	conn := client.(*conns.AWSClient).CodeBuildConn
+	conn := client.(*conns.AWSClient).CodeBuildConn()
 	input := &codebuild.ListReportGroupsInput{}
 	var sweeperErrs *multierror.Error
 
@@ -79,14 +79,14 @@ func sweepReportGroups(region string) error {
 	return sweeperErrs.ErrorOrNil()
 }
 
-func sweepProjects(region string) error {
+func sweepProjects(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(region)
 
 	if err != nil {
 		return fmt.Errorf("error getting client: %w", err)
 	}
 
-	conn := client.(*conns.AWSClient).CodeBuildConn
+	conn := client.(*conns.AWSClient).CodeBuildConn()
 	input := &codebuild.ListProjectsInput{}
 	var sweeperErrs *multierror.Error
 
@@ -125,14 +125,14 @@ func sweepProjects(region string) error {
 	return sweeperErrs.ErrorOrNil()
 }
 
-func sweepSourceCredentials(region string) error {
+func sweepSourceCredentials(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(region)
 
 	if err != nil {
 		return fmt.Errorf("error getting client: %w", err)
 	}
 
-	conn := client.(*conns.AWSClient).CodeBuildConn
+	conn := client.(*conns.AWSClient).CodeBuildConn()
 	input := &codebuild.ListSourceCredentialsInput{}
 	var sweeperErrs *multierror.Error
 
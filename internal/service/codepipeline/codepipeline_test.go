@@ -610,7 +610,7 @@ func testAccCheckPipelineExists(n string, v *codepipeline.PipelineDeclaration) r
 			return fmt.Errorf("No CodePipeline ID is set")
 		}
 
-		conn := acctest.Provider.Meta().(*conns.AWSClient).CodePipelineConn
+		conn := acctest.Provider.Meta().(*conns.AWSClient).CodePipelineConn()
 
 		output, err := tfcodepipeline.FindPipelineByName(context.Background(), conn, rs.Primary.ID)
 
@@ -625,7 +625,7 @@ func testAccCheckPipelineExists(n string, v *codepipeline.PipelineDeclaration) r
 }
 
 func testAccCheckPipelineDestroy(s *terraform.State) error {
-	conn := acctest.Provider.Meta().(*conns.AWSClient).CodePipelineConn
+	conn := acctest.Provider.Meta().(*conns.AWSClient).CodePipelineConn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_codepipeline" {
@@ -659,7 +659,7 @@ func testAccPreCheckSupported(t *testing.T, regions ...string) {
 		if diags.HasError() {
 			t.Fatalf("error getting AWS client for region %s", region)
 		}
-		conn := client.CodePipelineConn
+		conn := client.CodePipelineConn()
 
 		input := &codepipeline.ListPipelinesInput{}
 		_, err := conn.ListPipelines(input)
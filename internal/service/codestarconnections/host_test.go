@@ -117,7 +117,7 @@ func testAccCheckHostExists(n string, v *codestarconnections.GetHostOutput) reso
 			return errors.New("No CodeStar Connections Host ID is set")
 		}
 
-		conn := acctest.Provider.Meta().(*conns.AWSClient).CodeStarConnectionsConn
+		conn := acctest.Provider.Meta().(*conns.AWSClient).CodeStarConnectionsConn()
 
 		output, err := tfcodestarconnections.FindHostByARN(conn, rs.Primary.ID)
 
@@ -132,7 +132,7 @@ func testAccCheckHostExists(n string, v *codestarconnections.GetHostOutput) reso
 }
 
 func testAccCheckHostDestroy(s *terraform.State) error {
-	conn := acctest.Provider.Meta().(*conns.AWSClient).CodeStarConnectionsConn
+	conn := acctest.Provider.Meta().(*conns.AWSClient).CodeStarConnectionsConn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_codestarconnections_host" {
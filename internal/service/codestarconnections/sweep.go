@@ -4,23 +4,23 @@
 package codestarconnections
 
 import (
+	"context"
 	"fmt"
 	"log"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/codestarconnections"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/sweep"
 )
 
 func init() {
-	resource.AddTestSweepers("aws_codestarconnections_connection", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_codestarconnections_connection", &sweep.Sweeper{
 		Name: "aws_codestarconnections_connection",
 		F:    sweepConnections,
 	})
 
-	resource.AddTestSweepers("aws_codestarconnections_host", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_codestarconnections_host", &sweep.Sweeper{
 		Name: "aws_codestarconnections_host",
 		F:    sweepHosts,
 		Dependencies: []string{
@@ -29,12 +29,12 @@ func init() {
 	})
 }
 
-func sweepConnections(region string) error {
+func sweepConnections(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %w", err)
 	}
-	conn := client.(*conns.AWSClient).CodeStarConnectionsConn
+	conn := client.(*conns.AWSClient).CodeStarConnectionsConn()
 	input := &codestarconnections.ListConnectionsInput{}
 	sweepResources := make([]sweep.Sweepable, 0)
 
@@ -63,7 +63,7 @@ func sweepConnections(region string) error {
 		return fmt.Errorf("error listing CodeStar Connections Connections (%s): %w", region, err)
 	}
 
-	err = sweep.SweepOrchestrator(sweepResources)
+	err = sweep.SweepOrchestrator(ctx, sweepResources)
 
 	if err != nil {
 		return fmt.Errorf("error sweeping CodeStar Connections Connections (%s): %w", region, err)
@@ -72,12 +72,12 @@ func sweepConnections(region string) error {
 	return nil
 }
 
-func sweepHosts(region string) error {
+func sweepHosts(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %w", err)
 	}
-	conn := client.(*conns.AWSClient).CodeStarConnectionsConn
+	conn := client.(*conns.AWSClient).CodeStarConnectionsConn()
 	input := &codestarconnections.ListHostsInput{}
 	sweepResources := make([]sweep.Sweepable, 0)
 
@@ -106,7 +106,7 @@ func sweepHosts(region string) error {
 		return fmt.Errorf("error listing CodeStar Connections Hosts (%s): %w", region, err)
 	}
 
-	err = sweep.SweepOrchestrator(sweepResources)
+	err = sweep.SweepOrchestrator(ctx, sweepResources)
 
 	if err != nil {
 		return fmt.Errorf("error sweeping CodeStar Connections Hosts (%s): %w", region, err)
@@ -894,7 +894,7 @@ func TestAccComprehendEntityRecognizer_DefaultTags_providerOnly(t *testing.T) {
 }
 
 func testAccCheckEntityRecognizerDestroy(s *terraform.State) error {
-	conn := acctest.Provider.Meta().(*conns.AWSClient).ComprehendConn
+	conn := acctest.Provider.Meta().(*conns.AWSClient).ComprehendConn()
 	ctx := context.Background()
 
 	for _, rs := range s.RootModule().Resources {
@@ -942,7 +942,7 @@ func testAccCheckEntityRecognizerExists(name string, entityrecognizer *types.Ent
 			return fmt.Errorf("No Comprehend Entity Recognizer is set")
 		}
 
-		conn := acctest.Provider.Meta().(*conns.AWSClient).ComprehendConn
+		conn := acctest.Provider.Meta().(*conns.AWSClient).ComprehendConn()
 		ctx := context.Background()
 
 		resp, err := tfcomprehend.FindEntityRecognizerByID(ctx, conn, rs.Primary.ID)
@@ -991,7 +991,7 @@ func testAccCheckEntityRecognizerPublishedVersions(name string, expected int) re
 			return fmt.Errorf("No Comprehend Entity Recognizer is set")
 		}
 
-		conn := acctest.Provider.Meta().(*conns.AWSClient).ComprehendConn
+		conn := acctest.Provider.Meta().(*conns.AWSClient).ComprehendConn()
 		ctx := context.Background()
 
 		name, err := tfcomprehend.EntityRecognizerParseARN(rs.Primary.ID)
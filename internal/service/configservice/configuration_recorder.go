@@ -69,7 +69,7 @@ func ResourceConfigurationRecorder() *schema.Resource {
 }
 
 func resourceConfigurationRecorderPut(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).ConfigServiceConn
+	conn := meta.(*conns.AWSClient).ConfigServiceConn()
 
 	name := d.Get("name").(string)
 	recorder := configservice.ConfigurationRecorder{
@@ -95,7 +95,7 @@ func resourceConfigurationRecorderPut(d *schema.ResourceData, meta interface{})
 }
 
 func resourceConfigurationRecorderRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).ConfigServiceConn
+	conn := meta.(*conns.AWSClient).ConfigServiceConn()
 
 	input := configservice.DescribeConfigurationRecordersInput{
 		ConfigurationRecorderNames: []*string{aws.String(d.Id())},
@@ -144,7 +144,7 @@ func resourceConfigurationRecorderRead(d *schema.ResourceData, meta interface{})
 }
 
 func resourceConfigurationRecorderDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).ConfigServiceConn
+	conn := meta.(*conns.AWSClient).ConfigServiceConn()
 	input := configservice.DeleteConfigurationRecorderInput{
 		ConfigurationRecorderName: aws.String(d.Id()),
 	}
@@ -0,0 +1,35 @@
+package configservice
+
+import (
+	"github.com/aws/aws-sdk-go/service/configservice"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func init() {
+	conns.RegisterRetryRules(names.ConfigService,
+		// When calling Config Organization Rules API actions immediately
+		// after Organization creation, the API can randomly return the
+		// OrganizationAccessDeniedException error for a few minutes, even
+		// after succeeding a few requests.
+		// We only want to retry briefly as the default max retry count would
+		// excessively retry when the error could be legitimate.
+		// We currently depend on the DefaultRetryer exponential backoff here.
+		// ~10 retries gives a fair backoff of a few seconds.
+		conns.RetryRule{
+			Operations:   []string{"DeleteOrganizationConfigRule", "DescribeOrganizationConfigRules", "DescribeOrganizationConfigRuleStatuses", "PutOrganizationConfigRule"},
+			ErrorCode:    configservice.ErrCodeOrganizationAccessDeniedException,
+			ErrorMessage: "This action can be only made by AWS Organization's master account.",
+			MaxAttempts:  10,
+		},
+		conns.RetryRule{
+			Operations:  []string{"DeleteOrganizationConformancePack", "DescribeOrganizationConformancePacks", "DescribeOrganizationConformancePackStatuses", "PutOrganizationConformancePack"},
+			ErrorCode:   configservice.ErrCodeOrganizationAccessDeniedException,
+			MaxAttempts: 10,
+		},
+		conns.RetryRule{
+			Operations: []string{"DeleteOrganizationConformancePack"},
+			ErrorCode:  configservice.ErrCodeResourceInUseException,
+		},
+	)
+}
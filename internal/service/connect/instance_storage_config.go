@@ -173,7 +173,7 @@ func ResourceInstanceStorageConfig() *schema.Resource {
 }
 
 func resourceInstanceStorageConfigCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	conn := meta.(*conns.AWSClient).ConnectConn
+	conn := meta.(*conns.AWSClient).ConnectConn()
 
 	instanceId := d.Get("instance_id").(string)
 	resourceType := d.Get("resource_type").(string)
@@ -201,7 +201,7 @@ func resourceInstanceStorageConfigCreate(ctx context.Context, d *schema.Resource
 }
 
 func resourceInstanceStorageConfigRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	conn := meta.(*conns.AWSClient).ConnectConn
+	conn := meta.(*conns.AWSClient).ConnectConn()
 
 	instanceId, associationId, resourceType, err := InstanceStorageConfigParseId(d.Id())
 
@@ -243,7 +243,7 @@ func resourceInstanceStorageConfigRead(ctx context.Context, d *schema.ResourceDa
 }
 
 func resourceInstanceStorageConfigUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	conn := meta.(*conns.AWSClient).ConnectConn
+	conn := meta.(*conns.AWSClient).ConnectConn()
 
 	instanceId, associationId, resourceType, err := InstanceStorageConfigParseId(d.Id())
 
@@ -271,7 +271,7 @@ func resourceInstanceStorageConfigUpdate(ctx context.Context, d *schema.Resource
 }
 
 func resourceInstanceStorageConfigDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	conn := meta.(*conns.AWSClient).ConnectConn
+	conn := meta.(*conns.AWSClient).ConnectConn()
 
 	instanceId, associationId, resourceType, err := InstanceStorageConfigParseId(d.Id())
 
@@ -4,6 +4,7 @@
 package cur
 
 import (
+	"context"
 	"fmt"
 	"log"
 
@@ -11,19 +12,18 @@ import (
 	"github.com/aws/aws-sdk-go/aws/endpoints"
 	cur "github.com/aws/aws-sdk-go/service/costandusagereportservice"
 	"github.com/hashicorp/go-multierror"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/sweep"
 )
 
 func init() {
-	resource.AddTestSweepers("aws_cur_report_definition", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_cur_report_definition", &sweep.Sweeper{
 		Name: "aws_cur_report_definition",
 		F:    sweepReportDefinitions,
 	})
 }
 
-func sweepReportDefinitions(region string) error {
+func sweepReportDefinitions(ctx context.Context, region string) error {
 	c, err := sweep.SharedRegionalSweepClient(region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
@@ -37,7 +37,7 @@ func sweepReportDefinitions(region string) error {
 		}
 	}
 
-	conn := client.CURConn
+	conn := client.CURConn()
 
 	input := &cur.DescribeReportDefinitionsInput{}
 	var sweeperErrs *multierror.Error
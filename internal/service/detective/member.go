@@ -83,7 +83,7 @@ func ResourceMember() *schema.Resource {
 }
 
 func resourceMemberCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	conn := meta.(*conns.AWSClient).DetectiveConn
+	conn := meta.(*conns.AWSClient).DetectiveConn()
 
 	accountId := d.Get("account_id").(string)
 	graphArn := d.Get("graph_arn").(string)
@@ -139,7 +139,7 @@ func resourceMemberCreate(ctx context.Context, d *schema.ResourceData, meta inte
 }
 
 func resourceMemberRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	conn := meta.(*conns.AWSClient).DetectiveConn
+	conn := meta.(*conns.AWSClient).DetectiveConn()
 
 	graphArn, accountId, err := DecodeMemberID(d.Id())
 	if err != nil {
@@ -177,7 +177,7 @@ func resourceMemberRead(ctx context.Context, d *schema.ResourceData, meta interf
 	return nil
 }
 func resourceMemberDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	conn := meta.(*conns.AWSClient).DetectiveConn
+	conn := meta.(*conns.AWSClient).DetectiveConn()
 
 	graphArn, accountId, err := DecodeMemberID(d.Id())
 	if err != nil {
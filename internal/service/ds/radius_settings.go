@@ -86,7 +86,7 @@ func ResourceRadiusSettings() *schema.Resource {
 }
 
 func resourceRadiusSettingsCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	conn := meta.(*conns.AWSClient).DSConn
+	conn := meta.(*conns.AWSClient).DSConn()
 
 	directoryID := d.Get("directory_id").(string)
 	input := &directoryservice.EnableRadiusInput{
@@ -120,7 +120,7 @@ func resourceRadiusSettingsCreate(ctx context.Context, d *schema.ResourceData, m
 }
 
 func resourceRadiusSettingsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	conn := meta.(*conns.AWSClient).DSConn
+	conn := meta.(*conns.AWSClient).DSConn()
 
 	output, err := FindRadiusSettings(ctx, conn, d.Id())
 
@@ -148,7 +148,7 @@ func resourceRadiusSettingsRead(ctx context.Context, d *schema.ResourceData, met
 }
 
 func resourceRadiusSettingsUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	conn := meta.(*conns.AWSClient).DSConn
+	conn := meta.(*conns.AWSClient).DSConn()
 
 	input := &directoryservice.UpdateRadiusInput{
 		DirectoryId: aws.String(d.Id()),
@@ -179,7 +179,7 @@ func resourceRadiusSettingsUpdate(ctx context.Context, d *schema.ResourceData, m
 }
 
 func resourceRadiusSettingsDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	conn := meta.(*conns.AWSClient).DSConn
+	conn := meta.(*conns.AWSClient).DSConn()
 
 	_, err := conn.DisableRadiusWithContext(ctx, &directoryservice.DisableRadiusInput{
 		DirectoryId: aws.String(d.Id()),
@@ -0,0 +1,16 @@
+package dynamodb
+
+import (
+	"github.com/aws/aws-sdk-go/service/dynamodb"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func init() {
+	// See https://github.com/aws/aws-sdk-go/pull/1276
+	conns.RegisterRetryRules(names.DynamoDB, conns.RetryRule{
+		Operations:   []string{"PutItem", "UpdateItem", "DeleteItem"},
+		ErrorCode:    dynamodb.ErrCodeLimitExceededException,
+		ErrorMessage: "Subscriber limit exceeded:",
+	})
+}
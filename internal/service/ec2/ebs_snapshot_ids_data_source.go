@@ -42,7 +42,7 @@ func DataSourceEBSSnapshotIDs() *schema.Resource {
 }
 
 func dataSourceEBSSnapshotIDsRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).EC2Conn
+	conn := meta.(*conns.AWSClient).EC2Conn()
 
 	input := &ec2.DescribeSnapshotsInput{}
 
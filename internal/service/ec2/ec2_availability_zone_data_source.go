@@ -77,7 +77,7 @@ func DataSourceAvailabilityZone() *schema.Resource {
 }
 
 func dataSourceAvailabilityZoneRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).EC2Conn
+	conn := meta.(*conns.AWSClient).EC2Conn()
 
 	input := &ec2.DescribeAvailabilityZonesInput{}
 
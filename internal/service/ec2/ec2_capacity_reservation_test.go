@@ -391,7 +391,7 @@ func testAccCheckCapacityReservationExists(n string, v *ec2.CapacityReservation)
 			return fmt.Errorf("No EC2 Capacity Reservation ID is set")
 		}
 
-		conn := acctest.Provider.Meta().(*conns.AWSClient).EC2Conn
+		conn := acctest.Provider.Meta().(*conns.AWSClient).EC2Conn()
 
 		output, err := tfec2.FindCapacityReservationByID(conn, rs.Primary.ID)
 
@@ -406,7 +406,7 @@ func testAccCheckCapacityReservationExists(n string, v *ec2.CapacityReservation)
 }
 
 func testAccCheckCapacityReservationDestroy(s *terraform.State) error {
-	conn := acctest.Provider.Meta().(*conns.AWSClient).EC2Conn
+	conn := acctest.Provider.Meta().(*conns.AWSClient).EC2Conn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_ec2_capacity_reservation" {
@@ -430,7 +430,7 @@ func testAccCheckCapacityReservationDestroy(s *terraform.State) error {
 }
 
 func testAccPreCheckCapacityReservation(t *testing.T) {
-	conn := acctest.Provider.Meta().(*conns.AWSClient).EC2Conn
+	conn := acctest.Provider.Meta().(*conns.AWSClient).EC2Conn()
 
 	input := &ec2.DescribeCapacityReservationsInput{
 		MaxResults: aws.Int64(1),
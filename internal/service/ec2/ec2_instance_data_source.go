@@ -387,7 +387,7 @@ func DataSourceInstance() *schema.Resource {
 
 // dataSourceInstanceRead performs the instanceID lookup
 func dataSourceInstanceRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).EC2Conn
+	conn := meta.(*conns.AWSClient).EC2Conn()
 	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
 
 	// Build up search parameters
@@ -548,7 +548,7 @@ func instanceDescriptionAttributes(d *schema.ResourceData, instance *ec2.Instanc
 	}
 
 	// Block devices
-	if err := readBlockDevices(d, instance, conn); err != nil {
+	if err := readBlockDevices(d, instance, conn, ignoreTagsConfig); err != nil {
 		return err
 	}
 	if _, ok := d.GetOk("ephemeral_block_device"); !ok {
@@ -46,7 +46,7 @@ func DataSourceInstanceTypeOfferings() *schema.Resource {
 }
 
 func dataSourceInstanceTypeOfferingsRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).EC2Conn
+	conn := meta.(*conns.AWSClient).EC2Conn()
 
 	input := &ec2.DescribeInstanceTypeOfferingsInput{}
 
@@ -30,7 +30,7 @@ func DataSourceInstanceTypes() *schema.Resource {
 }
 
 func dataSourceInstanceTypesRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).EC2Conn
+	conn := meta.(*conns.AWSClient).EC2Conn()
 
 	input := &ec2.DescribeInstanceTypesInput{}
 
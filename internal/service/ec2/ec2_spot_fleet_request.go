@@ -828,7 +828,7 @@ func ResourceSpotFleetRequest() *schema.Resource {
 
 func resourceSpotFleetRequestCreate(d *schema.ResourceData, meta interface{}) error {
 	// http://docs.aws.amazon.com/AWSEC2/latest/APIReference/API_RequestSpotFleet.html
-	conn := meta.(*conns.AWSClient).EC2Conn
+	conn := meta.(*conns.AWSClient).EC2Conn()
 	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
 	tags := defaultTagsConfig.MergeTags(tftags.New(d.Get("tags").(map[string]interface{})))
 
@@ -978,7 +978,7 @@ func resourceSpotFleetRequestCreate(d *schema.ResourceData, meta interface{}) er
 
 func resourceSpotFleetRequestRead(d *schema.ResourceData, meta interface{}) error {
 	// http://docs.aws.amazon.com/AWSEC2/latest/APIReference/API_DescribeSpotFleetRequests.html
-	conn := meta.(*conns.AWSClient).EC2Conn
+	conn := meta.(*conns.AWSClient).EC2Conn()
 	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
 	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
 
@@ -1046,7 +1046,7 @@ func resourceSpotFleetRequestRead(d *schema.ResourceData, meta interface{}) erro
 			aws.TimeValue(config.ValidUntil).Format(time.RFC3339))
 	}
 
-	launchSpec, err := launchSpecsToSet(conn, config.LaunchSpecifications)
+	launchSpec, err := launchSpecsToSet(conn, config.LaunchSpecifications, d.Get("launch_specification").(*schema.Set), ignoreTagsConfig)
 
 	if err != nil {
 		return fmt.Errorf("reading EC2 Spot Fleet Request (%s) launch specifications: %w", d.Id(), err)
@@ -1105,7 +1105,7 @@ func resourceSpotFleetRequestRead(d *schema.ResourceData, meta interface{}) erro
 
 func resourceSpotFleetRequestUpdate(d *schema.ResourceData, meta interface{}) error {
 	// http://docs.aws.amazon.com/AWSEC2/latest/APIReference/API_ModifySpotFleetRequest.html
-	conn := meta.(*conns.AWSClient).EC2Conn
+	conn := meta.(*conns.AWSClient).EC2Conn()
 
 	if d.HasChangesExcept("tags", "tags_all") {
 		input := &ec2.ModifySpotFleetRequestInput{
@@ -1148,7 +1148,7 @@ func resourceSpotFleetRequestUpdate(d *schema.ResourceData, meta interface{}) er
 }
 
 func resourceSpotFleetRequestDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).EC2Conn
+	conn := meta.(*conns.AWSClient).EC2Conn()
 
 	terminateInstances := d.Get("terminate_instances_with_expiration").(bool)
 	// If terminate_instances_on_delete is not null, its value is used.
@@ -1204,7 +1204,7 @@ func resourceSpotFleetRequestDelete(d *schema.ResourceData, meta interface{}) er
 }
 
 func buildSpotFleetLaunchSpecification(d map[string]interface{}, meta interface{}) (*ec2.SpotFleetLaunchSpecification, error) {
-	conn := meta.(*conns.AWSClient).EC2Conn
+	conn := meta.(*conns.AWSClient).EC2Conn()
 
 	opts := &ec2.SpotFleetLaunchSpecification{
 		ImageId:      aws.String(d["ami"].(string)),
@@ -1860,7 +1860,7 @@ func expandSpotCapacityRebalance(l []interface{}) *ec2.SpotCapacityRebalance {
 	return capacityRebalance
 }
 
-func launchSpecsToSet(conn *ec2.EC2, launchSpecs []*ec2.SpotFleetLaunchSpecification) (*schema.Set, error) {
+func launchSpecsToSet(conn *ec2.EC2, launchSpecs []*ec2.SpotFleetLaunchSpecification, configured *schema.Set, ignoreTagsConfig *tftags.IgnoreConfig) (*schema.Set, error) {
 	specSet := &schema.Set{F: hashLaunchSpecification}
 	for _, spec := range launchSpecs {
 		rootDeviceName, err := FetchRootDeviceName(conn, aws.StringValue(spec.ImageId))
@@ -1868,11 +1868,58 @@ func launchSpecsToSet(conn *ec2.EC2, launchSpecs []*ec2.SpotFleetLaunchSpecifica
 			return nil, err
 		}
 
-		specSet.Add(launchSpecToMap(spec, rootDeviceName))
+		specSet.Add(launchSpecIgnoreTags(launchSpecToMap(spec, rootDeviceName), configured, ignoreTagsConfig))
 	}
 	return specSet, nil
 }
 
+// launchSpecIgnoreTags removes ignored tag keys from a flattened launch specification's tags.
+// launch_specification is ForceNew, so the values of ignored tag keys configured in the matching
+// launch specification are kept, rather than causing the Spot fleet request to be replaced.
+func launchSpecIgnoreTags(m map[string]interface{}, configured *schema.Set, ignoreTagsConfig *tftags.IgnoreConfig) map[string]interface{} {
+	if ignoreTagsConfig == nil {
+		return m
+	}
+
+	tags, ok := m["tags"].(map[string]string)
+
+	if !ok {
+		return m
+	}
+
+	result := make(map[string]string, len(tags))
+
+	for k, v := range tags {
+		if !ignoreTagsConfig.Ignored(k) {
+			result[k] = v
+		}
+	}
+
+	if configured != nil {
+		hash := hashLaunchSpecification(m)
+
+		for _, tfMapRaw := range configured.List() {
+			tfMap, ok := tfMapRaw.(map[string]interface{})
+
+			if !ok || hashLaunchSpecification(tfMap) != hash {
+				continue
+			}
+
+			if v, ok := tfMap["tags"].(map[string]interface{}); ok {
+				for k, v := range v {
+					if ignoreTagsConfig.Ignored(k) {
+						result[k] = v.(string)
+					}
+				}
+			}
+		}
+	}
+
+	m["tags"] = result
+
+	return m
+}
+
 func launchSpecToMap(l *ec2.SpotFleetLaunchSpecification, rootDevName *string) map[string]interface{} {
 	m := make(map[string]interface{})
 
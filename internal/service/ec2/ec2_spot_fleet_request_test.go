@@ -1434,6 +1434,43 @@ func TestAccEC2SpotFleetRequest_withTags(t *testing.T) {
 	})
 }
 
+func TestAccEC2SpotFleetRequest_withTagsIgnoreTags(t *testing.T) {
+	var config ec2.SpotFleetRequestConfig
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	validUntil := time.Now().UTC().Add(24 * time.Hour).Format(time.RFC3339)
+	resourceName := "aws_spot_fleet_request.test"
+
+	publicKey, _, err := sdkacctest.RandSSHKeyPair(acctest.DefaultEmailAddress)
+	if err != nil {
+		t.Fatalf("error generating random SSH key: %s", err)
+	}
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t); testAccPreCheckSpotFleetRequest(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, ec2.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckSpotFleetRequestDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: acctest.ConfigCompose(acctest.ConfigIgnoreTagsKeys("Second"), testAccSpotFleetRequestConfig_tags(rName, publicKey, validUntil)),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckSpotFleetRequestExists(resourceName, &config),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "launch_specification.*", map[string]string{
+						"tags.%":      "3",
+						"tags.First":  "TfAccTest",
+						"tags.Second": "Terraform",
+						"tags.Name":   rName,
+					}),
+				),
+			},
+			{
+				Config:   acctest.ConfigCompose(acctest.ConfigIgnoreTagsKeyPrefixes1("Sec"), testAccSpotFleetRequestConfig_tags(rName, publicKey, validUntil)),
+				PlanOnly: true,
+			},
+		},
+	})
+}
+
 func TestAccEC2SpotFleetRequest_placementTenancyAndGroup(t *testing.T) {
 	var sfr ec2.SpotFleetRequestConfig
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
@@ -1716,7 +1753,7 @@ func testAccCheckSpotFleetRequestExists(n string, v *ec2.SpotFleetRequestConfig)
 			return errors.New("No EC2 Spot Fleet Request ID is set")
 		}
 
-		conn := acctest.Provider.Meta().(*conns.AWSClient).EC2Conn
+		conn := acctest.Provider.Meta().(*conns.AWSClient).EC2Conn()
 
 		output, err := tfec2.FindSpotFleetRequestByID(conn, rs.Primary.ID)
 
@@ -1731,7 +1768,7 @@ func testAccCheckSpotFleetRequestExists(n string, v *ec2.SpotFleetRequestConfig)
 }
 
 func testAccCheckSpotFleetRequestDestroy(s *terraform.State) error {
-	conn := acctest.Provider.Meta().(*conns.AWSClient).EC2Conn
+	conn := acctest.Provider.Meta().(*conns.AWSClient).EC2Conn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_spot_fleet_request" {
@@ -1804,7 +1841,7 @@ func testAccCheckSpotFleetRequest_PlacementAttributes(
 }
 
 func testAccPreCheckSpotFleetRequest(t *testing.T) {
-	conn := acctest.Provider.Meta().(*conns.AWSClient).EC2Conn
+	conn := acctest.Provider.Meta().(*conns.AWSClient).EC2Conn()
 
 	_, err := tfec2.FindSpotFleetRequests(conn, &ec2.DescribeSpotFleetRequestsInput{})
 
@@ -74,7 +74,7 @@ const (
 )
 
 func resourceIPAMPoolCIDRCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).EC2Conn
+	conn := meta.(*conns.AWSClient).EC2Conn()
 	pool_id := d.Get("ipam_pool_id").(string)
 
 	input := &ec2.ProvisionIpamPoolCidrInput{
@@ -106,7 +106,7 @@ func resourceIPAMPoolCIDRCreate(d *schema.ResourceData, meta interface{}) error
 }
 
 func resourceIPAMPoolCIDRRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).EC2Conn
+	conn := meta.(*conns.AWSClient).EC2Conn()
 	cidr, pool_id, err := FindIPAMPoolCIDR(conn, d.Id())
 
 	if err != nil {
@@ -133,7 +133,7 @@ func resourceIPAMPoolCIDRRead(d *schema.ResourceData, meta interface{}) error {
 }
 
 func resourceIPAMPoolCIDRDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).EC2Conn
+	conn := meta.(*conns.AWSClient).EC2Conn()
 	cidr, pool_id, err := DecodeIPAMPoolCIDRID(d.Id())
 
 	input := &ec2.DeprovisionIpamPoolCidrInput{
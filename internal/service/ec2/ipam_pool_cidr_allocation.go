@@ -91,7 +91,7 @@ const (
 )
 
 func resourceIPAMPoolCIDRAllocationCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).EC2Conn
+	conn := meta.(*conns.AWSClient).EC2Conn()
 	pool_id := d.Get("ipam_pool_id").(string)
 
 	input := &ec2.AllocateIpamPoolCidrInput{
@@ -126,7 +126,7 @@ func resourceIPAMPoolCIDRAllocationCreate(d *schema.ResourceData, meta interface
 }
 
 func resourceIPAMPoolCIDRAllocationRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).EC2Conn
+	conn := meta.(*conns.AWSClient).EC2Conn()
 
 	cidr_allocation, pool_id, err := FindIPAMPoolCIDRAllocation(conn, d.Id())
 
@@ -159,7 +159,7 @@ func resourceIPAMPoolCIDRAllocationRead(d *schema.ResourceData, meta interface{}
 }
 
 func resourceIPAMPoolCIDRAllocationDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).EC2Conn
+	conn := meta.(*conns.AWSClient).EC2Conn()
 
 	input := &ec2.ReleaseIpamPoolAllocationInput{
 		IpamPoolAllocationId: aws.String(d.Get("ipam_pool_allocation_id").(string)),
@@ -151,7 +151,7 @@ func testAccCheckIPAMAllocationExists(n string, allocation *ec2.IpamPoolAllocati
 		}
 
 		id := rs.Primary.ID
-		conn := acctest.Provider.Meta().(*conns.AWSClient).EC2Conn
+		conn := acctest.Provider.Meta().(*conns.AWSClient).EC2Conn()
 		cidr_allocation, _, err := tfec2.FindIPAMPoolCIDRAllocation(conn, id)
 
 		if err != nil {
@@ -174,7 +174,7 @@ func testAccCheckIPAMCIDRPrefix(allocation *ec2.IpamPoolAllocation, expected str
 }
 
 func testAccCheckIPAMPoolAllocationDestroy(s *terraform.State) error {
-	conn := acctest.Provider.Meta().(*conns.AWSClient).EC2Conn
+	conn := acctest.Provider.Meta().(*conns.AWSClient).EC2Conn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_vpc_ipam_pool_cidr_allocation" {
@@ -49,7 +49,7 @@ func testAccCheckIPAMCIDRExists(n string, cidr *ec2.IpamPoolCidr) resource.TestC
 		}
 
 		id := rs.Primary.ID
-		conn := acctest.Provider.Meta().(*conns.AWSClient).EC2Conn
+		conn := acctest.Provider.Meta().(*conns.AWSClient).EC2Conn()
 		found_cidr, _, err := tfec2.FindIPAMPoolCIDR(conn, id)
 
 		if err != nil {
@@ -62,7 +62,7 @@ func testAccCheckIPAMCIDRExists(n string, cidr *ec2.IpamPoolCidr) resource.TestC
 }
 
 func testAccCheckIPAMProvisionedPoolCIDRDestroy(s *terraform.State) error {
-	conn := acctest.Provider.Meta().(*conns.AWSClient).EC2Conn
+	conn := acctest.Provider.Meta().(*conns.AWSClient).EC2Conn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_vpc_ipam_pool_cidr" {
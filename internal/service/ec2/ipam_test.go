@@ -161,7 +161,7 @@ func TestAccIPAM_tags(t *testing.T) {
 }
 
 func testAccCheckIPAMDestroy(s *terraform.State) error {
-	conn := acctest.Provider.Meta().(*conns.AWSClient).EC2Conn
+	conn := acctest.Provider.Meta().(*conns.AWSClient).EC2Conn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_vpc_ipam" {
@@ -187,7 +187,7 @@ func testAccCheckIPAMScopeCreate(resourceName string) resource.TestCheckFunc {
 			if rs.Type != "aws_vpc_ipam" {
 				continue
 			}
-			conn := acctest.Provider.Meta().(*conns.AWSClient).EC2Conn
+			conn := acctest.Provider.Meta().(*conns.AWSClient).EC2Conn()
 			input := &ec2.CreateIpamScopeInput{
 				ClientToken: aws.String(resource.UniqueId()),
 				IpamId:      aws.String(rs.Primary.ID),
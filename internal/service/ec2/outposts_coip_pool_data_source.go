@@ -53,7 +53,7 @@ func DataSourceCoIPPool() *schema.Resource {
 }
 
 func dataSourceCoIPPoolRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).EC2Conn
+	conn := meta.(*conns.AWSClient).EC2Conn()
 	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
 
 	req := &ec2.DescribeCoipPoolsInput{}
@@ -42,7 +42,7 @@ func DataSourceLocalGatewayVirtualInterfaceGroup() *schema.Resource {
 }
 
 func dataSourceLocalGatewayVirtualInterfaceGroupRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).EC2Conn
+	conn := meta.(*conns.AWSClient).EC2Conn()
 	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
 
 	input := &ec2.DescribeLocalGatewayVirtualInterfaceGroupsInput{}
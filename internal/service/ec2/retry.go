@@ -0,0 +1,36 @@
+package ec2
+
+import (
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func init() {
+	conns.RegisterRetryRules(names.EC2,
+		conns.RetryRule{
+			Operations:   []string{"AttachVpnGateway", "DetachVpnGateway"},
+			ErrorCode:    errCodeInvalidParameterValue,
+			ErrorMessage: "This call cannot be completed because there are pending VPNs or Virtual Interfaces",
+		},
+		conns.RetryRule{
+			Operations:   []string{"CreateClientVpnEndpoint"},
+			ErrorCode:    "OperationNotPermitted",
+			ErrorMessage: "Endpoint cannot be created while another endpoint is being created",
+		},
+		conns.RetryRule{
+			Operations:   []string{"CreateClientVpnRoute", "DeleteClientVpnRoute"},
+			ErrorCode:    "ConcurrentMutationLimitExceeded",
+			ErrorMessage: "Cannot initiate another change for this endpoint at this time",
+		},
+		conns.RetryRule{
+			Operations:   []string{"CreateVpnConnection"},
+			ErrorCode:    "VpnConnectionLimitExceeded",
+			ErrorMessage: "maximum number of mutating objects has been reached",
+		},
+		conns.RetryRule{
+			Operations:   []string{"CreateVpnGateway"},
+			ErrorCode:    "VpnGatewayLimitExceeded",
+			ErrorMessage: "maximum number of mutating objects has been reached",
+		},
+	)
+}
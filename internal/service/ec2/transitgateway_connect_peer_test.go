@@ -199,7 +199,7 @@ func testAccCheckTransitGatewayConnectPeerExists(n string, v *ec2.TransitGateway
 			return fmt.Errorf("No EC2 Transit Gateway Connect Peer ID is set")
 		}
 
-		conn := acctest.Provider.Meta().(*conns.AWSClient).EC2Conn
+		conn := acctest.Provider.Meta().(*conns.AWSClient).EC2Conn()
 
 		output, err := tfec2.FindTransitGatewayConnectPeerByID(conn, rs.Primary.ID)
 
@@ -214,7 +214,7 @@ func testAccCheckTransitGatewayConnectPeerExists(n string, v *ec2.TransitGateway
 }
 
 func testAccCheckTransitGatewayConnectPeerDestroy(s *terraform.State) error {
-	conn := acctest.Provider.Meta().(*conns.AWSClient).EC2Conn
+	conn := acctest.Provider.Meta().(*conns.AWSClient).EC2Conn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_ec2_transit_gateway_connect_peer" {
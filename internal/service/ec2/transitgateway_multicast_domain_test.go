@@ -154,7 +154,7 @@ func testAccCheckTransitGatewayMulticastDomainExists(n string, v *ec2.TransitGat
 			return fmt.Errorf("No EC2 Transit Gateway Multicast Domain ID is set")
 		}
 
-		conn := acctest.Provider.Meta().(*conns.AWSClient).EC2Conn
+		conn := acctest.Provider.Meta().(*conns.AWSClient).EC2Conn()
 
 		output, err := tfec2.FindTransitGatewayMulticastDomainByID(conn, rs.Primary.ID)
 
@@ -169,7 +169,7 @@ func testAccCheckTransitGatewayMulticastDomainExists(n string, v *ec2.TransitGat
 }
 
 func testAccCheckTransitGatewayMulticastDomainDestroy(s *terraform.State) error {
-	conn := acctest.Provider.Meta().(*conns.AWSClient).EC2Conn
+	conn := acctest.Provider.Meta().(*conns.AWSClient).EC2Conn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_ec2_transit_gateway_multicast_domain" {
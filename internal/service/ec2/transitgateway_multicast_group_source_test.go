@@ -99,7 +99,7 @@ func testAccCheckTransitGatewayMulticastGroupSourceExists(n string, v *ec2.Trans
 			return err
 		}
 
-		conn := acctest.Provider.Meta().(*conns.AWSClient).EC2Conn
+		conn := acctest.Provider.Meta().(*conns.AWSClient).EC2Conn()
 
 		output, err := tfec2.FindTransitGatewayMulticastGroupSourceByThreePartKey(conn, multicastDomainID, groupIPAddress, eniID)
 
@@ -114,7 +114,7 @@ func testAccCheckTransitGatewayMulticastGroupSourceExists(n string, v *ec2.Trans
 }
 
 func testAccCheckTransitGatewayMulticastGroupSourceDestroy(s *terraform.State) error {
-	conn := acctest.Provider.Meta().(*conns.AWSClient).EC2Conn
+	conn := acctest.Provider.Meta().(*conns.AWSClient).EC2Conn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_ec2_transit_gateway_multicast_group_source" {
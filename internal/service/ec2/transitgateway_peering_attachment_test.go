@@ -186,7 +186,7 @@ func testAccCheckTransitGatewayPeeringAttachmentExists(n string, v *ec2.TransitG
 			return fmt.Errorf("No EC2 Transit Gateway Peering Attachment ID is set")
 		}
 
-		conn := acctest.Provider.Meta().(*conns.AWSClient).EC2Conn
+		conn := acctest.Provider.Meta().(*conns.AWSClient).EC2Conn()
 
 		output, err := tfec2.FindTransitGatewayPeeringAttachmentByID(conn, rs.Primary.ID)
 
@@ -201,7 +201,7 @@ func testAccCheckTransitGatewayPeeringAttachmentExists(n string, v *ec2.TransitG
 }
 
 func testAccCheckTransitGatewayPeeringAttachmentDestroy(s *terraform.State) error {
-	conn := acctest.Provider.Meta().(*conns.AWSClient).EC2Conn
+	conn := acctest.Provider.Meta().(*conns.AWSClient).EC2Conn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_ec2_transit_gateway_peering_attachment" {
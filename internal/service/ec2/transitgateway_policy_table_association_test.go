@@ -100,7 +100,7 @@ func testAccCheckTransitGatewayPolicyTableAssociationExists(n string, v *ec2.Tra
 			return err
 		}
 
-		conn := acctest.Provider.Meta().(*conns.AWSClient).EC2Conn
+		conn := acctest.Provider.Meta().(*conns.AWSClient).EC2Conn()
 
 		output, err := tfec2.FindTransitGatewayPolicyTableAssociationByTwoPartKey(conn, transitGatewayPolicyTableID, transitGatewayAttachmentID)
 
@@ -115,7 +115,7 @@ func testAccCheckTransitGatewayPolicyTableAssociationExists(n string, v *ec2.Tra
 }
 
 func testAccCheckTransitGatewayPolicyTableAssociationDestroy(s *terraform.State) error {
-	conn := acctest.Provider.Meta().(*conns.AWSClient).EC2Conn
+	conn := acctest.Provider.Meta().(*conns.AWSClient).EC2Conn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_ec2_transit_gateway_policy_table_association" {
@@ -155,7 +155,7 @@ func testAccCheckTransitGatewayPolicyTableExists(n string, v *ec2.TransitGateway
 			return fmt.Errorf("No EC2 Transit Gateway Policy Table ID is set")
 		}
 
-		conn := acctest.Provider.Meta().(*conns.AWSClient).EC2Conn
+		conn := acctest.Provider.Meta().(*conns.AWSClient).EC2Conn()
 
 		output, err := tfec2.FindTransitGatewayPolicyTableByID(conn, rs.Primary.ID)
 
@@ -170,7 +170,7 @@ func testAccCheckTransitGatewayPolicyTableExists(n string, v *ec2.TransitGateway
 }
 
 func testAccCheckTransitGatewayPolicyTableDestroy(s *terraform.State) error {
-	conn := acctest.Provider.Meta().(*conns.AWSClient).EC2Conn
+	conn := acctest.Provider.Meta().(*conns.AWSClient).EC2Conn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_ec2_transit_gateway_policy_table" {
@@ -55,7 +55,7 @@ func ResourceTransitGatewayRoute() *schema.Resource {
 }
 
 func resourceTransitGatewayRouteCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).EC2Conn
+	conn := meta.(*conns.AWSClient).EC2Conn()
 
 	destination := d.Get("destination_cidr_block").(string)
 	transitGatewayRouteTableID := d.Get("transit_gateway_route_table_id").(string)
@@ -84,7 +84,7 @@ func resourceTransitGatewayRouteCreate(d *schema.ResourceData, meta interface{})
 }
 
 func resourceTransitGatewayRouteRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).EC2Conn
+	conn := meta.(*conns.AWSClient).EC2Conn()
 
 	transitGatewayRouteTableID, destination, err := TransitGatewayRouteParseResourceID(d.Id())
 
@@ -122,7 +122,7 @@ func resourceTransitGatewayRouteRead(d *schema.ResourceData, meta interface{}) e
 }
 
 func resourceTransitGatewayRouteDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).EC2Conn
+	conn := meta.(*conns.AWSClient).EC2Conn()
 
 	transitGatewayRouteTableID, destination, err := TransitGatewayRouteParseResourceID(d.Id())
 
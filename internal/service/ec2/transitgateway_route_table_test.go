@@ -156,7 +156,7 @@ func testAccCheckTransitGatewayRouteTableExists(n string, v *ec2.TransitGatewayR
 			return fmt.Errorf("No EC2 Transit Gateway Route Table ID is set")
 		}
 
-		conn := acctest.Provider.Meta().(*conns.AWSClient).EC2Conn
+		conn := acctest.Provider.Meta().(*conns.AWSClient).EC2Conn()
 
 		output, err := tfec2.FindTransitGatewayRouteTableByID(conn, rs.Primary.ID)
 
@@ -171,7 +171,7 @@ func testAccCheckTransitGatewayRouteTableExists(n string, v *ec2.TransitGatewayR
 }
 
 func testAccCheckTransitGatewayRouteTableDestroy(s *terraform.State) error {
-	conn := acctest.Provider.Meta().(*conns.AWSClient).EC2Conn
+	conn := acctest.Provider.Meta().(*conns.AWSClient).EC2Conn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_ec2_transit_gateway_route_table" {
@@ -44,7 +44,7 @@ func TestAccVPCEndpointConnectionAccepter_crossAccount(t *testing.T) {
 }
 
 func testAccCheckVPCEndpointConnectionAccepterDestroy(s *terraform.State) error {
-	conn := acctest.Provider.Meta().(*conns.AWSClient).EC2Conn
+	conn := acctest.Provider.Meta().(*conns.AWSClient).EC2Conn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_vpc_endpoint_connection_accepter" {
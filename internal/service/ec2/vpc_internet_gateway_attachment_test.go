@@ -68,7 +68,7 @@ func TestAccVPCInternetGatewayAttachment_disappears(t *testing.T) {
 }
 
 func testAccCheckInternetGatewayAttachmentDestroy(s *terraform.State) error {
-	conn := acctest.Provider.Meta().(*conns.AWSClient).EC2Conn
+	conn := acctest.Provider.Meta().(*conns.AWSClient).EC2Conn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_internet_gateway_attachment" {
@@ -108,7 +108,7 @@ func testAccCheckInternetGatewayAttachmentExists(n string, v *ec2.InternetGatewa
 			return fmt.Errorf("No EC2 Internet Gateway Attachment ID is set")
 		}
 
-		conn := acctest.Provider.Meta().(*conns.AWSClient).EC2Conn
+		conn := acctest.Provider.Meta().(*conns.AWSClient).EC2Conn()
 
 		igwID, vpcID, err := tfec2.InternetGatewayAttachmentParseResourceID(rs.Primary.ID)
 
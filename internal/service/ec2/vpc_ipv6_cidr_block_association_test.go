@@ -15,7 +15,7 @@ import (
 )
 
 func testAccCheckVPCIPv6CIDRBlockAssociationDestroy(s *terraform.State) error {
-	conn := acctest.Provider.Meta().(*conns.AWSClient).EC2Conn
+	conn := acctest.Provider.Meta().(*conns.AWSClient).EC2Conn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_vpc_ipv6_cidr_block_association" {
@@ -49,7 +49,7 @@ func testAccCheckVPCIPv6CIDRBlockAssociationExists(n string, v *ec2.VpcIpv6CidrB
 			return fmt.Errorf("No EC2 VPC IPv6 CIDR Block Association is set")
 		}
 
-		conn := acctest.Provider.Meta().(*conns.AWSClient).EC2Conn
+		conn := acctest.Provider.Meta().(*conns.AWSClient).EC2Conn()
 
 		output, _, err := tfec2.FindVPCIPv6CIDRBlockAssociationByID(conn, rs.Primary.ID)
 
@@ -105,7 +105,7 @@ var vpcPeeringConnectionOptionsSchema = &schema.Schema{
 }
 
 func resourceVPCPeeringConnectionCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).EC2Conn
+	conn := meta.(*conns.AWSClient).EC2Conn()
 	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
 	tags := defaultTagsConfig.MergeTags(tftags.New(d.Get("tags").(map[string]interface{})))
 
@@ -162,7 +162,7 @@ func resourceVPCPeeringConnectionCreate(d *schema.ResourceData, meta interface{}
 }
 
 func resourceVPCPeeringConnectionRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).EC2Conn
+	conn := meta.(*conns.AWSClient).EC2Conn()
 	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
 	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
 
@@ -224,7 +224,7 @@ func resourceVPCPeeringConnectionRead(d *schema.ResourceData, meta interface{})
 }
 
 func resourceVPCPeeringConnectionUpdate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).EC2Conn
+	conn := meta.(*conns.AWSClient).EC2Conn()
 
 	vpcPeeringConnection, err := FindVPCPeeringConnectionByID(conn, d.Id())
 
@@ -258,7 +258,7 @@ func resourceVPCPeeringConnectionUpdate(d *schema.ResourceData, meta interface{}
 }
 
 func resourceVPCPeeringConnectionDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).EC2Conn
+	conn := meta.(*conns.AWSClient).EC2Conn()
 
 	log.Printf("[INFO] Deleting EC2 VPC Peering Connection: %s", d.Id())
 	_, err := conn.DeleteVpcPeeringConnection(&ec2.DeleteVpcPeeringConnectionInput{
@@ -112,7 +112,7 @@ func DataSourceVPCPeeringConnection() *schema.Resource {
 }
 
 func dataSourceVPCPeeringConnectionRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).EC2Conn
+	conn := meta.(*conns.AWSClient).EC2Conn()
 	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
 
 	input := &ec2.DescribeVpcPeeringConnectionsInput{}
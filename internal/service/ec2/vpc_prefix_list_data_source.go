@@ -39,7 +39,7 @@ func DataSourcePrefixList() *schema.Resource {
 }
 
 func dataSourcePrefixListRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).EC2Conn
+	conn := meta.(*conns.AWSClient).EC2Conn()
 
 	input := &ec2.DescribePrefixListsInput{}
 
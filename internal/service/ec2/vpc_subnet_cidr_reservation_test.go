@@ -108,7 +108,7 @@ func testAccCheckSubnetCIDRReservationExists(n string, v *ec2.SubnetCidrReservat
 			return fmt.Errorf("No EC2 Subnet CIDR Reservation ID is set")
 		}
 
-		conn := acctest.Provider.Meta().(*conns.AWSClient).EC2Conn
+		conn := acctest.Provider.Meta().(*conns.AWSClient).EC2Conn()
 
 		output, err := tfec2.FindSubnetCIDRReservationBySubnetIDAndReservationID(conn, rs.Primary.Attributes["subnet_id"], rs.Primary.ID)
 
@@ -123,7 +123,7 @@ func testAccCheckSubnetCIDRReservationExists(n string, v *ec2.SubnetCidrReservat
 }
 
 func testAccCheckSubnetCIDRReservationDestroy(s *terraform.State) error {
-	conn := acctest.Provider.Meta().(*conns.AWSClient).EC2Conn
+	conn := acctest.Provider.Meta().(*conns.AWSClient).EC2Conn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_ec2_subnet_cidr_reservation" {
@@ -114,7 +114,7 @@ func ResourceTrafficMirrorFilterRule() *schema.Resource {
 }
 
 func resourceTrafficMirrorFilterRuleCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).EC2Conn
+	conn := meta.(*conns.AWSClient).EC2Conn()
 
 	filterId := d.Get("traffic_mirror_filter_id")
 
@@ -153,7 +153,7 @@ func resourceTrafficMirrorFilterRuleCreate(d *schema.ResourceData, meta interfac
 }
 
 func resourceTrafficMirrorFilterRuleRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).EC2Conn
+	conn := meta.(*conns.AWSClient).EC2Conn()
 	ruleId := d.Id()
 
 	var rule *ec2.TrafficMirrorFilterRule
@@ -236,7 +236,7 @@ func findTrafficMirrorFilterRule(ruleId string, filters []*ec2.TrafficMirrorFilt
 }
 
 func resourceTrafficMirrorFilterRuleUpdate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).EC2Conn
+	conn := meta.(*conns.AWSClient).EC2Conn()
 	ruleId := d.Id()
 
 	input := &ec2.ModifyTrafficMirrorFilterRuleInput{
@@ -319,7 +319,7 @@ func resourceTrafficMirrorFilterRuleUpdate(d *schema.ResourceData, meta interfac
 }
 
 func resourceTrafficMirrorFilterRuleDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).EC2Conn
+	conn := meta.(*conns.AWSClient).EC2Conn()
 
 	ruleId := d.Id()
 	input := &ec2.DeleteTrafficMirrorFilterRuleInput{
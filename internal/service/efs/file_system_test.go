@@ -383,7 +383,7 @@ func TestAccEFSFileSystem_disappears(t *testing.T) {
 }
 
 func testAccCheckFileSystemDestroy(s *terraform.State) error {
-	conn := acctest.Provider.Meta().(*conns.AWSClient).EFSConn
+	conn := acctest.Provider.Meta().(*conns.AWSClient).EFSConn()
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_efs_file_system" {
 			continue
@@ -416,7 +416,7 @@ func testAccCheckFileSystem(resourceID string, fDesc *efs.FileSystemDescription)
 			return fmt.Errorf("No EFS file system ID is set")
 		}
 
-		conn := acctest.Provider.Meta().(*conns.AWSClient).EFSConn
+		conn := acctest.Provider.Meta().(*conns.AWSClient).EFSConn()
 
 		fs, err := tfefs.FindFileSystemByID(conn, rs.Primary.ID)
 
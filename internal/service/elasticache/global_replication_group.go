@@ -234,7 +234,7 @@ func paramGroupNameRequiresMajorVersionUpgrade(diff changesDiffer) error {
 }
 
 func resourceGlobalReplicationGroupCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).ElastiCacheConn
+	conn := meta.(*conns.AWSClient).ElastiCacheConn()
 
 	input := &elasticache.CreateGlobalReplicationGroupInput{
 		GlobalReplicationGroupIdSuffix: aws.String(d.Get("global_replication_group_id_suffix").(string)),
@@ -319,7 +319,7 @@ func resourceGlobalReplicationGroupCreate(d *schema.ResourceData, meta interface
 }
 
 func resourceGlobalReplicationGroupRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).ElastiCacheConn
+	conn := meta.(*conns.AWSClient).ElastiCacheConn()
 
 	globalReplicationGroup, err := FindGlobalReplicationGroupByID(conn, d.Id())
 	if !d.IsNewResource() && tfresource.NotFound(err) {
@@ -362,7 +362,7 @@ func resourceGlobalReplicationGroupRead(d *schema.ResourceData, meta interface{}
 type globalReplicationGroupUpdater func(input *elasticache.ModifyGlobalReplicationGroupInput)
 
 func resourceGlobalReplicationGroupUpdate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).ElastiCacheConn
+	conn := meta.(*conns.AWSClient).ElastiCacheConn()
 
 	// Only one field can be changed per request
 	if d.HasChange("cache_node_type") {
@@ -457,7 +457,7 @@ func updateGlobalReplicationGroup(conn *elasticache.ElastiCache, id string, f gl
 }
 
 func resourceGlobalReplicationGroupDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).ElastiCacheConn
+	conn := meta.(*conns.AWSClient).ElastiCacheConn()
 
 	// Using Update timeout because the Global Replication Group could be in the middle of an update operation
 	err := DeleteGlobalReplicationGroup(conn, d.Id(), GlobalReplicationGroupDefaultUpdatedTimeout)
@@ -4,29 +4,29 @@
 package emrcontainers
 
 import (
+	"context"
 	"fmt"
 	"log"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/emrcontainers"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/sweep"
 )
 
 func init() {
-	resource.AddTestSweepers("aws_emrcontainers_virtual_cluster", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_emrcontainers_virtual_cluster", &sweep.Sweeper{
 		Name: "aws_emrcontainers_virtual_cluster",
 		F:    sweepVirtualClusters,
 	})
 }
 
-func sweepVirtualClusters(region string) error {
+func sweepVirtualClusters(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
 	}
-	conn := client.(*conns.AWSClient).EMRContainersConn
+	conn := client.(*conns.AWSClient).EMRContainersConn()
 	input := &emrcontainers.ListVirtualClustersInput{}
 	sweepResources := make([]sweep.Sweepable, 0)
 
@@ -59,7 +59,7 @@ func sweepVirtualClusters(region string) error {
 		return fmt.Errorf("error listing EMR Containers Virtual Clusters (%s): %w", region, err)
 	}
 
-	err = sweep.SweepOrchestrator(sweepResources)
+	err = sweep.SweepOrchestrator(ctx, sweepResources)
 
 	if err != nil {
 		return fmt.Errorf("error sweeping EMR Containers Virtual Clusters (%s): %w", region, err)
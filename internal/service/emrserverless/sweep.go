@@ -4,29 +4,29 @@
 package emrserverless
 
 import (
+	"context"
 	"fmt"
 	"log"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/emrserverless"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/sweep"
 )
 
 func init() {
-	resource.AddTestSweepers("aws_emrserverless_application", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_emrserverless_application", &sweep.Sweeper{
 		Name: "aws_emrserverless_application",
 		F:    sweepApplications,
 	})
 }
 
-func sweepApplications(region string) error {
+func sweepApplications(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
 	}
-	conn := client.(*conns.AWSClient).EMRServerlessConn
+	conn := client.(*conns.AWSClient).EMRServerlessConn()
 	input := &emrserverless.ListApplicationsInput{}
 	sweepResources := make([]sweep.Sweepable, 0)
 
@@ -59,7 +59,7 @@ func sweepApplications(region string) error {
 		return fmt.Errorf("error listing EMR Serverless Applications (%s): %w", region, err)
 	}
 
-	err = sweep.SweepOrchestrator(sweepResources)
+	err = sweep.SweepOrchestrator(ctx, sweepResources)
 
 	if err != nil {
 		return fmt.Errorf("error sweeping EMR Serverless Applications (%s): %w", region, err)
@@ -231,7 +231,7 @@ func ResourceExperimentTemplate() *schema.Resource {
 }
 
 func resourceExperimentTemplateCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	conn := meta.(*conns.AWSClient).FISConn
+	conn := meta.(*conns.AWSClient).FISConn()
 	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
 	tags := defaultTagsConfig.MergeTags(tftags.New(d.Get("tags").(map[string]interface{})))
 
@@ -264,7 +264,7 @@ func resourceExperimentTemplateCreate(ctx context.Context, d *schema.ResourceDat
 }
 
 func resourceExperimentTemplateRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	conn := meta.(*conns.AWSClient).FISConn
+	conn := meta.(*conns.AWSClient).FISConn()
 	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
 	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
 
@@ -324,7 +324,7 @@ func resourceExperimentTemplateRead(ctx context.Context, d *schema.ResourceData,
 }
 
 func resourceExperimentTemplateUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	conn := meta.(*conns.AWSClient).FISConn
+	conn := meta.(*conns.AWSClient).FISConn()
 
 	input := &fis.UpdateExperimentTemplateInput{
 		Id: aws.String(d.Id()),
@@ -363,7 +363,7 @@ func resourceExperimentTemplateUpdate(ctx context.Context, d *schema.ResourceDat
 }
 
 func resourceExperimentTemplateDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	conn := meta.(*conns.AWSClient).FISConn
+	conn := meta.(*conns.AWSClient).FISConn()
 	_, err := conn.DeleteExperimentTemplate(ctx, &fis.DeleteExperimentTemplateInput{
 		Id: aws.String(d.Id()),
 	})
@@ -0,0 +1,33 @@
+package fms
+
+import (
+	"github.com/aws/aws-sdk-go/service/fms"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func init() {
+	// Acceptance testing creates and deletes resources in quick succession.
+	// The FMS onboarding process into Organizations is opaque to consumers.
+	// Since we cannot reasonably check this status before receiving the error,
+	// set the operation as retryable.
+	conns.RegisterRetryRules(names.FMS,
+		conns.RetryRule{
+			Operations:   []string{"AssociateAdminAccount"},
+			ErrorCode:    fms.ErrCodeInvalidOperationException,
+			ErrorMessage: "Your AWS Organization is currently offboarding with AWS Firewall Manager. Please submit onboard request after offboarded.",
+		},
+		conns.RetryRule{
+			Operations:   []string{"DisassociateAdminAccount"},
+			ErrorCode:    fms.ErrCodeInvalidOperationException,
+			ErrorMessage: "Your AWS Organization is currently onboarding with AWS Firewall Manager and cannot be offboarded.",
+		},
+		// System problems can arise during FMS policy updates (maybe also creation),
+		// so we set the following operation as retryable.
+		// Reference: https://github.com/hashicorp/terraform-provider-aws/issues/23946
+		conns.RetryRule{
+			Operations: []string{"PutPolicy"},
+			ErrorCode:  fms.ErrCodeInternalErrorException,
+		},
+	)
+}
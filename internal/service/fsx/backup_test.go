@@ -259,7 +259,7 @@ func testAccCheckBackupExists(resourceName string, fs *fsx.Backup) resource.Test
 			return fmt.Errorf("Not found: %s", resourceName)
 		}
 
-		conn := acctest.Provider.Meta().(*conns.AWSClient).FSxConn
+		conn := acctest.Provider.Meta().(*conns.AWSClient).FSxConn()
 
 		output, err := tffsx.FindBackupByID(conn, rs.Primary.ID)
 		if err != nil {
@@ -277,7 +277,7 @@ func testAccCheckBackupExists(resourceName string, fs *fsx.Backup) resource.Test
 }
 
 func testAccCheckBackupDestroy(s *terraform.State) error {
-	conn := acctest.Provider.Meta().(*conns.AWSClient).FSxConn
+	conn := acctest.Provider.Meta().(*conns.AWSClient).FSxConn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_fsx_backup" {
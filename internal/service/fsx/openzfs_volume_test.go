@@ -470,7 +470,7 @@ func testAccCheckOpenzfsVolumeExists(resourceName string, volume *fsx.Volume) re
 			return fmt.Errorf("Not found: %s", resourceName)
 		}
 
-		conn := acctest.Provider.Meta().(*conns.AWSClient).FSxConn
+		conn := acctest.Provider.Meta().(*conns.AWSClient).FSxConn()
 
 		volume1, err := tffsx.FindVolumeByID(conn, rs.Primary.ID)
 		if err != nil {
@@ -488,7 +488,7 @@ func testAccCheckOpenzfsVolumeExists(resourceName string, volume *fsx.Volume) re
 }
 
 func testAccCheckOpenzfsVolumeDestroy(s *terraform.State) error {
-	conn := acctest.Provider.Meta().(*conns.AWSClient).FSxConn
+	conn := acctest.Provider.Meta().(*conns.AWSClient).FSxConn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_fsx_openzfs_volume" {
@@ -117,7 +117,7 @@ func (d *dataSourceAccelerator) Read(ctx context.Context, request datasource.Rea
 		return
 	}
 
-	conn := d.meta.GlobalAcceleratorConn
+	conn := d.meta.GlobalAcceleratorConn()
 	ignoreTagsConfig := d.meta.IgnoreTagsConfig
 
 	var results []*globalaccelerator.Accelerator
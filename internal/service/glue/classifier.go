@@ -161,7 +161,7 @@ func ResourceClassifier() *schema.Resource {
 }
 
 func resourceClassifierCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).GlueConn
+	conn := meta.(*conns.AWSClient).GlueConn()
 	name := d.Get("name").(string)
 
 	input := &glue.CreateClassifierInput{}
@@ -198,7 +198,7 @@ func resourceClassifierCreate(d *schema.ResourceData, meta interface{}) error {
 }
 
 func resourceClassifierRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).GlueConn
+	conn := meta.(*conns.AWSClient).GlueConn()
 
 	input := &glue.GetClassifierInput{
 		Name: aws.String(d.Id()),
@@ -244,7 +244,7 @@ func resourceClassifierRead(d *schema.ResourceData, meta interface{}) error {
 }
 
 func resourceClassifierUpdate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).GlueConn
+	conn := meta.(*conns.AWSClient).GlueConn()
 
 	input := &glue.UpdateClassifierInput{}
 
@@ -278,7 +278,7 @@ func resourceClassifierUpdate(d *schema.ResourceData, meta interface{}) error {
 }
 
 func resourceClassifierDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).GlueConn
+	conn := meta.(*conns.AWSClient).GlueConn()
 
 	log.Printf("[DEBUG] Deleting Glue Classifier: %s", d.Id())
 	err := DeleteClassifier(conn, d.Id())
@@ -89,7 +89,7 @@ func ResourceUserDefinedFunction() *schema.Resource {
 }
 
 func resourceUserDefinedFunctionCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).GlueConn
+	conn := meta.(*conns.AWSClient).GlueConn()
 	catalogID := createCatalogID(d, meta.(*conns.AWSClient).AccountID)
 	dbName := d.Get("database_name").(string)
 	funcName := d.Get("name").(string)
@@ -111,7 +111,7 @@ func resourceUserDefinedFunctionCreate(d *schema.ResourceData, meta interface{})
 }
 
 func resourceUserDefinedFunctionUpdate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).GlueConn
+	conn := meta.(*conns.AWSClient).GlueConn()
 
 	catalogID, dbName, funcName, err := ReadUDFID(d.Id())
 	if err != nil {
@@ -133,7 +133,7 @@ func resourceUserDefinedFunctionUpdate(d *schema.ResourceData, meta interface{})
 }
 
 func resourceUserDefinedFunctionRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).GlueConn
+	conn := meta.(*conns.AWSClient).GlueConn()
 
 	catalogID, dbName, funcName, err := ReadUDFID(d.Id())
 	if err != nil {
@@ -185,7 +185,7 @@ func resourceUserDefinedFunctionRead(d *schema.ResourceData, meta interface{}) e
 }
 
 func resourceUserDefinedFunctionDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).GlueConn
+	conn := meta.(*conns.AWSClient).GlueConn()
 	catalogID, dbName, funcName, err := ReadUDFID(d.Id())
 	if err != nil {
 		return err
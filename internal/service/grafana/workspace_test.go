@@ -508,7 +508,7 @@ func testAccCheckWorkspaceExists(name string) resource.TestCheckFunc {
 			return fmt.Errorf("No Grafana Workspace ID is set")
 		}
 
-		conn := acctest.Provider.Meta().(*conns.AWSClient).GrafanaConn
+		conn := acctest.Provider.Meta().(*conns.AWSClient).GrafanaConn()
 
 		_, err := tfgrafana.FindWorkspaceByID(conn, rs.Primary.ID)
 
@@ -517,7 +517,7 @@ func testAccCheckWorkspaceExists(name string) resource.TestCheckFunc {
 }
 
 func testAccCheckWorkspaceDestroy(s *terraform.State) error {
-	conn := acctest.Provider.Meta().(*conns.AWSClient).GrafanaConn
+	conn := acctest.Provider.Meta().(*conns.AWSClient).GrafanaConn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_grafana_workspace" {
@@ -0,0 +1,118 @@
+package iam
+
+import (
+	"context"
+	"log"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/iam"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func ResourceGroupPoliciesExclusive() *schema.Resource {
+	return &schema.Resource{
+		// Create and Update both delete inline policies that aren't configured.
+		CreateWithoutTimeout: resourceGroupPoliciesExclusivePut,
+		ReadWithoutTimeout:   resourceGroupPoliciesExclusiveRead,
+		UpdateWithoutTimeout: resourceGroupPoliciesExclusivePut,
+		DeleteWithoutTimeout: resourceGroupPoliciesExclusiveDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceGroupPoliciesExclusiveImport,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"policy_names": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"group_name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+		},
+	}
+}
+
+func resourceGroupPoliciesExclusivePut(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).IAMConn()
+
+	groupName := d.Get("group_name").(string)
+
+	policyNames, err := FindGroupPolicyNames(ctx, conn, groupName)
+
+	if err != nil {
+		return diag.Errorf("reading IAM Group (%s) inline policies: %s", groupName, err)
+	}
+
+	// Configured policies are created by aws_iam_group_policy resources; only the others are removed here.
+	missing, del := exclusiveSetDiff(d.Get("policy_names").(*schema.Set), policyNames)
+
+	if len(missing) > 0 {
+		return diag.Errorf("IAM Group (%s) inline policies not found: %s", groupName, strings.Join(missing, ", "))
+	}
+
+	for _, policyName := range del {
+		log.Printf("[DEBUG] Deleting IAM Group (%s) inline policy: %s", groupName, policyName)
+		_, err := conn.DeleteGroupPolicyWithContext(ctx, &iam.DeleteGroupPolicyInput{
+			PolicyName: aws.String(policyName),
+			GroupName:  aws.String(groupName),
+		})
+
+		if tfawserr.ErrCodeEquals(err, iam.ErrCodeNoSuchEntityException) {
+			continue
+		}
+
+		if err != nil {
+			return diag.Errorf("deleting IAM Group (%s) inline policy (%s): %s", groupName, policyName, err)
+		}
+	}
+
+	d.SetId(groupName)
+
+	return resourceGroupPoliciesExclusiveRead(ctx, d, meta)
+}
+
+func resourceGroupPoliciesExclusiveRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).IAMConn()
+
+	policyNames, err := FindGroupPolicyNames(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] IAM Group (%s) not found, removing inline policies from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("reading IAM Group (%s) inline policies: %s", d.Id(), err)
+	}
+
+	d.Set("policy_names", flex.FlattenStringValueSet(policyNames))
+	d.Set("group_name", d.Id())
+
+	return nil
+}
+
+func resourceGroupPoliciesExclusiveDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	// The inline policies remain. Destroying the resource only ends exclusive management.
+	log.Printf("[DEBUG] Removing IAM Group (%s) exclusive inline policies from state", d.Id())
+
+	return nil
+}
+
+func resourceGroupPoliciesExclusiveImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	d.Set("group_name", d.Id())
+
+	return []*schema.ResourceData{d}, nil
+}
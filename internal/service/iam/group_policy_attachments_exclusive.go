@@ -0,0 +1,117 @@
+package iam
+
+import (
+	"context"
+	"log"
+
+	"github.com/aws/aws-sdk-go/service/iam"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+func ResourceGroupPolicyAttachmentsExclusive() *schema.Resource {
+	return &schema.Resource{
+		// Create and Update both reconcile the group's attachments with the configuration.
+		CreateWithoutTimeout: resourceGroupPolicyAttachmentsExclusivePut,
+		ReadWithoutTimeout:   resourceGroupPolicyAttachmentsExclusiveRead,
+		UpdateWithoutTimeout: resourceGroupPolicyAttachmentsExclusivePut,
+		DeleteWithoutTimeout: resourceGroupPolicyAttachmentsExclusiveDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceGroupPolicyAttachmentsExclusiveImport,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"policy_arns": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: verify.ValidARN,
+				},
+			},
+			"group_name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+		},
+	}
+}
+
+func resourceGroupPolicyAttachmentsExclusivePut(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).IAMConn()
+
+	groupName := d.Get("group_name").(string)
+
+	attached, err := FindGroupAttachedPolicyARNs(ctx, conn, groupName)
+
+	if err != nil {
+		return diag.Errorf("reading IAM Group (%s) policy attachments: %s", groupName, err)
+	}
+
+	add, del := exclusiveSetDiff(d.Get("policy_arns").(*schema.Set), attached)
+
+	for _, policyARN := range add {
+		log.Printf("[DEBUG] Attaching IAM Group (%s) policy: %s", groupName, policyARN)
+		if err := attachPolicyToGroup(conn, groupName, policyARN); err != nil {
+			return diag.Errorf("attaching IAM Group (%s) policy (%s): %s", groupName, policyARN, err)
+		}
+	}
+
+	for _, policyARN := range del {
+		log.Printf("[DEBUG] Detaching IAM Group (%s) policy: %s", groupName, policyARN)
+		err := detachPolicyFromGroup(conn, groupName, policyARN)
+
+		if tfawserr.ErrCodeEquals(err, iam.ErrCodeNoSuchEntityException) {
+			continue
+		}
+
+		if err != nil {
+			return diag.Errorf("detaching IAM Group (%s) policy (%s): %s", groupName, policyARN, err)
+		}
+	}
+
+	d.SetId(groupName)
+
+	return resourceGroupPolicyAttachmentsExclusiveRead(ctx, d, meta)
+}
+
+func resourceGroupPolicyAttachmentsExclusiveRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).IAMConn()
+
+	attached, err := FindGroupAttachedPolicyARNs(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] IAM Group (%s) not found, removing policy attachments from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("reading IAM Group (%s) policy attachments: %s", d.Id(), err)
+	}
+
+	d.Set("policy_arns", flex.FlattenStringValueSet(attached))
+	d.Set("group_name", d.Id())
+
+	return nil
+}
+
+func resourceGroupPolicyAttachmentsExclusiveDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	// The policies remain attached. Destroying the resource only ends exclusive management.
+	log.Printf("[DEBUG] Removing IAM Group (%s) exclusive policy attachments from state", d.Id())
+
+	return nil
+}
+
+func resourceGroupPolicyAttachmentsExclusiveImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	d.Set("group_name", d.Id())
+
+	return []*schema.ResourceData{d}, nil
+}
@@ -40,7 +40,7 @@ func DataSourceInstanceProfiles() *schema.Resource {
 }
 
 func dataSourceInstanceProfilesRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	conn := meta.(*conns.AWSClient).IAMConn
+	conn := meta.(*conns.AWSClient).IAMConn()
 
 	roleName := d.Get("role_name").(string)
 	input := &iam.ListInstanceProfilesForRoleInput{
@@ -11,10 +11,26 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	"github.com/hashicorp/terraform-provider-aws/internal/policy"
 )
 
 var dataSourcePolicyDocumentVarReplacer = strings.NewReplacer("&{", "${")
 
+const (
+	policyDocumentMergeModeOverride = "override"
+	policyDocumentMergeModeUnion    = "union"
+)
+
+func policyDocumentMergeMode_Values() []string {
+	return []string{
+		policyDocumentMergeModeOverride,
+		policyDocumentMergeModeUnion,
+	}
+}
+
+// policyDocumentTemplateValuePlaceholder is replaced by each of a statement's template_values.
+const policyDocumentTemplateValuePlaceholder = "&{each.value}"
+
 func DataSourcePolicyDocument() *schema.Resource {
 	setOfString := &schema.Schema{
 		Type:     schema.TypeSet,
@@ -28,10 +44,20 @@ func DataSourcePolicyDocument() *schema.Resource {
 		Read: dataSourcePolicyDocumentRead,
 
 		Schema: map[string]*schema.Schema{
+			"deduplicate_statements": {
+				Type:     schema.TypeBool,
+				Optional: true,
+			},
 			"json": {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+			"merge_mode": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      policyDocumentMergeModeOverride,
+				ValidateFunc: validation.StringInSlice(policyDocumentMergeMode_Values(), false),
+			},
 			"override_json": {
 				Type:         schema.TypeString,
 				Optional:     true,
@@ -64,6 +90,18 @@ func DataSourcePolicyDocument() *schema.Resource {
 					ValidateFunc: validation.StringIsJSON,
 				},
 			},
+			"split_json": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"split_max_size": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ValidateFunc: validation.IntAtLeast(1),
+			},
 			"statement": {
 				Type:     schema.TypeList,
 				Optional: true,
@@ -108,6 +146,13 @@ func DataSourcePolicyDocument() *schema.Resource {
 							Type:     schema.TypeString,
 							Optional: true,
 						},
+						"template_values": {
+							Type:     schema.TypeList,
+							Optional: true,
+							Elem: &schema.Schema{
+								Type: schema.TypeString,
+							},
+						},
 					},
 				},
 			},
@@ -125,7 +170,18 @@ func DataSourcePolicyDocument() *schema.Resource {
 }
 
 func dataSourcePolicyDocumentRead(d *schema.ResourceData, meta interface{}) error {
-	mergedDoc := &IAMPolicyDoc{}
+	mergedDoc := &policy.IAMPolicyDoc{}
+	union := d.Get("merge_mode").(string) == policyDocumentMergeModeUnion
+
+	merge := func(newDoc *policy.IAMPolicyDoc) error {
+		if union {
+			return mergedDoc.MergeUnion(newDoc)
+		}
+
+		mergedDoc.Merge(newDoc)
+
+		return nil
+	}
 
 	if v, ok := d.GetOk("source_json"); ok {
 		if err := json.Unmarshal([]byte(v.(string)), mergedDoc); err != nil {
@@ -148,14 +204,14 @@ func dataSourcePolicyDocumentRead(d *schema.ResourceData, meta interface{}) erro
 				continue
 			}
 
-			sourceDoc := &IAMPolicyDoc{}
+			sourceDoc := &policy.IAMPolicyDoc{}
 			if err := json.Unmarshal([]byte(sourceJSON.(string)), sourceDoc); err != nil {
 				return err
 			}
 
 			// assure all statements in sourceDoc are unique before merging
 			for stmtIndex, stmt := range sourceDoc.Statements {
-				if stmt.Sid != "" {
+				if stmt.Sid != "" && !union {
 					if _, sidExists := sidMap[stmt.Sid]; sidExists {
 						return fmt.Errorf("duplicate Sid (%s) in source_policy_documents (item %d; statement %d). Remove the Sid or ensure Sids are unique.", stmt.Sid, sourceJSONIndex, stmtIndex)
 					}
@@ -163,12 +219,14 @@ func dataSourcePolicyDocumentRead(d *schema.ResourceData, meta interface{}) erro
 				}
 			}
 
-			mergedDoc.Merge(sourceDoc)
+			if err := merge(sourceDoc); err != nil {
+				return fmt.Errorf("merging source_policy_documents (item %d): %w", sourceJSONIndex, err)
+			}
 		}
 	}
 
 	// process the current document
-	doc := &IAMPolicyDoc{
+	doc := &policy.IAMPolicyDoc{
 		Version: d.Get("version").(string),
 	}
 
@@ -177,108 +235,95 @@ func dataSourcePolicyDocumentRead(d *schema.ResourceData, meta interface{}) erro
 	}
 
 	if cfgStmts, hasCfgStmts := d.GetOk("statement"); hasCfgStmts {
-		var cfgStmtIntf = cfgStmts.([]interface{})
-		stmts := make([]*IAMPolicyStatement, len(cfgStmtIntf))
+		var stmts []*policy.IAMPolicyStatement
 		sidMap := make(map[string]struct{})
 
-		for i, stmtI := range cfgStmtIntf {
+		for _, stmtI := range cfgStmts.([]interface{}) {
 			cfgStmt := stmtI.(map[string]interface{})
-			stmt := &IAMPolicyStatement{
-				Effect: cfgStmt["effect"].(string),
-			}
 
-			if sid, ok := cfgStmt["sid"]; ok {
-				if _, ok := sidMap[sid.(string)]; ok {
-					return fmt.Errorf("duplicate Sid (%s). Remove the Sid or ensure the Sid is unique.", sid.(string))
-				}
-				stmt.Sid = sid.(string)
-				if len(stmt.Sid) > 0 {
-					sidMap[stmt.Sid] = struct{}{}
-				}
-			}
-
-			if actions := cfgStmt["actions"].(*schema.Set).List(); len(actions) > 0 {
-				stmt.Actions = policyDecodeConfigStringList(actions)
-			}
-			if actions := cfgStmt["not_actions"].(*schema.Set).List(); len(actions) > 0 {
-				stmt.NotActions = policyDecodeConfigStringList(actions)
-			}
+			var expanded []*policy.IAMPolicyStatement
 
-			if resources := cfgStmt["resources"].(*schema.Set).List(); len(resources) > 0 {
-				var err error
-				stmt.Resources, err = dataSourcePolicyDocumentReplaceVarsInList(
-					policyDecodeConfigStringList(resources), doc.Version,
-				)
-				if err != nil {
-					return fmt.Errorf("error reading resources: %w", err)
+			if values := cfgStmt["template_values"].([]interface{}); len(values) > 0 {
+				for i, value := range values {
+					stmt, err := dataSourcePolicyDocumentMakeStatement(dataSourcePolicyDocumentExpandTemplate(cfgStmt, value.(string)).(map[string]interface{}), doc.Version)
+					if err != nil {
+						return err
+					}
+					if len(stmt.Sid) > 0 {
+						stmt.Sid += strconv.Itoa(i)
+					}
+					expanded = append(expanded, stmt)
 				}
-			}
-			if notResources := cfgStmt["not_resources"].(*schema.Set).List(); len(notResources) > 0 {
-				var err error
-				stmt.NotResources, err = dataSourcePolicyDocumentReplaceVarsInList(
-					policyDecodeConfigStringList(notResources), doc.Version,
-				)
+			} else {
+				stmt, err := dataSourcePolicyDocumentMakeStatement(cfgStmt, doc.Version)
 				if err != nil {
-					return fmt.Errorf("error reading not_resources: %w", err)
+					return err
 				}
+				expanded = append(expanded, stmt)
 			}
 
-			if principals := cfgStmt["principals"].(*schema.Set).List(); len(principals) > 0 {
-				var err error
-				stmt.Principals, err = dataSourcePolicyDocumentMakePrincipals(principals, doc.Version)
-				if err != nil {
-					return fmt.Errorf("error reading principals: %w", err)
+			for _, stmt := range expanded {
+				if len(stmt.Sid) > 0 {
+					if _, ok := sidMap[stmt.Sid]; ok && !union {
+						return fmt.Errorf("duplicate Sid (%s). Remove the Sid or ensure the Sid is unique.", stmt.Sid)
+					}
+					sidMap[stmt.Sid] = struct{}{}
 				}
 			}
 
-			if notPrincipals := cfgStmt["not_principals"].(*schema.Set).List(); len(notPrincipals) > 0 {
-				var err error
-				stmt.NotPrincipals, err = dataSourcePolicyDocumentMakePrincipals(notPrincipals, doc.Version)
-				if err != nil {
-					return fmt.Errorf("error reading not_principals: %w", err)
-				}
-			}
+			stmts = append(stmts, expanded...)
+		}
 
-			if conditions := cfgStmt["condition"].(*schema.Set).List(); len(conditions) > 0 {
-				var err error
-				stmt.Conditions, err = dataSourcePolicyDocumentMakeConditions(conditions, doc.Version)
-				if err != nil {
-					return fmt.Errorf("error reading condition: %w", err)
+		if union {
+			// combine statements with the same Sid
+			for _, stmt := range stmts {
+				if err := doc.MergeUnion(&policy.IAMPolicyDoc{Statements: []*policy.IAMPolicyStatement{stmt}}); err != nil {
+					return fmt.Errorf("reading statement: %w", err)
 				}
 			}
-
-			stmts[i] = stmt
+		} else {
+			doc.Statements = stmts
 		}
-
-		doc.Statements = stmts
 	}
 
 	// merge our current document into mergedDoc
-	mergedDoc.Merge(doc)
+	if err := merge(doc); err != nil {
+		return fmt.Errorf("merging statement: %w", err)
+	}
 
 	// merge override_policy_documents policies into mergedDoc in order specified
 	if v, ok := d.GetOk("override_policy_documents"); ok && len(v.([]interface{})) > 0 {
-		for _, overrideJSON := range v.([]interface{}) {
+		for overrideJSONIndex, overrideJSON := range v.([]interface{}) {
 			if overrideJSON == nil {
 				continue
 			}
-			overrideDoc := &IAMPolicyDoc{}
+			overrideDoc := &policy.IAMPolicyDoc{}
 			if err := json.Unmarshal([]byte(overrideJSON.(string)), overrideDoc); err != nil {
 				return err
 			}
 
-			mergedDoc.Merge(overrideDoc)
+			if err := merge(overrideDoc); err != nil {
+				return fmt.Errorf("merging override_policy_documents (item %d): %w", overrideJSONIndex, err)
+			}
 		}
 	}
 
 	// merge in override_json
 	if v, ok := d.GetOk("override_json"); ok {
-		overrideDoc := &IAMPolicyDoc{}
+		overrideDoc := &policy.IAMPolicyDoc{}
 		if err := json.Unmarshal([]byte(v.(string)), overrideDoc); err != nil {
 			return err
 		}
 
-		mergedDoc.Merge(overrideDoc)
+		if err := merge(overrideDoc); err != nil {
+			return fmt.Errorf("merging override_json: %w", err)
+		}
+	}
+
+	if d.Get("deduplicate_statements").(bool) {
+		if err := mergedDoc.Deduplicate(); err != nil {
+			return fmt.Errorf("deduplicating statements: %w", err)
+		}
 	}
 
 	jsonDoc, err := json.MarshalIndent(mergedDoc, "", "  ")
@@ -288,12 +333,124 @@ func dataSourcePolicyDocumentRead(d *schema.ResourceData, meta interface{}) erro
 	}
 	jsonString := string(jsonDoc)
 
+	// Statements that can't be split only prevent splitting if a maximum size is configured.
+	// Otherwise, the document is left whole, e.g. for a resource-based policy with a larger size limit.
+	maxSize, splitRequested := d.GetOk("split_max_size")
+	if !splitRequested {
+		maxSize = managedPolicyMaxSize
+	}
+
+	splitDocs, err := mergedDoc.Split(maxSize.(int))
+	if err != nil {
+		if splitRequested {
+			return fmt.Errorf("splitting policy document: %w", err)
+		}
+
+		splitDocs = []*policy.IAMPolicyDoc{mergedDoc}
+	}
+
+	var splitJSON []string
+	for _, splitDoc := range splitDocs {
+		jsonDoc, err := json.MarshalIndent(splitDoc, "", "  ")
+		if err != nil {
+			return err
+		}
+		splitJSON = append(splitJSON, string(jsonDoc))
+	}
+
 	d.Set("json", jsonString)
+	d.Set("split_json", splitJSON)
 	d.SetId(strconv.Itoa(create.StringHashcode(jsonString)))
 
 	return nil
 }
 
+func dataSourcePolicyDocumentMakeStatement(cfgStmt map[string]interface{}, version string) (*policy.IAMPolicyStatement, error) {
+	stmt := &policy.IAMPolicyStatement{
+		Effect: cfgStmt["effect"].(string),
+	}
+
+	if sid, ok := cfgStmt["sid"]; ok {
+		stmt.Sid = sid.(string)
+	}
+
+	if actions := cfgStmt["actions"].(*schema.Set).List(); len(actions) > 0 {
+		stmt.Actions = policy.DecodeConfigStringList(actions)
+	}
+	if actions := cfgStmt["not_actions"].(*schema.Set).List(); len(actions) > 0 {
+		stmt.NotActions = policy.DecodeConfigStringList(actions)
+	}
+
+	if resources := cfgStmt["resources"].(*schema.Set).List(); len(resources) > 0 {
+		var err error
+		stmt.Resources, err = dataSourcePolicyDocumentReplaceVarsInList(
+			policy.DecodeConfigStringList(resources), version,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("error reading resources: %w", err)
+		}
+	}
+	if notResources := cfgStmt["not_resources"].(*schema.Set).List(); len(notResources) > 0 {
+		var err error
+		stmt.NotResources, err = dataSourcePolicyDocumentReplaceVarsInList(
+			policy.DecodeConfigStringList(notResources), version,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("error reading not_resources: %w", err)
+		}
+	}
+
+	if principals := cfgStmt["principals"].(*schema.Set).List(); len(principals) > 0 {
+		var err error
+		stmt.Principals, err = dataSourcePolicyDocumentMakePrincipals(principals, version)
+		if err != nil {
+			return nil, fmt.Errorf("error reading principals: %w", err)
+		}
+	}
+
+	if notPrincipals := cfgStmt["not_principals"].(*schema.Set).List(); len(notPrincipals) > 0 {
+		var err error
+		stmt.NotPrincipals, err = dataSourcePolicyDocumentMakePrincipals(notPrincipals, version)
+		if err != nil {
+			return nil, fmt.Errorf("error reading not_principals: %w", err)
+		}
+	}
+
+	if conditions := cfgStmt["condition"].(*schema.Set).List(); len(conditions) > 0 {
+		var err error
+		stmt.Conditions, err = dataSourcePolicyDocumentMakeConditions(conditions, version)
+		if err != nil {
+			return nil, fmt.Errorf("error reading condition: %w", err)
+		}
+	}
+
+	return stmt, nil
+}
+
+// dataSourcePolicyDocumentExpandTemplate returns a copy of a statement's configuration with each &{each.value} replaced by value.
+func dataSourcePolicyDocumentExpandTemplate(in interface{}, value string) interface{} {
+	switch v := in.(type) {
+	case string:
+		return strings.ReplaceAll(v, policyDocumentTemplateValuePlaceholder, value)
+	case []interface{}:
+		out := make([]interface{}, len(v))
+		for i, item := range v {
+			out[i] = dataSourcePolicyDocumentExpandTemplate(item, value)
+		}
+		return out
+	case map[string]interface{}:
+		out := make(map[string]interface{}, len(v))
+		for k, item := range v {
+			out[k] = dataSourcePolicyDocumentExpandTemplate(item, value)
+		}
+		return out
+	case *schema.Set:
+		return schema.NewSet(v.F, dataSourcePolicyDocumentExpandTemplate(v.List(), value).([]interface{}))
+	default:
+		return v
+	}
+}
+
 func dataSourcePolicyDocumentReplaceVarsInList(in interface{}, version string) (interface{}, error) {
 	switch v := in.(type) {
 	case string:
@@ -315,12 +472,12 @@ func dataSourcePolicyDocumentReplaceVarsInList(in interface{}, version string) (
 	}
 }
 
-func dataSourcePolicyDocumentMakeConditions(in []interface{}, version string) (IAMPolicyStatementConditionSet, error) {
-	out := make([]IAMPolicyStatementCondition, len(in))
+func dataSourcePolicyDocumentMakeConditions(in []interface{}, version string) (policy.IAMPolicyStatementConditionSet, error) {
+	out := make([]policy.IAMPolicyStatementCondition, len(in))
 	for i, itemI := range in {
 		var err error
 		item := itemI.(map[string]interface{})
-		out[i] = IAMPolicyStatementCondition{
+		out[i] = policy.IAMPolicyStatementCondition{
 			Test:     item["test"].(string),
 			Variable: item["variable"].(string),
 		}
@@ -336,19 +493,19 @@ func dataSourcePolicyDocumentMakeConditions(in []interface{}, version string) (I
 			out[i].Values = itemValues[0]
 		}
 	}
-	return IAMPolicyStatementConditionSet(out), nil
+	return policy.IAMPolicyStatementConditionSet(out), nil
 }
 
-func dataSourcePolicyDocumentMakePrincipals(in []interface{}, version string) (IAMPolicyStatementPrincipalSet, error) {
-	out := make([]IAMPolicyStatementPrincipal, len(in))
+func dataSourcePolicyDocumentMakePrincipals(in []interface{}, version string) (policy.IAMPolicyStatementPrincipalSet, error) {
+	out := make([]policy.IAMPolicyStatementPrincipal, len(in))
 	for i, itemI := range in {
 		var err error
 		item := itemI.(map[string]interface{})
-		out[i] = IAMPolicyStatementPrincipal{
+		out[i] = policy.IAMPolicyStatementPrincipal{
 			Type: item["type"].(string),
 		}
 		out[i].Identifiers, err = dataSourcePolicyDocumentReplaceVarsInList(
-			policyDecodeConfigStringList(
+			policy.DecodeConfigStringList(
 				item["identifiers"].(*schema.Set).List(),
 			), version,
 		)
@@ -356,7 +513,7 @@ func dataSourcePolicyDocumentMakePrincipals(in []interface{}, version string) (I
 			return nil, fmt.Errorf("error reading identifiers: %w", err)
 		}
 	}
-	return IAMPolicyStatementPrincipalSet(out), nil
+	return policy.IAMPolicyStatementPrincipalSet(out), nil
 }
 
 func dataSourcePolicyPrincipalSchema() *schema.Schema {
@@ -421,6 +421,100 @@ func TestAccIAMPolicyDocumentDataSource_version20081017(t *testing.T) {
 	})
 }
 
+func TestAccIAMPolicyDocumentDataSource_mergeModeUnion(t *testing.T) {
+	dataSourceName := "data.aws_iam_policy_document.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, iam.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPolicyDocumentDataSourceConfig_mergeModeUnion,
+				Check: resource.ComposeTestCheckFunc(
+					acctest.CheckResourceAttrEquivalentJSON(dataSourceName, "json", testAccPolicyDocumentMergeModeUnionExpectedJSON),
+				),
+			},
+		},
+	})
+}
+
+func TestAccIAMPolicyDocumentDataSource_mergeModeUnionConflicting(t *testing.T) {
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, iam.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccPolicyDocumentDataSourceConfig_mergeModeUnionConflicting,
+				ExpectError: regexp.MustCompile(`merging statements with Sid \(Read\): effects differ`),
+			},
+		},
+	})
+}
+
+func TestAccIAMPolicyDocumentDataSource_templateValues(t *testing.T) {
+	dataSourceName := "data.aws_iam_policy_document.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, iam.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPolicyDocumentDataSourceConfig_templateValues,
+				Check: resource.ComposeTestCheckFunc(
+					acctest.CheckResourceAttrEquivalentJSON(dataSourceName, "json", testAccPolicyDocumentTemplateValuesExpectedJSON),
+					resource.TestCheckResourceAttr(dataSourceName, "split_json.#", "1"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccIAMPolicyDocumentDataSource_split(t *testing.T) {
+	dataSourceName := "data.aws_iam_policy_document.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, iam.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPolicyDocumentDataSourceConfig_split,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "split_json.#", "2"),
+					acctest.CheckResourceAttrEquivalentJSON(dataSourceName, "split_json.0", testAccPolicyDocumentSplit0ExpectedJSON),
+					acctest.CheckResourceAttrEquivalentJSON(dataSourceName, "split_json.1", testAccPolicyDocumentSplit1ExpectedJSON),
+				),
+			},
+		},
+	})
+}
+
+func TestAccIAMPolicyDocumentDataSource_splitUnsplittable(t *testing.T) {
+	dataSourceName := "data.aws_iam_policy_document.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, iam.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPolicyDocumentDataSourceConfig_splitUnsplittable(""),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "split_json.#", "1"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "split_json.0", dataSourceName, "json"),
+				),
+			},
+			{
+				Config:      testAccPolicyDocumentDataSourceConfig_splitUnsplittable("split_max_size = 6144"),
+				ExpectError: regexp.MustCompile(`splitting policy document`),
+			},
+		},
+	})
+}
+
 var testAccPolicyDocumentDataSourceConfig_basic = `
 data "aws_partition" "current" {}
 
@@ -1543,3 +1637,179 @@ data "aws_iam_policy_document" "test" {
   source_json = "{"
 }
 `
+
+const testAccPolicyDocumentDataSourceConfig_mergeModeUnion = `
+data "aws_iam_policy_document" "source" {
+  statement {
+    sid       = "Read"
+    actions   = ["s3:GetObject"]
+    resources = ["arn:aws:s3:::first/*"]
+  }
+
+  statement {
+    actions   = ["sqs:SendMessage"]
+    resources = ["*"]
+  }
+}
+
+data "aws_iam_policy_document" "test" {
+  source_policy_documents = [data.aws_iam_policy_document.source.json]
+  merge_mode              = "union"
+  deduplicate_statements  = true
+
+  statement {
+    sid       = "Read"
+    actions   = ["s3:GetObject", "s3:GetObjectVersion"]
+    resources = ["arn:aws:s3:::second/*"]
+  }
+
+  statement {
+    actions   = ["sqs:SendMessage"]
+    resources = ["*"]
+  }
+}
+`
+
+const testAccPolicyDocumentMergeModeUnionExpectedJSON = `{
+  "Version": "2012-10-17",
+  "Statement": [
+    {
+      "Sid": "Read",
+      "Effect": "Allow",
+      "Action": [
+        "s3:GetObjectVersion",
+        "s3:GetObject"
+      ],
+      "Resource": [
+        "arn:aws:s3:::second/*",
+        "arn:aws:s3:::first/*"
+      ]
+    },
+    {
+      "Sid": "",
+      "Effect": "Allow",
+      "Action": "sqs:SendMessage",
+      "Resource": "*"
+    }
+  ]
+}`
+
+const testAccPolicyDocumentDataSourceConfig_mergeModeUnionConflicting = `
+data "aws_iam_policy_document" "test" {
+  merge_mode = "union"
+
+  statement {
+    sid       = "Read"
+    actions   = ["s3:GetObject"]
+    resources = ["*"]
+  }
+
+  statement {
+    sid       = "Read"
+    effect    = "Deny"
+    actions   = ["s3:GetObject"]
+    resources = ["*"]
+  }
+}
+`
+
+const testAccPolicyDocumentDataSourceConfig_templateValues = `
+data "aws_iam_policy_document" "test" {
+  statement {
+    sid             = "Read"
+    template_values = ["arn:aws:s3:::first", "arn:aws:s3:::second"]
+    actions         = ["s3:GetObject"]
+    resources       = ["&{each.value}/*"]
+
+    condition {
+      test     = "StringEquals"
+      variable = "s3:ExistingObjectTag/bucket"
+      values   = ["&{each.value}"]
+    }
+  }
+}
+`
+
+const testAccPolicyDocumentTemplateValuesExpectedJSON = `{
+  "Version": "2012-10-17",
+  "Statement": [
+    {
+      "Sid": "Read0",
+      "Effect": "Allow",
+      "Action": "s3:GetObject",
+      "Resource": "arn:aws:s3:::first/*",
+      "Condition": {
+        "StringEquals": {
+          "s3:ExistingObjectTag/bucket": "arn:aws:s3:::first"
+        }
+      }
+    },
+    {
+      "Sid": "Read1",
+      "Effect": "Allow",
+      "Action": "s3:GetObject",
+      "Resource": "arn:aws:s3:::second/*",
+      "Condition": {
+        "StringEquals": {
+          "s3:ExistingObjectTag/bucket": "arn:aws:s3:::second"
+        }
+      }
+    }
+  ]
+}`
+
+const testAccPolicyDocumentDataSourceConfig_split = `
+data "aws_iam_policy_document" "test" {
+  split_max_size = 150
+
+  statement {
+    sid       = "Read"
+    actions   = ["s3:GetObject"]
+    resources = ["arn:aws:s3:::first/*", "arn:aws:s3:::second/*"]
+  }
+}
+`
+
+// A statement with a single resource and a condition larger than a managed policy can't be split.
+func testAccPolicyDocumentDataSourceConfig_splitUnsplittable(splitMaxSize string) string {
+	return fmt.Sprintf(`
+data "aws_iam_policy_document" "test" {
+  %[1]s
+
+  statement {
+    actions   = ["s3:GetObject"]
+    resources = ["arn:aws:s3:::test/*"]
+
+    condition {
+      test     = "ArnEquals"
+      variable = "aws:PrincipalArn"
+      values   = [for i in range(200) : "arn:aws:iam::123456789012:role/tf-acc-test-role-${i}"]
+    }
+  }
+}
+`, splitMaxSize)
+}
+
+const testAccPolicyDocumentSplit0ExpectedJSON = `{
+  "Version": "2012-10-17",
+  "Statement": [
+    {
+      "Sid": "Read0",
+      "Effect": "Allow",
+      "Action": "s3:GetObject",
+      "Resource": "arn:aws:s3:::second/*"
+    }
+  ]
+}`
+
+const testAccPolicyDocumentSplit1ExpectedJSON = `{
+  "Version": "2012-10-17",
+  "Statement": [
+    {
+      "Sid": "Read1",
+      "Effect": "Allow",
+      "Action": "s3:GetObject",
+      "Resource": "arn:aws:s3:::first/*"
+    }
+  ]
+}`
@@ -0,0 +1,128 @@
+package iam
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	"github.com/hashicorp/terraform-provider-aws/internal/policy"
+)
+
+// Maximum policy sizes, not counting white space.
+// See https://docs.aws.amazon.com/IAM/latest/UserGuide/reference_iam-quotas.html#reference_iam-quotas-entity-length.
+const (
+	groupPolicyMaxSize   = 5120
+	managedPolicyMaxSize = 6144
+	rolePolicyMaxSize    = 10240
+	trustPolicyMaxSize   = 2048
+	userPolicyMaxSize    = 2048
+)
+
+func DataSourcePolicyLint() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourcePolicyLintRead,
+
+		Schema: map[string]*schema.Schema{
+			"findings": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"code": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"message": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"severity": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"sid": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"statement_index": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"max_size": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ValidateFunc: validation.IntAtLeast(0),
+			},
+			"policy": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"policy_type": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      string(policy.TypeIdentity),
+				ValidateFunc: validation.StringInSlice(policy.Type_Values(), false),
+			},
+			"valid": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func dataSourcePolicyLintRead(d *schema.ResourceData, meta interface{}) error {
+	document := d.Get("policy").(string)
+	policyType := policy.Type(d.Get("policy_type").(string))
+
+	maxSize := defaultPolicyMaxSize(policyType)
+	if v, ok := d.GetOk("max_size"); ok {
+		maxSize = v.(int)
+	}
+
+	findings := policy.Lint(document, policyType, maxSize)
+
+	valid := true
+	tfList := make([]interface{}, 0, len(findings))
+
+	for _, finding := range findings {
+		if finding.Severity == policy.LintSeverityError {
+			valid = false
+		}
+
+		tfList = append(tfList, map[string]interface{}{
+			"code":            finding.Code,
+			"message":         finding.Message,
+			"severity":        finding.Severity,
+			"sid":             finding.Sid,
+			"statement_index": finding.StatementIndex,
+		})
+	}
+
+	d.SetId(strconv.Itoa(create.StringHashcode(fmt.Sprintf("%s-%s-%d", document, policyType, maxSize))))
+
+	if err := d.Set("findings", tfList); err != nil {
+		return fmt.Errorf("setting findings: %w", err)
+	}
+
+	d.Set("valid", valid)
+
+	return nil
+}
+
+// defaultPolicyMaxSize returns the maximum size of a policy of the specified type, or 0 if it has no fixed limit.
+func defaultPolicyMaxSize(policyType policy.Type) int {
+	switch policyType {
+	case policy.TypeIdentity:
+		return managedPolicyMaxSize
+	case policy.TypeTrust:
+		return trustPolicyMaxSize
+	default:
+		return 0
+	}
+}
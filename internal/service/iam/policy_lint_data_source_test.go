@@ -0,0 +1,93 @@
+package iam_test
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/iam"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+)
+
+func TestAccIAMPolicyLintDataSource_basic(t *testing.T) {
+	dataSourceName := "data.aws_iam_policy_lint.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, iam.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPolicyLintDataSourceConfig_basic,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "valid", "false"),
+					resource.TestCheckResourceAttr(dataSourceName, "findings.#", "2"),
+					resource.TestCheckResourceAttr(dataSourceName, "findings.0.code", "INVALID_ACTION"),
+					resource.TestCheckResourceAttr(dataSourceName, "findings.0.severity", "ERROR"),
+					resource.TestCheckResourceAttr(dataSourceName, "findings.0.sid", "First"),
+					resource.TestCheckResourceAttr(dataSourceName, "findings.0.statement_index", "0"),
+					resource.TestCheckResourceAttr(dataSourceName, "findings.1.code", "DUPLICATE_SID"),
+					resource.TestCheckResourceAttr(dataSourceName, "findings.1.statement_index", "1"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccIAMPolicyLintDataSource_valid(t *testing.T) {
+	dataSourceName := "data.aws_iam_policy_lint.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, iam.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPolicyLintDataSourceConfig_valid,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "valid", "true"),
+					resource.TestCheckResourceAttr(dataSourceName, "findings.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+const testAccPolicyLintDataSourceConfig_basic = `
+data "aws_iam_policy_lint" "test" {
+  policy = jsonencode({
+    Version = "2012-10-17"
+    Statement = [
+      {
+        Sid      = "First"
+        Effect   = "Allow"
+        Action   = "s3GetObject"
+        Resource = "*"
+      },
+      {
+        Sid      = "First"
+        Effect   = "Deny"
+        Action   = "s3:DeleteObject"
+        Resource = "*"
+      },
+    ]
+  })
+}
+`
+
+const testAccPolicyLintDataSourceConfig_valid = `
+data "aws_iam_policy_document" "test" {
+  statement {
+    actions = ["sts:AssumeRole"]
+
+    principals {
+      type        = "Service"
+      identifiers = ["ec2.amazonaws.com"]
+    }
+  }
+}
+
+data "aws_iam_policy_lint" "test" {
+  policy      = data.aws_iam_policy_document.test.json
+  policy_type = "TRUST_POLICY"
+}
+`
@@ -0,0 +1,120 @@
+package iam
+
+import (
+	"context"
+	"log"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/iam"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func ResourceRolePoliciesExclusive() *schema.Resource {
+	return &schema.Resource{
+		// Create and Update both delete inline policies that aren't configured.
+		CreateWithoutTimeout: resourceRolePoliciesExclusivePut,
+		ReadWithoutTimeout:   resourceRolePoliciesExclusiveRead,
+		UpdateWithoutTimeout: resourceRolePoliciesExclusivePut,
+		DeleteWithoutTimeout: resourceRolePoliciesExclusiveDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceRolePoliciesExclusiveImport,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"policy_names": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validRolePolicyName,
+				},
+			},
+			"role_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validRolePolicyRole,
+			},
+		},
+	}
+}
+
+func resourceRolePoliciesExclusivePut(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).IAMConn()
+
+	roleName := d.Get("role_name").(string)
+
+	policyNames, err := FindRolePolicyNames(ctx, conn, roleName)
+
+	if err != nil {
+		return diag.Errorf("reading IAM Role (%s) inline policies: %s", roleName, err)
+	}
+
+	// Configured policies are created by aws_iam_role_policy resources; only the others are removed here.
+	missing, del := exclusiveSetDiff(d.Get("policy_names").(*schema.Set), policyNames)
+
+	if len(missing) > 0 {
+		return diag.Errorf("IAM Role (%s) inline policies not found: %s", roleName, strings.Join(missing, ", "))
+	}
+
+	for _, policyName := range del {
+		log.Printf("[DEBUG] Deleting IAM Role (%s) inline policy: %s", roleName, policyName)
+		_, err := conn.DeleteRolePolicyWithContext(ctx, &iam.DeleteRolePolicyInput{
+			PolicyName: aws.String(policyName),
+			RoleName:   aws.String(roleName),
+		})
+
+		if tfawserr.ErrCodeEquals(err, iam.ErrCodeNoSuchEntityException) {
+			continue
+		}
+
+		if err != nil {
+			return diag.Errorf("deleting IAM Role (%s) inline policy (%s): %s", roleName, policyName, err)
+		}
+	}
+
+	d.SetId(roleName)
+
+	return resourceRolePoliciesExclusiveRead(ctx, d, meta)
+}
+
+func resourceRolePoliciesExclusiveRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).IAMConn()
+
+	policyNames, err := FindRolePolicyNames(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] IAM Role (%s) not found, removing inline policies from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("reading IAM Role (%s) inline policies: %s", d.Id(), err)
+	}
+
+	d.Set("policy_names", flex.FlattenStringValueSet(policyNames))
+	d.Set("role_name", d.Id())
+
+	return nil
+}
+
+func resourceRolePoliciesExclusiveDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	// The inline policies remain. Destroying the resource only ends exclusive management.
+	log.Printf("[DEBUG] Removing IAM Role (%s) exclusive inline policies from state", d.Id())
+
+	return nil
+}
+
+func resourceRolePoliciesExclusiveImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	d.Set("role_name", d.Id())
+
+	return []*schema.ResourceData{d}, nil
+}
@@ -7,11 +7,14 @@ import (
 	"strings"
 
 	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/accessanalyzer"
 	"github.com/aws/aws-sdk-go/service/iam"
 	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/policy"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 	"github.com/hashicorp/terraform-provider-aws/internal/verify"
 )
@@ -40,6 +43,11 @@ func ResourceRolePolicy() *schema.Resource {
 				ValidateFunc:     verify.ValidIAMPolicyJSON,
 				DiffSuppressFunc: verify.SuppressEquivalentPolicyDiffs,
 			},
+			"policy_changes": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
 			"name": {
 				Type:          schema.TypeString,
 				Optional:      true,
@@ -62,11 +70,17 @@ func ResourceRolePolicy() *schema.Resource {
 				ValidateFunc: validRolePolicyRole,
 			},
 		},
+
+		CustomizeDiff: customdiff.Sequence(
+			policy.LintCustomizeDiff("policy", policy.TypeIdentity, rolePolicyMaxSize),
+			policy.ValidateCustomizeDiff("policy", accessanalyzer.PolicyTypeIdentityPolicy, ""),
+			verify.SetPolicyChangesDiff("policy"),
+		),
 	}
 }
 
 func resourceRolePolicyPut(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).IAMConn
+	conn := meta.(*conns.AWSClient).IAMConn()
 
 	request := &iam.PutRolePolicyInput{
 		RoleName:       aws.String(d.Get("role").(string)),
@@ -92,7 +106,7 @@ func resourceRolePolicyPut(d *schema.ResourceData, meta interface{}) error {
 }
 
 func resourceRolePolicyRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).IAMConn
+	conn := meta.(*conns.AWSClient).IAMConn()
 
 	role, name, err := RolePolicyParseID(d.Id())
 	if err != nil {
@@ -145,6 +159,8 @@ func resourceRolePolicyRead(d *schema.ResourceData, meta interface{}) error {
 		return err
 	}
 
+	d.Set("policy_changes", nil)
+
 	policyToSet, err := verify.SecondJSONUnlessEquivalent(d.Get("policy").(string), policy)
 
 	if err != nil {
@@ -152,7 +168,6 @@ func resourceRolePolicyRead(d *schema.ResourceData, meta interface{}) error {
 	}
 
 	d.Set("policy", policyToSet)
-
 	if err := d.Set("name", name); err != nil {
 		return err
 	}
@@ -160,7 +175,7 @@ func resourceRolePolicyRead(d *schema.ResourceData, meta interface{}) error {
 }
 
 func resourceRolePolicyDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).IAMConn
+	conn := meta.(*conns.AWSClient).IAMConn()
 
 	role, name, err := RolePolicyParseID(d.Id())
 	if err != nil {
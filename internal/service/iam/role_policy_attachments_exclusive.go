@@ -0,0 +1,125 @@
+package iam
+
+import (
+	"context"
+	"log"
+
+	"github.com/aws/aws-sdk-go/service/iam"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+func ResourceRolePolicyAttachmentsExclusive() *schema.Resource {
+	return &schema.Resource{
+		// Create and Update both reconcile the role's attachments with the configuration.
+		CreateWithoutTimeout: resourceRolePolicyAttachmentsExclusivePut,
+		ReadWithoutTimeout:   resourceRolePolicyAttachmentsExclusiveRead,
+		UpdateWithoutTimeout: resourceRolePolicyAttachmentsExclusivePut,
+		DeleteWithoutTimeout: resourceRolePolicyAttachmentsExclusiveDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceRolePolicyAttachmentsExclusiveImport,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"policy_arns": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: verify.ValidARN,
+				},
+			},
+			"role_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validRolePolicyRole,
+			},
+		},
+	}
+}
+
+func resourceRolePolicyAttachmentsExclusivePut(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).IAMConn()
+
+	roleName := d.Get("role_name").(string)
+
+	attached, err := FindRoleAttachedPolicyARNs(ctx, conn, roleName)
+
+	if err != nil {
+		return diag.Errorf("reading IAM Role (%s) policy attachments: %s", roleName, err)
+	}
+
+	add, del := exclusiveSetDiff(d.Get("policy_arns").(*schema.Set), attached)
+
+	for _, policyARN := range add {
+		log.Printf("[DEBUG] Attaching IAM Role (%s) policy: %s", roleName, policyARN)
+		if err := attachPolicyToRole(conn, roleName, policyARN); err != nil {
+			return diag.Errorf("attaching IAM Role (%s) policy (%s): %s", roleName, policyARN, err)
+		}
+	}
+
+	for _, policyARN := range del {
+		log.Printf("[DEBUG] Detaching IAM Role (%s) policy: %s", roleName, policyARN)
+		err := DetachPolicyFromRole(conn, roleName, policyARN)
+
+		if tfawserr.ErrCodeEquals(err, iam.ErrCodeNoSuchEntityException) {
+			continue
+		}
+
+		if err != nil {
+			return diag.Errorf("detaching IAM Role (%s) policy (%s): %s", roleName, policyARN, err)
+		}
+	}
+
+	d.SetId(roleName)
+
+	return resourceRolePolicyAttachmentsExclusiveRead(ctx, d, meta)
+}
+
+func resourceRolePolicyAttachmentsExclusiveRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).IAMConn()
+
+	attached, err := FindRoleAttachedPolicyARNs(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] IAM Role (%s) not found, removing policy attachments from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("reading IAM Role (%s) policy attachments: %s", d.Id(), err)
+	}
+
+	d.Set("policy_arns", flex.FlattenStringValueSet(attached))
+	d.Set("role_name", d.Id())
+
+	return nil
+}
+
+func resourceRolePolicyAttachmentsExclusiveDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	// The policies remain attached. Destroying the resource only ends exclusive management.
+	log.Printf("[DEBUG] Removing IAM Role (%s) exclusive policy attachments from state", d.Id())
+
+	return nil
+}
+
+func resourceRolePolicyAttachmentsExclusiveImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	d.Set("role_name", d.Id())
+
+	return []*schema.ResourceData{d}, nil
+}
+
+// exclusiveSetDiff returns the configured values that are missing from the existing values and the existing values that are not configured.
+func exclusiveSetDiff(configured *schema.Set, existing []string) (add, del []string) {
+	existingSet := flex.FlattenStringValueSet(existing)
+
+	return flex.ExpandStringValueSet(configured.Difference(existingSet)), flex.ExpandStringValueSet(existingSet.Difference(configured))
+}
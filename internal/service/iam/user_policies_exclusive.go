@@ -0,0 +1,118 @@
+package iam
+
+import (
+	"context"
+	"log"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/iam"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func ResourceUserPoliciesExclusive() *schema.Resource {
+	return &schema.Resource{
+		// Create and Update both delete inline policies that aren't configured.
+		CreateWithoutTimeout: resourceUserPoliciesExclusivePut,
+		ReadWithoutTimeout:   resourceUserPoliciesExclusiveRead,
+		UpdateWithoutTimeout: resourceUserPoliciesExclusivePut,
+		DeleteWithoutTimeout: resourceUserPoliciesExclusiveDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceUserPoliciesExclusiveImport,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"policy_names": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"user_name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+		},
+	}
+}
+
+func resourceUserPoliciesExclusivePut(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).IAMConn()
+
+	userName := d.Get("user_name").(string)
+
+	policyNames, err := FindUserPolicyNames(ctx, conn, userName)
+
+	if err != nil {
+		return diag.Errorf("reading IAM User (%s) inline policies: %s", userName, err)
+	}
+
+	// Configured policies are created by aws_iam_user_policy resources; only the others are removed here.
+	missing, del := exclusiveSetDiff(d.Get("policy_names").(*schema.Set), policyNames)
+
+	if len(missing) > 0 {
+		return diag.Errorf("IAM User (%s) inline policies not found: %s", userName, strings.Join(missing, ", "))
+	}
+
+	for _, policyName := range del {
+		log.Printf("[DEBUG] Deleting IAM User (%s) inline policy: %s", userName, policyName)
+		_, err := conn.DeleteUserPolicyWithContext(ctx, &iam.DeleteUserPolicyInput{
+			PolicyName: aws.String(policyName),
+			UserName:   aws.String(userName),
+		})
+
+		if tfawserr.ErrCodeEquals(err, iam.ErrCodeNoSuchEntityException) {
+			continue
+		}
+
+		if err != nil {
+			return diag.Errorf("deleting IAM User (%s) inline policy (%s): %s", userName, policyName, err)
+		}
+	}
+
+	d.SetId(userName)
+
+	return resourceUserPoliciesExclusiveRead(ctx, d, meta)
+}
+
+func resourceUserPoliciesExclusiveRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).IAMConn()
+
+	policyNames, err := FindUserPolicyNames(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] IAM User (%s) not found, removing inline policies from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("reading IAM User (%s) inline policies: %s", d.Id(), err)
+	}
+
+	d.Set("policy_names", flex.FlattenStringValueSet(policyNames))
+	d.Set("user_name", d.Id())
+
+	return nil
+}
+
+func resourceUserPoliciesExclusiveDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	// The inline policies remain. Destroying the resource only ends exclusive management.
+	log.Printf("[DEBUG] Removing IAM User (%s) exclusive inline policies from state", d.Id())
+
+	return nil
+}
+
+func resourceUserPoliciesExclusiveImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	d.Set("user_name", d.Id())
+
+	return []*schema.ResourceData{d}, nil
+}
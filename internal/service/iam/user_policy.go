@@ -7,11 +7,14 @@ import (
 	"strings"
 
 	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/accessanalyzer"
 	"github.com/aws/aws-sdk-go/service/iam"
 	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/policy"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 	"github.com/hashicorp/terraform-provider-aws/internal/verify"
 )
@@ -35,6 +38,11 @@ func ResourceUserPolicy() *schema.Resource {
 				ValidateFunc:     verify.ValidIAMPolicyJSON,
 				DiffSuppressFunc: verify.SuppressEquivalentPolicyDiffs,
 			},
+			"policy_changes": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
 			"name": {
 				Type:          schema.TypeString,
 				Optional:      true,
@@ -54,11 +62,17 @@ func ResourceUserPolicy() *schema.Resource {
 				ForceNew: true,
 			},
 		},
+
+		CustomizeDiff: customdiff.Sequence(
+			policy.LintCustomizeDiff("policy", policy.TypeIdentity, userPolicyMaxSize),
+			policy.ValidateCustomizeDiff("policy", accessanalyzer.PolicyTypeIdentityPolicy, ""),
+			verify.SetPolicyChangesDiff("policy"),
+		),
 	}
 }
 
 func resourceUserPolicyPut(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).IAMConn
+	conn := meta.(*conns.AWSClient).IAMConn()
 
 	request := &iam.PutUserPolicyInput{
 		UserName:       aws.String(d.Get("user").(string)),
@@ -90,7 +104,7 @@ func resourceUserPolicyPut(d *schema.ResourceData, meta interface{}) error {
 }
 
 func resourceUserPolicyRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).IAMConn
+	conn := meta.(*conns.AWSClient).IAMConn()
 
 	user, name, err := UserPolicyParseID(d.Id())
 	if err != nil {
@@ -143,6 +157,8 @@ func resourceUserPolicyRead(d *schema.ResourceData, meta interface{}) error {
 		return err
 	}
 
+	d.Set("policy_changes", nil)
+
 	policyToSet, err := verify.SecondJSONUnlessEquivalent(d.Get("policy").(string), policy)
 
 	if err != nil {
@@ -150,7 +166,6 @@ func resourceUserPolicyRead(d *schema.ResourceData, meta interface{}) error {
 	}
 
 	d.Set("policy", policyToSet)
-
 	if err := d.Set("name", name); err != nil {
 		return err
 	}
@@ -158,7 +173,7 @@ func resourceUserPolicyRead(d *schema.ResourceData, meta interface{}) error {
 }
 
 func resourceUserPolicyDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).IAMConn
+	conn := meta.(*conns.AWSClient).IAMConn()
 
 	user, name, err := UserPolicyParseID(d.Id())
 	if err != nil {
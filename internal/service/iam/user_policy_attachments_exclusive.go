@@ -0,0 +1,117 @@
+package iam
+
+import (
+	"context"
+	"log"
+
+	"github.com/aws/aws-sdk-go/service/iam"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+func ResourceUserPolicyAttachmentsExclusive() *schema.Resource {
+	return &schema.Resource{
+		// Create and Update both reconcile the user's attachments with the configuration.
+		CreateWithoutTimeout: resourceUserPolicyAttachmentsExclusivePut,
+		ReadWithoutTimeout:   resourceUserPolicyAttachmentsExclusiveRead,
+		UpdateWithoutTimeout: resourceUserPolicyAttachmentsExclusivePut,
+		DeleteWithoutTimeout: resourceUserPolicyAttachmentsExclusiveDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceUserPolicyAttachmentsExclusiveImport,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"policy_arns": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: verify.ValidARN,
+				},
+			},
+			"user_name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+		},
+	}
+}
+
+func resourceUserPolicyAttachmentsExclusivePut(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).IAMConn()
+
+	userName := d.Get("user_name").(string)
+
+	attached, err := FindUserAttachedPolicyARNs(ctx, conn, userName)
+
+	if err != nil {
+		return diag.Errorf("reading IAM User (%s) policy attachments: %s", userName, err)
+	}
+
+	add, del := exclusiveSetDiff(d.Get("policy_arns").(*schema.Set), attached)
+
+	for _, policyARN := range add {
+		log.Printf("[DEBUG] Attaching IAM User (%s) policy: %s", userName, policyARN)
+		if err := attachPolicyToUser(conn, userName, policyARN); err != nil {
+			return diag.Errorf("attaching IAM User (%s) policy (%s): %s", userName, policyARN, err)
+		}
+	}
+
+	for _, policyARN := range del {
+		log.Printf("[DEBUG] Detaching IAM User (%s) policy: %s", userName, policyARN)
+		err := DetachPolicyFromUser(conn, userName, policyARN)
+
+		if tfawserr.ErrCodeEquals(err, iam.ErrCodeNoSuchEntityException) {
+			continue
+		}
+
+		if err != nil {
+			return diag.Errorf("detaching IAM User (%s) policy (%s): %s", userName, policyARN, err)
+		}
+	}
+
+	d.SetId(userName)
+
+	return resourceUserPolicyAttachmentsExclusiveRead(ctx, d, meta)
+}
+
+func resourceUserPolicyAttachmentsExclusiveRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).IAMConn()
+
+	attached, err := FindUserAttachedPolicyARNs(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] IAM User (%s) not found, removing policy attachments from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("reading IAM User (%s) policy attachments: %s", d.Id(), err)
+	}
+
+	d.Set("policy_arns", flex.FlattenStringValueSet(attached))
+	d.Set("user_name", d.Id())
+
+	return nil
+}
+
+func resourceUserPolicyAttachmentsExclusiveDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	// The policies remain attached. Destroying the resource only ends exclusive management.
+	log.Printf("[DEBUG] Removing IAM User (%s) exclusive policy attachments from state", d.Id())
+
+	return nil
+}
+
+func resourceUserPolicyAttachmentsExclusiveImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	d.Set("user_name", d.Id())
+
+	return []*schema.ResourceData{d}, nil
+}
@@ -4,60 +4,60 @@
 package imagebuilder
 
 import (
+	"context"
 	"fmt"
 	"log"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/imagebuilder"
 	"github.com/hashicorp/go-multierror"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/sweep"
 )
 
 func init() {
-	resource.AddTestSweepers("aws_imagebuilder_component", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_imagebuilder_component", &sweep.Sweeper{
 		Name: "aws_imagebuilder_component",
 		F:    sweepComponents,
 	})
 
-	resource.AddTestSweepers("aws_imagebuilder_distribution_configuration", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_imagebuilder_distribution_configuration", &sweep.Sweeper{
 		Name: "aws_imagebuilder_distribution_configuration",
 		F:    sweepDistributionConfigurations,
 	})
 
-	resource.AddTestSweepers("aws_imagebuilder_image_pipeline", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_imagebuilder_image_pipeline", &sweep.Sweeper{
 		Name: "aws_imagebuilder_image_pipeline",
 		F:    sweepImagePipelines,
 	})
 
-	resource.AddTestSweepers("aws_imagebuilder_image_recipe", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_imagebuilder_image_recipe", &sweep.Sweeper{
 		Name: "aws_imagebuilder_image_recipe",
 		F:    sweepImageRecipes,
 	})
 
-	resource.AddTestSweepers("aws_imagebuilder_container_recipe", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_imagebuilder_container_recipe", &sweep.Sweeper{
 		Name: "aws_imagebuilder_container_recipe",
 		F:    sweepContainerRecipes,
 	})
 
-	resource.AddTestSweepers("aws_imagebuilder_image", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_imagebuilder_image", &sweep.Sweeper{
 		Name: "aws_imagebuilder_image",
 		F:    sweepImages,
 	})
 
-	resource.AddTestSweepers("aws_imagebuilder_infrastructure_configuration", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_imagebuilder_infrastructure_configuration", &sweep.Sweeper{
 		Name: "aws_imagebuilder_infrastructure_configuration",
 		F:    sweepInfrastructureConfigurations,
 	})
 }
 
-func sweepComponents(region string) error {
+func sweepComponents(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
 	}
-	conn := client.(*conns.AWSClient).ImageBuilderConn
+	conn := client.(*conns.AWSClient).ImageBuilderConn()
 
 	var sweeperErrs *multierror.Error
 
@@ -132,12 +132,12 @@ func sweepComponents(region string) error {
 	return sweeperErrs.ErrorOrNil()
 }
 
-func sweepDistributionConfigurations(region string) error {
+func sweepDistributionConfigurations(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
 	}
-	conn := client.(*conns.AWSClient).ImageBuilderConn
+	conn := client.(*conns.AWSClient).ImageBuilderConn()
 
 	var sweeperErrs *multierror.Error
 
@@ -184,12 +184,12 @@ func sweepDistributionConfigurations(region string) error {
 	return sweeperErrs.ErrorOrNil()
 }
 
-func sweepImagePipelines(region string) error {
+func sweepImagePipelines(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %w", err)
 	}
-	conn := client.(*conns.AWSClient).ImageBuilderConn
+	conn := client.(*conns.AWSClient).ImageBuilderConn()
 
 	var sweeperErrs *multierror.Error
 
@@ -236,12 +236,12 @@ func sweepImagePipelines(region string) error {
 	return sweeperErrs.ErrorOrNil()
 }
 
-func sweepImageRecipes(region string) error {
+func sweepImageRecipes(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %w", err)
 	}
-	conn := client.(*conns.AWSClient).ImageBuilderConn
+	conn := client.(*conns.AWSClient).ImageBuilderConn()
 
 	var sweeperErrs *multierror.Error
 
@@ -290,12 +290,12 @@ func sweepImageRecipes(region string) error {
 	return sweeperErrs.ErrorOrNil()
 }
 
-func sweepContainerRecipes(region string) error {
+func sweepContainerRecipes(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %w", err)
 	}
-	conn := client.(*conns.AWSClient).ImageBuilderConn
+	conn := client.(*conns.AWSClient).ImageBuilderConn()
 
 	var sweeperErrs *multierror.Error
 
@@ -344,14 +344,14 @@ func sweepContainerRecipes(region string) error {
 	return sweeperErrs.ErrorOrNil()
 }
 
-func sweepImages(region string) error {
+func sweepImages(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(region)
 
 	if err != nil {
 		return fmt.Errorf("error getting client: %w", err)
 	}
 
-	conn := client.(*conns.AWSClient).ImageBuilderConn
+	conn := client.(*conns.AWSClient).ImageBuilderConn()
 	sweepResources := make([]sweep.Sweepable, 0)
 	var errs *multierror.Error
 
@@ -412,7 +412,7 @@ func sweepImages(region string) error {
 		errs = multierror.Append(errs, fmt.Errorf("error listing Image Builder Images for %s: %w", region, err))
 	}
 
-	if err := sweep.SweepOrchestrator(sweepResources); err != nil {
+	if err := sweep.SweepOrchestrator(ctx, sweepResources); err != nil {
 		errs = multierror.Append(errs, fmt.Errorf("error sweeping Image Builder Images for %s: %w", region, err))
 	}
 
@@ -424,12 +424,12 @@ func sweepImages(region string) error {
 	return errs.ErrorOrNil()
 }
 
-func sweepInfrastructureConfigurations(region string) error {
+func sweepInfrastructureConfigurations(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
 	}
-	conn := client.(*conns.AWSClient).ImageBuilderConn
+	conn := client.(*conns.AWSClient).ImageBuilderConn()
 
 	var sweeperErrs *multierror.Error
 
@@ -55,7 +55,7 @@ const (
 )
 
 func resourceDelegatedAdminAccountCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	conn := meta.(*conns.AWSClient).Inspector2Conn
+	conn := meta.(*conns.AWSClient).Inspector2Conn()
 
 	in := &inspector2.EnableDelegatedAdminAccountInput{
 		DelegatedAdminAccountId: aws.String(d.Get("account_id").(string)),
@@ -82,7 +82,7 @@ func resourceDelegatedAdminAccountCreate(ctx context.Context, d *schema.Resource
 }
 
 func resourceDelegatedAdminAccountRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	conn := meta.(*conns.AWSClient).Inspector2Conn
+	conn := meta.(*conns.AWSClient).Inspector2Conn()
 
 	st, ai, err := FindDelegatedAdminAccountStatusID(ctx, conn, d.Id())
 
@@ -103,7 +103,7 @@ func resourceDelegatedAdminAccountRead(ctx context.Context, d *schema.ResourceDa
 }
 
 func resourceDelegatedAdminAccountDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	conn := meta.(*conns.AWSClient).Inspector2Conn
+	conn := meta.(*conns.AWSClient).Inspector2Conn()
 
 	log.Printf("[INFO] Deleting Inspector2 DelegatedAdminAccount %s", d.Id())
 
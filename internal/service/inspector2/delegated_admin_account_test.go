@@ -109,7 +109,7 @@ func testAccDelegatedAdminAccount_disappears(t *testing.T) {
 }
 
 func testAccCheckDelegatedAdminAccountDestroy(s *terraform.State) error {
-	conn := acctest.Provider.Meta().(*conns.AWSClient).Inspector2Conn
+	conn := acctest.Provider.Meta().(*conns.AWSClient).Inspector2Conn()
 	ctx := context.Background()
 
 	for _, rs := range s.RootModule().Resources {
@@ -144,7 +144,7 @@ func testAccCheckDelegatedAdminAccountExists(name string) resource.TestCheckFunc
 			return create.Error(names.Inspector2, create.ErrActionCheckingExistence, tfinspector2.ResNameDelegatedAdminAccount, name, errors.New("not set"))
 		}
 
-		conn := acctest.Provider.Meta().(*conns.AWSClient).Inspector2Conn
+		conn := acctest.Provider.Meta().(*conns.AWSClient).Inspector2Conn()
 		ctx := context.Background()
 		_, _, err := tfinspector2.FindDelegatedAdminAccountStatusID(ctx, conn, rs.Primary.ID)
 
@@ -157,7 +157,7 @@ func testAccCheckDelegatedAdminAccountExists(name string) resource.TestCheckFunc
 }
 
 func testAccPreCheck(t *testing.T) {
-	conn := acctest.Provider.Meta().(*conns.AWSClient).Inspector2Conn
+	conn := acctest.Provider.Meta().(*conns.AWSClient).Inspector2Conn()
 	ctx := context.Background()
 
 	_, err := conn.ListDelegatedAdminAccounts(ctx, &inspector2.ListDelegatedAdminAccountsInput{})
@@ -69,7 +69,7 @@ func resourceOrganizationConfigurationCreate(ctx context.Context, d *schema.Reso
 }
 
 func resourceOrganizationConfigurationRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	conn := meta.(*conns.AWSClient).Inspector2Conn
+	conn := meta.(*conns.AWSClient).Inspector2Conn()
 
 	out, err := conn.DescribeOrganizationConfiguration(ctx, &inspector2.DescribeOrganizationConfigurationInput{})
 
@@ -93,7 +93,7 @@ func resourceOrganizationConfigurationRead(ctx context.Context, d *schema.Resour
 }
 
 func resourceOrganizationConfigurationUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	conn := meta.(*conns.AWSClient).Inspector2Conn
+	conn := meta.(*conns.AWSClient).Inspector2Conn()
 
 	update := false
 
@@ -125,7 +125,7 @@ func resourceOrganizationConfigurationUpdate(ctx context.Context, d *schema.Reso
 }
 
 func resourceOrganizationConfigurationDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	conn := meta.(*conns.AWSClient).Inspector2Conn
+	conn := meta.(*conns.AWSClient).Inspector2Conn()
 
 	conns.GlobalMutexKV.Lock(orgConfigMutex)
 	defer conns.GlobalMutexKV.Unlock(orgConfigMutex)
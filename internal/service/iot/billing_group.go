@@ -0,0 +1,323 @@
+package iot
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/iot"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func init() {
+	registerFrameworkResourceFactory(newResourceBillingGroup)
+}
+
+// newResourceBillingGroup instantiates a new Resource for the aws_iot_billing_group resource.
+func newResourceBillingGroup(context.Context) (resource.ResourceWithConfigure, error) {
+	return &resourceBillingGroup{}, nil
+}
+
+type resourceBillingGroup struct {
+	meta *conns.AWSClient
+}
+
+// Metadata should return the full name of the resource, such as
+// examplecloud_thing.
+func (r *resourceBillingGroup) Metadata(_ context.Context, request resource.MetadataRequest, response *resource.MetadataResponse) {
+	response.TypeName = "aws_iot_billing_group"
+}
+
+// GetSchema returns the schema for this resource.
+func (r *resourceBillingGroup) GetSchema(context.Context) (tfsdk.Schema, diag.Diagnostics) {
+	schema := tfsdk.Schema{
+		Attributes: map[string]tfsdk.Attribute{
+			"arn": {
+				Type:     types.StringType,
+				Computed: true,
+				PlanModifiers: tfsdk.AttributePlanModifiers{
+					resource.UseStateForUnknown(),
+				},
+			},
+			"description": {
+				Type:     types.StringType,
+				Optional: true,
+				Validators: []tfsdk.AttributeValidator{
+					stringvalidator.LengthAtMost(2028),
+				},
+			},
+			"id": {
+				Type:     types.StringType,
+				Computed: true,
+				PlanModifiers: tfsdk.AttributePlanModifiers{
+					resource.UseStateForUnknown(),
+				},
+			},
+			"name": {
+				Type:     types.StringType,
+				Required: true,
+				PlanModifiers: tfsdk.AttributePlanModifiers{
+					resource.RequiresReplace(),
+				},
+				Validators: []tfsdk.AttributeValidator{
+					stringvalidator.LengthBetween(1, 128),
+				},
+			},
+			"tags":     tftags.TagsAttribute(),
+			"tags_all": tftags.TagsAttributeTrulyComputed(),
+			"version": {
+				Type:     types.Int64Type,
+				Computed: true,
+			},
+		},
+	}
+
+	return schema, nil
+}
+
+// Configure enables provider-level data or clients to be set in the
+// provider-defined Resource type.
+func (r *resourceBillingGroup) Configure(_ context.Context, request resource.ConfigureRequest, response *resource.ConfigureResponse) {
+	if v, ok := request.ProviderData.(*conns.AWSClient); ok {
+		r.meta = v.ResourceTypeClient("aws_iot_billing_group")
+	}
+}
+
+// Create is called when the provider must create a new resource.
+// Config and planned state values should be read from the CreateRequest and new state values set on the CreateResponse.
+func (r *resourceBillingGroup) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var data resourceBillingGroupData
+
+	response.Diagnostics.Append(request.Plan.Get(ctx, &data)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.meta.IoTConn()
+
+	name := data.Name.Value
+	tags := r.meta.DefaultTagsConfig.MergeTags(tftags.New(data.Tags))
+	input := &iot.CreateBillingGroupInput{
+		BillingGroupName:       aws.String(name),
+		BillingGroupProperties: &iot.BillingGroupProperties{},
+	}
+
+	if !data.Description.IsNull() {
+		input.BillingGroupProperties.BillingGroupDescription = aws.String(data.Description.Value)
+	}
+
+	if len(tags) > 0 {
+		input.Tags = Tags(tags.IgnoreAWS())
+	}
+
+	output, err := conn.CreateBillingGroupWithContext(ctx, input)
+
+	if err != nil {
+		response.Diagnostics.AddError("creating IoT Billing Group", err.Error())
+
+		return
+	}
+
+	data.ARN = types.String{Value: aws.StringValue(output.BillingGroupArn)}
+	data.ID = types.String{Value: name}
+	// "tags_all" is unknown in the plan if any tag value was unknown during planning.
+	data.TagsAll = tags.IgnoreConfig(r.meta.IgnoreTagsConfig).FrameworkMap()
+
+	billingGroup, err := FindBillingGroupByName(ctx, conn, name)
+
+	if err != nil {
+		response.Diagnostics.AddError("reading IoT Billing Group", err.Error())
+
+		return
+	}
+
+	data.Version = types.Int64{Value: aws.Int64Value(billingGroup.Version)}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+// Read is called when the provider must read resource values in order to update state.
+// Planned state values should be read from the ReadRequest and new state values set on the ReadResponse.
+func (r *resourceBillingGroup) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var data resourceBillingGroupData
+
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.meta.IoTConn()
+
+	output, err := FindBillingGroupByName(ctx, conn, data.ID.Value)
+
+	if tfresource.NotFound(err) {
+		response.Diagnostics.Append(errs.NewResourceNotFoundWarningDiagnostic(err))
+		response.State.RemoveResource(ctx)
+
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError("reading IoT Billing Group", err.Error())
+
+		return
+	}
+
+	data.ARN = types.String{Value: aws.StringValue(output.BillingGroupArn)}
+	var description string
+	if v := output.BillingGroupProperties; v != nil {
+		description = aws.StringValue(v.BillingGroupDescription)
+	}
+	// Keep a null "description" value if there is no description, as removing it from the configuration sets an empty one.
+	if description != "" || !data.Description.IsNull() {
+		data.Description = types.String{Value: description}
+	}
+	data.Name = types.String{Value: aws.StringValue(output.BillingGroupName)}
+	data.Version = types.Int64{Value: aws.Int64Value(output.Version)}
+
+	tags, err := ListTagsWithContext(ctx, conn, data.ARN.Value)
+
+	if err != nil {
+		response.Diagnostics.AddError("listing tags for IoT Billing Group", err.Error())
+
+		return
+	}
+
+	tags = tags.IgnoreAWS().IgnoreConfig(r.meta.IgnoreTagsConfig)
+
+	// Keep a null "tags" value if there are no resource tags, so that not configuring tags shows no difference.
+	if resourceTags := tags.RemoveDefaultConfig(r.meta.DefaultTagsConfig); len(resourceTags) > 0 || !data.Tags.IsNull() {
+		data.Tags = resourceTags.FrameworkMap()
+	}
+
+	data.TagsAll = tags.FrameworkMap()
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+// Update is called to update the state of the resource.
+// Config, planned state, and prior state values should be read from the UpdateRequest and new state values set on the UpdateResponse.
+func (r *resourceBillingGroup) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
+	var old, new resourceBillingGroupData
+
+	response.Diagnostics.Append(request.State.Get(ctx, &old)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(request.Plan.Get(ctx, &new)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.meta.IoTConn()
+
+	new.Version = old.Version
+
+	if !new.Description.Equal(old.Description) {
+		input := &iot.UpdateBillingGroupInput{
+			BillingGroupName: aws.String(new.ID.Value),
+			BillingGroupProperties: &iot.BillingGroupProperties{
+				BillingGroupDescription: aws.String(new.Description.Value),
+			},
+			ExpectedVersion: aws.Int64(old.Version.Value),
+		}
+
+		output, err := conn.UpdateBillingGroupWithContext(ctx, input)
+
+		if err != nil {
+			response.Diagnostics.AddError("updating IoT Billing Group", err.Error())
+
+			return
+		}
+
+		new.Version = types.Int64{Value: aws.Int64Value(output.Version)}
+	}
+
+	// "tags_all" is unknown in the plan if any tag value was unknown during planning.
+	new.TagsAll = r.meta.DefaultTagsConfig.MergeTags(tftags.New(new.Tags)).IgnoreConfig(r.meta.IgnoreTagsConfig).FrameworkMap()
+
+	if !new.TagsAll.Equal(old.TagsAll) {
+		if err := UpdateTagsWithContext(ctx, conn, new.ARN.Value, old.TagsAll, new.TagsAll); err != nil {
+			response.Diagnostics.AddError("updating IoT Billing Group tags", err.Error())
+
+			return
+		}
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &new)...)
+}
+
+// Delete is called when the provider must delete the resource.
+// Config values may be read from the DeleteRequest.
+//
+// If execution completes without error, the framework will automatically call DeleteResponse.State.RemoveResource(),
+// so it can be omitted from provider logic.
+func (r *resourceBillingGroup) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	var data resourceBillingGroupData
+
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "deleting IoT Billing Group", map[string]interface{}{
+		"id": data.ID.Value,
+	})
+
+	_, err := r.meta.IoTConn().DeleteBillingGroupWithContext(ctx, &iot.DeleteBillingGroupInput{
+		BillingGroupName: aws.String(data.ID.Value),
+	})
+
+	if err != nil {
+		response.Diagnostics.AddError("deleting IoT Billing Group", err.Error())
+
+		return
+	}
+}
+
+// ImportState is called when the provider must import the state of a resource instance.
+// This method must return enough state so the Read method can properly refresh the full resource.
+//
+// If setting an attribute with the import identifier, it is recommended to use the ImportStatePassthroughID() call in this method.
+func (r *resourceBillingGroup) ImportState(ctx context.Context, request resource.ImportStateRequest, response *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), request, response)
+}
+
+// ModifyPlan is called when the provider has an opportunity to modify
+// the plan: once during the plan phase when Terraform is determining
+// the diff that should be shown to the user for approval, and once
+// during the apply phase with any unknown values from configuration
+// filled in with their final values.
+func (r *resourceBillingGroup) ModifyPlan(ctx context.Context, request resource.ModifyPlanRequest, response *resource.ModifyPlanResponse) {
+	// The provider has not been configured, e.g. while validating the configuration.
+	if r.meta == nil {
+		return
+	}
+
+	tftags.ModifyPlan(ctx, r.meta.DefaultTagsConfig, r.meta.IgnoreTagsConfig, request, response)
+}
+
+type resourceBillingGroupData struct {
+	ARN         types.String `tfsdk:"arn"`
+	Description types.String `tfsdk:"description"`
+	ID          types.String `tfsdk:"id"`
+	Name        types.String `tfsdk:"name"`
+	Tags        types.Map    `tfsdk:"tags"`
+	TagsAll     types.Map    `tfsdk:"tags_all"`
+	Version     types.Int64  `tfsdk:"version"`
+}
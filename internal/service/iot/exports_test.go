@@ -0,0 +1,4 @@
+package iot
+
+// Exports for use in tests only.
+var ResourceBillingGroup = newResourceBillingGroup
@@ -35,6 +35,31 @@ func FindAuthorizerByName(conn *iot.IoT, name string) (*iot.AuthorizerDescriptio
 	return output.AuthorizerDescription, nil
 }
 
+func FindBillingGroupByName(ctx context.Context, conn *iot.IoT, name string) (*iot.DescribeBillingGroupOutput, error) {
+	input := &iot.DescribeBillingGroupInput{
+		BillingGroupName: aws.String(name),
+	}
+
+	output, err := conn.DescribeBillingGroupWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, iot.ErrCodeResourceNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
+
 func FindThingByName(conn *iot.IoT, name string) (*iot.DescribeThingOutput, error) {
 	input := &iot.DescribeThingInput{
 		ThingName: aws.String(name),
@@ -0,0 +1,22 @@
+package iot
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/iot"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex/flextest"
+)
+
+func TestFlattenExpandThingTypeProperties(t *testing.T) {
+	flextest.Run(t, flextest.Case[iot.ThingTypeProperties]{
+		Name:      "ThingTypeProperties",
+		Resource:  ResourceThingType(),
+		Attribute: "properties",
+		Flatten: func(apiObject *iot.ThingTypeProperties) interface{} {
+			return flattenThingTypeProperties(apiObject)
+		},
+		Expand: func(v interface{}) *iot.ThingTypeProperties {
+			return expandThingTypeProperties(v.([]interface{})[0].(map[string]interface{}))
+		},
+	})
+}
@@ -45,7 +45,7 @@ func ResourceRoleAlias() *schema.Resource {
 }
 
 func resourceRoleAliasCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).IoTConn
+	conn := meta.(*conns.AWSClient).IoTConn()
 
 	roleAlias := d.Get("alias").(string)
 	roleArn := d.Get("role_arn").(string)
@@ -82,7 +82,7 @@ func GetRoleAliasDescription(conn *iot.IoT, alias string) (*iot.RoleAliasDescrip
 }
 
 func resourceRoleAliasRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).IoTConn
+	conn := meta.(*conns.AWSClient).IoTConn()
 
 	var roleAliasDescription *iot.RoleAliasDescription
 
@@ -107,7 +107,7 @@ func resourceRoleAliasRead(d *schema.ResourceData, meta interface{}) error {
 }
 
 func resourceRoleAliasDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).IoTConn
+	conn := meta.(*conns.AWSClient).IoTConn()
 
 	alias := d.Get("alias").(string)
 
@@ -123,7 +123,7 @@ func resourceRoleAliasDelete(d *schema.ResourceData, meta interface{}) error {
 }
 
 func resourceRoleAliasUpdate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).IoTConn
+	conn := meta.(*conns.AWSClient).IoTConn()
 
 	if d.HasChange("credential_duration") {
 		roleAliasInput := &iot.UpdateRoleAliasInput{
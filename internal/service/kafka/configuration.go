@@ -8,6 +8,7 @@ import (
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/kafka"
 	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
@@ -16,10 +17,10 @@ import (
 
 func ResourceConfiguration() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceConfigurationCreate,
-		Read:   resourceConfigurationRead,
-		Update: resourceConfigurationUpdate,
-		Delete: resourceConfigurationDelete,
+		Create:               resourceConfigurationCreate,
+		Read:                 resourceConfigurationRead,
+		Update:               resourceConfigurationUpdate,
+		DeleteWithoutTimeout: resourceConfigurationDelete,
 
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
@@ -66,7 +67,7 @@ func ResourceConfiguration() *schema.Resource {
 }
 
 func resourceConfigurationCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).KafkaConn
+	conn := meta.(*conns.AWSClient).KafkaConn()
 
 	input := &kafka.CreateConfigurationInput{
 		Name:             aws.String(d.Get("name").(string)),
@@ -93,7 +94,7 @@ func resourceConfigurationCreate(d *schema.ResourceData, meta interface{}) error
 }
 
 func resourceConfigurationRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).KafkaConn
+	conn := meta.(*conns.AWSClient).KafkaConn()
 
 	configurationInput := &kafka.DescribeConfigurationInput{
 		Arn: aws.String(d.Id()),
@@ -150,7 +151,7 @@ func resourceConfigurationRead(d *schema.ResourceData, meta interface{}) error {
 }
 
 func resourceConfigurationUpdate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).KafkaConn
+	conn := meta.(*conns.AWSClient).KafkaConn()
 
 	input := &kafka.UpdateConfigurationInput{
 		Arn:              aws.String(d.Id()),
@@ -170,21 +171,20 @@ func resourceConfigurationUpdate(d *schema.ResourceData, meta interface{}) error
 	return resourceConfigurationRead(d, meta)
 }
 
-func resourceConfigurationDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).KafkaConn
+func resourceConfigurationDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).KafkaConn()
 
-	input := &kafka.DeleteConfigurationInput{
+	log.Printf("[DEBUG] Deleting MSK Configuration: %s", d.Id())
+	_, err := conn.DeleteConfigurationWithContext(ctx, &kafka.DeleteConfigurationInput{
 		Arn: aws.String(d.Id()),
-	}
-
-	_, err := conn.DeleteConfiguration(input)
+	})
 
 	if err != nil {
-		return fmt.Errorf("error deleting MSK Configuration (%s): %w", d.Id(), err)
+		return diag.Errorf("deleting MSK Configuration (%s): %s", d.Id(), err)
 	}
 
-	if _, err := waitConfigurationDeleted(conn, d.Id()); err != nil {
-		return fmt.Errorf("error waiting for MSK Configuration (%s): %w", d.Id(), err)
+	if _, err := waitConfigurationDeleted(ctx, conn, d.Id(), configurationDeletedTimeout); err != nil {
+		return diag.Errorf("waiting for MSK Configuration (%s) delete: %s", d.Id(), err)
 	}
 
 	return nil
@@ -0,0 +1,54 @@
+// Code generated by "internal/generate/findstatuswait/main.go -Resource=ClusterOperation -FindOp=DescribeClusterOperation -IDField=ClusterOperationArn -IDName=ARN -OutputField=ClusterOperationInfo -NotFoundError=NotFoundException -StatusField=OperationState -Export"; DO NOT EDIT.
+
+package kafka
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/kafka"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func FindClusterOperationByARN(ctx context.Context, conn *kafka.Kafka, arn string) (*kafka.ClusterOperationInfo, error) {
+	input := &kafka.DescribeClusterOperationInput{
+		ClusterOperationArn: aws.String(arn),
+	}
+
+	output, err := conn.DescribeClusterOperationWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, kafka.ErrCodeNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.ClusterOperationInfo == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.ClusterOperationInfo, nil
+}
+
+func statusClusterOperation(ctx context.Context, conn *kafka.Kafka, arn string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := FindClusterOperationByARN(ctx, conn, arn)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, aws.StringValue(output.OperationState), nil
+	}
+}
@@ -0,0 +1,72 @@
+// Code generated by "internal/generate/findstatuswait/main.go -Resource=Configuration -FindOp=DescribeConfiguration -IDField=Arn -IDName=ARN -NotFoundError=BadRequestException -NotFoundMessage=Configuration ARN does not exist -StatusField=State -Waiters=Deleted:DELETING: -Export"; DO NOT EDIT.
+
+package kafka
+
+import (
+	"context"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/kafka"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func FindConfigurationByARN(ctx context.Context, conn *kafka.Kafka, arn string) (*kafka.DescribeConfigurationOutput, error) {
+	input := &kafka.DescribeConfigurationInput{
+		Arn: aws.String(arn),
+	}
+
+	output, err := conn.DescribeConfigurationWithContext(ctx, input)
+
+	if tfawserr.ErrMessageContains(err, kafka.ErrCodeBadRequestException, "Configuration ARN does not exist") {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
+
+func statusConfiguration(ctx context.Context, conn *kafka.Kafka, arn string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := FindConfigurationByARN(ctx, conn, arn)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, aws.StringValue(output.State), nil
+	}
+}
+
+func waitConfigurationDeleted(ctx context.Context, conn *kafka.Kafka, arn string, timeout time.Duration) (*kafka.DescribeConfigurationOutput, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{kafka.ConfigurationStateDeleting},
+		Target:  []string{},
+		Refresh: statusConfiguration(ctx, conn, arn),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*kafka.DescribeConfigurationOutput); ok {
+		return output, err
+	}
+
+	return nil, err
+}
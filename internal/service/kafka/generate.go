@@ -1,4 +1,9 @@
 //go:generate go run ../../generate/tags/main.go -ServiceTagsMap -UpdateTags
+//go:generate go run ../../generate/findstatuswait/main.go -Resource=Cluster -FindOp=DescribeCluster -IDField=ClusterArn -IDName=ARN -OutputField=ClusterInfo -NotFoundError=NotFoundException -Export
+//go:generate go run ../../generate/findstatuswait/main.go -Resource=ClusterV2 -FindOp=DescribeClusterV2 -IDField=ClusterArn -IDName=ARN -OutputField=ClusterInfo -NotFoundError=NotFoundException -StatusField=State
+//go:generate go run ../../generate/findstatuswait/main.go -Resource=ClusterOperation -FindOp=DescribeClusterOperation -IDField=ClusterOperationArn -IDName=ARN -OutputField=ClusterOperationInfo -NotFoundError=NotFoundException -StatusField=OperationState -Export
+//go:generate go run ../../generate/findstatuswait/main.go -Resource=Configuration -FindOp=DescribeConfiguration -IDField=Arn -IDName=ARN -NotFoundError=BadRequestException -NotFoundMessage="Configuration ARN does not exist" -StatusField=State -Waiters=Deleted:DELETING: -Export
+//go:generate go run ../../generate/servicepackagedata/main.go
 // ONLY generate directives and package declaration! Do not add anything else to this file.
 
 package kafka
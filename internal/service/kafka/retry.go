@@ -0,0 +1,14 @@
+package kafka
+
+import (
+	"github.com/aws/aws-sdk-go/service/kafka"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func init() {
+	conns.RegisterRetryRules(names.Kafka, conns.RetryRule{
+		ErrorCode:    kafka.ErrCodeTooManyRequestsException,
+		ErrorMessage: "Too Many Requests",
+	})
+}
@@ -19,7 +19,7 @@ func waitClusterCreated(ctx context.Context, conn *kafka.Kafka, arn string, time
 	stateConf := &resource.StateChangeConf{
 		Pending: []string{kafka.ClusterStateCreating},
 		Target:  []string{kafka.ClusterStateActive},
-		Refresh: statusClusterState(ctx, conn, arn),
+		Refresh: statusClusterV2(ctx, conn, arn),
 		Timeout: timeout,
 	}
 
@@ -40,7 +40,7 @@ func waitClusterDeleted(ctx context.Context, conn *kafka.Kafka, arn string, time
 	stateConf := &resource.StateChangeConf{
 		Pending: []string{kafka.ClusterStateDeleting},
 		Target:  []string{},
-		Refresh: statusClusterState(ctx, conn, arn),
+		Refresh: statusClusterV2(ctx, conn, arn),
 		Timeout: timeout,
 	}
 
@@ -61,7 +61,7 @@ func waitClusterOperationCompleted(ctx context.Context, conn *kafka.Kafka, arn s
 	stateConf := &resource.StateChangeConf{
 		Pending: []string{ClusterOperationStatePending, ClusterOperationStateUpdateInProgress},
 		Target:  []string{ClusterOperationStateUpdateComplete},
-		Refresh: statusClusterOperationState(ctx, conn, arn),
+		Refresh: statusClusterOperation(ctx, conn, arn),
 		Timeout: timeout,
 	}
 
@@ -77,20 +77,3 @@ func waitClusterOperationCompleted(ctx context.Context, conn *kafka.Kafka, arn s
 
 	return nil, err
 }
-
-func waitConfigurationDeleted(conn *kafka.Kafka, arn string) (*kafka.DescribeConfigurationOutput, error) {
-	stateConf := &resource.StateChangeConf{
-		Pending: []string{kafka.ConfigurationStateDeleting},
-		Target:  []string{},
-		Refresh: statusConfigurationState(conn, arn),
-		Timeout: configurationDeletedTimeout,
-	}
-
-	outputRaw, err := stateConf.WaitForState()
-
-	if output, ok := outputRaw.(*kafka.DescribeConfigurationOutput); ok {
-		return output, err
-	}
-
-	return nil, err
-}
@@ -1750,7 +1750,7 @@ func TestAccKendraDataSource_CustomDocumentEnrichmentConfiguration_ExtractionHoo
 }
 
 func testAccCheckDataSourceDestroy(s *terraform.State) error {
-	conn := acctest.Provider.Meta().(*conns.AWSClient).KendraConn
+	conn := acctest.Provider.Meta().(*conns.AWSClient).KendraConn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_kendra_data_source" {
@@ -1792,7 +1792,7 @@ func testAccCheckDataSourceExists(name string) resource.TestCheckFunc {
 			return err
 		}
 
-		conn := acctest.Provider.Meta().(*conns.AWSClient).KendraConn
+		conn := acctest.Provider.Meta().(*conns.AWSClient).KendraConn()
 
 		_, err = tfkendra.FindDataSourceByID(context.Background(), conn, id, indexId)
 
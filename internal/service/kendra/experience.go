@@ -172,7 +172,7 @@ func ResourceExperience() *schema.Resource {
 }
 
 func resourceExperienceCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	conn := meta.(*conns.AWSClient).KendraConn
+	conn := meta.(*conns.AWSClient).KendraConn()
 
 	in := &kendra.CreateExperienceInput{
 		ClientToken: aws.String(resource.UniqueId()),
@@ -211,7 +211,7 @@ func resourceExperienceCreate(ctx context.Context, d *schema.ResourceData, meta
 }
 
 func resourceExperienceRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	conn := meta.(*conns.AWSClient).KendraConn
+	conn := meta.(*conns.AWSClient).KendraConn()
 
 	id, indexId, err := ExperienceParseResourceID(d.Id())
 	if err != nil {
@@ -258,7 +258,7 @@ func resourceExperienceRead(ctx context.Context, d *schema.ResourceData, meta in
 }
 
 func resourceExperienceUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	conn := meta.(*conns.AWSClient).KendraConn
+	conn := meta.(*conns.AWSClient).KendraConn()
 
 	id, indexId, err := ExperienceParseResourceID(d.Id())
 	if err != nil {
@@ -300,7 +300,7 @@ func resourceExperienceUpdate(ctx context.Context, d *schema.ResourceData, meta
 }
 
 func resourceExperienceDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	conn := meta.(*conns.AWSClient).KendraConn
+	conn := meta.(*conns.AWSClient).KendraConn()
 
 	log.Printf("[INFO] Deleting Kendra Experience %s", d.Id())
 
@@ -136,7 +136,7 @@ func DataSourceExperience() *schema.Resource {
 }
 
 func dataSourceExperienceRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	conn := meta.(*conns.AWSClient).KendraConn
+	conn := meta.(*conns.AWSClient).KendraConn()
 
 	experienceID := d.Get("experience_id").(string)
 	indexID := d.Get("index_id").(string)
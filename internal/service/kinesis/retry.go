@@ -0,0 +1,22 @@
+package kinesis
+
+import (
+	"github.com/aws/aws-sdk-go/service/kinesis"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func init() {
+	conns.RegisterRetryRules(names.Kinesis,
+		conns.RetryRule{
+			Operations:   []string{"CreateStream"},
+			ErrorCode:    kinesis.ErrCodeLimitExceededException,
+			ErrorMessage: "simultaneously be in CREATING or DELETING",
+		},
+		conns.RetryRule{
+			Operations:   []string{"CreateStream", "DeleteStream"},
+			ErrorCode:    kinesis.ErrCodeLimitExceededException,
+			ErrorMessage: "Rate exceeded for stream",
+		},
+	)
+}
@@ -63,7 +63,7 @@ const (
 )
 
 func resourceCustomKeyStoreCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	conn := meta.(*conns.AWSClient).KMSConn
+	conn := meta.(*conns.AWSClient).KMSConn()
 
 	in := &kms.CreateCustomKeyStoreInput{
 		CloudHsmClusterId:      aws.String(d.Get("cloud_hsm_cluster_id").(string)),
@@ -87,7 +87,7 @@ func resourceCustomKeyStoreCreate(ctx context.Context, d *schema.ResourceData, m
 }
 
 func resourceCustomKeyStoreRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	conn := meta.(*conns.AWSClient).KMSConn
+	conn := meta.(*conns.AWSClient).KMSConn()
 
 	in := &kms.DescribeCustomKeyStoresInput{
 		CustomKeyStoreId: aws.String(d.Id()),
@@ -112,7 +112,7 @@ func resourceCustomKeyStoreRead(ctx context.Context, d *schema.ResourceData, met
 }
 
 func resourceCustomKeyStoreUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	conn := meta.(*conns.AWSClient).KMSConn
+	conn := meta.(*conns.AWSClient).KMSConn()
 
 	update := false
 
@@ -144,7 +144,7 @@ func resourceCustomKeyStoreUpdate(ctx context.Context, d *schema.ResourceData, m
 }
 
 func resourceCustomKeyStoreDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	conn := meta.(*conns.AWSClient).KMSConn
+	conn := meta.(*conns.AWSClient).KMSConn()
 
 	log.Printf("[INFO] Deleting KMS CustomKeyStore %s", d.Id())
 
@@ -106,7 +106,7 @@ func ResourceDataLakeSettings() *schema.Resource {
 }
 
 func resourceDataLakeSettingsCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).LakeFormationConn
+	conn := meta.(*conns.AWSClient).LakeFormationConn()
 
 	input := &lakeformation.PutDataLakeSettingsInput{}
 
@@ -169,7 +169,7 @@ func resourceDataLakeSettingsCreate(d *schema.ResourceData, meta interface{}) er
 }
 
 func resourceDataLakeSettingsRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).LakeFormationConn
+	conn := meta.(*conns.AWSClient).LakeFormationConn()
 
 	input := &lakeformation.GetDataLakeSettingsInput{}
 
@@ -204,7 +204,7 @@ func resourceDataLakeSettingsRead(d *schema.ResourceData, meta interface{}) erro
 }
 
 func resourceDataLakeSettingsDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).LakeFormationConn
+	conn := meta.(*conns.AWSClient).LakeFormationConn()
 
 	input := &lakeformation.PutDataLakeSettingsInput{
 		DataLakeSettings: &lakeformation.DataLakeSettings{
@@ -113,7 +113,7 @@ func ResourceFunctionURL() *schema.Resource {
 }
 
 func resourceFunctionURLCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	conn := meta.(*conns.AWSClient).LambdaConn
+	conn := meta.(*conns.AWSClient).LambdaConn()
 
 	name := d.Get("function_name").(string)
 	qualifier := d.Get("qualifier").(string)
@@ -169,7 +169,7 @@ func resourceFunctionURLCreate(ctx context.Context, d *schema.ResourceData, meta
 }
 
 func resourceFunctionURLRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	conn := meta.(*conns.AWSClient).LambdaConn
+	conn := meta.(*conns.AWSClient).LambdaConn()
 
 	name, qualifier, err := FunctionURLParseResourceID(d.Id())
 
@@ -218,7 +218,7 @@ func resourceFunctionURLRead(ctx context.Context, d *schema.ResourceData, meta i
 }
 
 func resourceFunctionURLUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	conn := meta.(*conns.AWSClient).LambdaConn
+	conn := meta.(*conns.AWSClient).LambdaConn()
 
 	name, qualifier, err := FunctionURLParseResourceID(d.Id())
 
@@ -255,7 +255,7 @@ func resourceFunctionURLUpdate(ctx context.Context, d *schema.ResourceData, meta
 }
 
 func resourceFunctionURLDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	conn := meta.(*conns.AWSClient).LambdaConn
+	conn := meta.(*conns.AWSClient).LambdaConn()
 
 	name, qualifier, err := FunctionURLParseResourceID(d.Id())
 
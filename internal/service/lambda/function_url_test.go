@@ -222,7 +222,7 @@ func testAccCheckFunctionURLExists(n string, v *lambda.GetFunctionUrlConfigOutpu
 			return err
 		}
 
-		conn := acctest.Provider.Meta().(*conns.AWSClient).LambdaConn
+		conn := acctest.Provider.Meta().(*conns.AWSClient).LambdaConn()
 
 		output, err := tflambda.FindFunctionURLByNameAndQualifier(context.Background(), conn, name, qualifier)
 
@@ -237,7 +237,7 @@ func testAccCheckFunctionURLExists(n string, v *lambda.GetFunctionUrlConfigOutpu
 }
 
 func testAccCheckFunctionURLDestroy(s *terraform.State) error {
-	conn := acctest.Provider.Meta().(*conns.AWSClient).LambdaConn
+	conn := acctest.Provider.Meta().(*conns.AWSClient).LambdaConn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_lambda_function_url" {
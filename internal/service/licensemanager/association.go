@@ -44,7 +44,7 @@ func ResourceAssociation() *schema.Resource {
 }
 
 func resourceAssociationCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	conn := meta.(*conns.AWSClient).LicenseManagerConn
+	conn := meta.(*conns.AWSClient).LicenseManagerConn()
 
 	licenseConfigurationARN := d.Get("license_configuration_arn").(string)
 	resourceARN := d.Get("resource_arn").(string)
@@ -69,7 +69,7 @@ func resourceAssociationCreate(ctx context.Context, d *schema.ResourceData, meta
 }
 
 func resourceAssociationRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	conn := meta.(*conns.AWSClient).LicenseManagerConn
+	conn := meta.(*conns.AWSClient).LicenseManagerConn()
 
 	resourceARN, licenseConfigurationARN, err := AssociationParseResourceID(d.Id())
 
@@ -96,7 +96,7 @@ func resourceAssociationRead(ctx context.Context, d *schema.ResourceData, meta i
 }
 
 func resourceAssociationDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	conn := meta.(*conns.AWSClient).LicenseManagerConn
+	conn := meta.(*conns.AWSClient).LicenseManagerConn()
 
 	resourceARN, licenseConfigurationARN, err := AssociationParseResourceID(d.Id())
 
@@ -193,7 +193,7 @@ func testAccCheckLicenseConfigurationExists(n string, v *licensemanager.GetLicen
 			return fmt.Errorf("No License Manager License Configuration ID is set")
 		}
 
-		conn := acctest.Provider.Meta().(*conns.AWSClient).LicenseManagerConn
+		conn := acctest.Provider.Meta().(*conns.AWSClient).LicenseManagerConn()
 
 		output, err := tflicensemanager.FindLicenseConfigurationByARN(context.Background(), conn, rs.Primary.ID)
 
@@ -208,7 +208,7 @@ func testAccCheckLicenseConfigurationExists(n string, v *licensemanager.GetLicen
 }
 
 func testAccCheckLicenseConfigurationDestroy(s *terraform.State) error {
-	conn := acctest.Provider.Meta().(*conns.AWSClient).LicenseManagerConn
+	conn := acctest.Provider.Meta().(*conns.AWSClient).LicenseManagerConn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_licensemanager_license_configuration" {
@@ -4,29 +4,29 @@
 package licensemanager
 
 import (
+	"context"
 	"fmt"
 	"log"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/licensemanager"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/sweep"
 )
 
 func init() {
-	resource.AddTestSweepers("aws_licensemanager_license_configuration", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_licensemanager_license_configuration", &sweep.Sweeper{
 		Name: "aws_licensemanager_license_configuration",
 		F:    sweepLicenseConfigurations,
 	})
 }
 
-func sweepLicenseConfigurations(region string) error {
+func sweepLicenseConfigurations(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
 	}
-	conn := client.(*conns.AWSClient).LicenseManagerConn
+	conn := client.(*conns.AWSClient).LicenseManagerConn()
 	input := &licensemanager.ListLicenseConfigurationsInput{}
 	sweepResources := make([]sweep.Sweepable, 0)
 
@@ -55,7 +55,7 @@ func sweepLicenseConfigurations(region string) error {
 		return fmt.Errorf("error listing License Manager License Configurations (%s): %w", region, err)
 	}
 
-	err = sweep.SweepOrchestrator(sweepResources)
+	err = sweep.SweepOrchestrator(ctx, sweepResources)
 
 	if err != nil {
 		return fmt.Errorf("error sweeping License Manager License Configurations (%s): %w", region, err)
@@ -68,7 +68,7 @@ func ResourceDomainEntry() *schema.Resource {
 }
 
 func resourceDomainEntryCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	conn := meta.(*conns.AWSClient).LightsailConn
+	conn := meta.(*conns.AWSClient).LightsailConn()
 
 	req := &lightsail.CreateDomainEntryInput{
 		DomainName: aws.String(d.Get("domain_name").(string)),
@@ -108,7 +108,7 @@ func resourceDomainEntryCreate(ctx context.Context, d *schema.ResourceData, meta
 }
 
 func resourceDomainEntryRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	conn := meta.(*conns.AWSClient).LightsailConn
+	conn := meta.(*conns.AWSClient).LightsailConn()
 
 	entry, err := FindDomainEntryById(ctx, conn, d.Id())
 
@@ -134,7 +134,7 @@ func resourceDomainEntryRead(ctx context.Context, d *schema.ResourceData, meta i
 }
 
 func resourceDomainEntryDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	conn := meta.(*conns.AWSClient).LightsailConn
+	conn := meta.(*conns.AWSClient).LightsailConn()
 
 	resp, err := conn.DeleteDomainEntry(&lightsail.DeleteDomainEntryInput{
 		DomainName:  aws.String(expandDomainNameFromId(d.Id())),
@@ -0,0 +1,22 @@
+package lightsail
+
+import (
+	"github.com/aws/aws-sdk-go/service/lightsail"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func init() {
+	conns.RegisterRetryRules(names.Lightsail,
+		conns.RetryRule{
+			Operations:   []string{"CreateContainerService", "UpdateContainerService", "CreateContainerServiceDeployment", "DeleteContainerService"},
+			ErrorCode:    lightsail.ErrCodeInvalidInputException,
+			ErrorMessage: "Please try again in a few minutes",
+		},
+		conns.RetryRule{
+			Operations:   []string{"DeleteContainerService"},
+			ErrorCode:    lightsail.ErrCodeInvalidInputException,
+			ErrorMessage: "Please wait for it to complete before trying again",
+		},
+	)
+}
@@ -147,7 +147,7 @@ func TestAccLocationRouteCalculator_tags(t *testing.T) {
 }
 
 func testAccCheckRouteCalculatorDestroy(s *terraform.State) error {
-	conn := acctest.Provider.Meta().(*conns.AWSClient).LocationConn
+	conn := acctest.Provider.Meta().(*conns.AWSClient).LocationConn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_location_route_calculator" {
@@ -183,7 +183,7 @@ func testAccCheckRouteCalculatorExists(name string) resource.TestCheckFunc {
 			return fmt.Errorf("No Location Service Route Calculator is set")
 		}
 
-		conn := acctest.Provider.Meta().(*conns.AWSClient).LocationConn
+		conn := acctest.Provider.Meta().(*conns.AWSClient).LocationConn()
 		_, err := conn.DescribeRouteCalculator(&locationservice.DescribeRouteCalculatorInput{
 			CalculatorName: aws.String(rs.Primary.ID),
 		})
@@ -50,7 +50,7 @@ func TestAccLogsDestinationPolicy_basic(t *testing.T) {
 }
 
 func testAccCheckDestinationPolicyDestroy(s *terraform.State) error {
-	conn := acctest.Provider.Meta().(*conns.AWSClient).LogsConn
+	conn := acctest.Provider.Meta().(*conns.AWSClient).LogsConn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_cloudwatch_log_destination_policy" {
@@ -77,7 +77,7 @@ func testAccCheckDestinationPolicyExists(n string, d *cloudwatchlogs.Destination
 			return fmt.Errorf("Not found: %s", n)
 		}
 
-		conn := acctest.Provider.Meta().(*conns.AWSClient).LogsConn
+		conn := acctest.Provider.Meta().(*conns.AWSClient).LogsConn()
 		destination, exists, err := tflogs.LookupDestination(conn, rs.Primary.ID, nil)
 		if err != nil {
 			return err
@@ -33,7 +33,7 @@ func DataSourceGroups() *schema.Resource {
 }
 
 func dataSourceGroupsRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).LogsConn
+	conn := meta.(*conns.AWSClient).LogsConn()
 
 	input := &cloudwatchlogs.DescribeLogGroupsInput{}
 
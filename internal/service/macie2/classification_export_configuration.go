@@ -52,7 +52,7 @@ func ResourceClassificationExportConfiguration() *schema.Resource {
 }
 
 func resourceClassificationExportConfigurationCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	conn := meta.(*conns.AWSClient).Macie2Conn
+	conn := meta.(*conns.AWSClient).Macie2Conn()
 
 	if d.IsNewResource() {
 		output, err := conn.GetClassificationExportConfiguration(&macie2.GetClassificationExportConfigurationInput{})
@@ -85,7 +85,7 @@ func resourceClassificationExportConfigurationCreate(ctx context.Context, d *sch
 }
 
 func resourceClassificationExportConfigurationUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	conn := meta.(*conns.AWSClient).Macie2Conn
+	conn := meta.(*conns.AWSClient).Macie2Conn()
 
 	input := macie2.PutClassificationExportConfigurationInput{
 		Configuration: &macie2.ClassificationExportConfiguration{},
@@ -109,7 +109,7 @@ func resourceClassificationExportConfigurationUpdate(ctx context.Context, d *sch
 }
 
 func resourceClassificationExportConfigurationRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	conn := meta.(*conns.AWSClient).Macie2Conn
+	conn := meta.(*conns.AWSClient).Macie2Conn()
 
 	input := macie2.GetClassificationExportConfigurationInput{} // api does not have a getById() like endpoint.
 	output, err := conn.GetClassificationExportConfiguration(&input)
@@ -132,7 +132,7 @@ func resourceClassificationExportConfigurationRead(ctx context.Context, d *schem
 }
 
 func resourceClassificationExportConfigurationDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	conn := meta.(*conns.AWSClient).Macie2Conn
+	conn := meta.(*conns.AWSClient).Macie2Conn()
 
 	input := macie2.PutClassificationExportConfigurationInput{
 		Configuration: &macie2.ClassificationExportConfiguration{},
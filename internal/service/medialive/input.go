@@ -180,7 +180,7 @@ const (
 )
 
 func resourceInputCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	conn := meta.(*conns.AWSClient).MediaLiveConn
+	conn := meta.(*conns.AWSClient).MediaLiveConn()
 
 	in := &medialive.CreateInputInput{
 		RequestId: aws.String(resource.UniqueId()),
@@ -255,7 +255,7 @@ func resourceInputCreate(ctx context.Context, d *schema.ResourceData, meta inter
 }
 
 func resourceInputRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	conn := meta.(*conns.AWSClient).MediaLiveConn
+	conn := meta.(*conns.AWSClient).MediaLiveConn()
 
 	out, err := FindInputByID(ctx, conn, d.Id())
 
@@ -303,7 +303,7 @@ func resourceInputRead(ctx context.Context, d *schema.ResourceData, meta interfa
 }
 
 func resourceInputUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	conn := meta.(*conns.AWSClient).MediaLiveConn
+	conn := meta.(*conns.AWSClient).MediaLiveConn()
 
 	if d.HasChangesExcept("tags", "tags_all") {
 		in := &medialive.UpdateInputInput{
@@ -356,7 +356,7 @@ func resourceInputUpdate(ctx context.Context, d *schema.ResourceData, meta inter
 }
 
 func resourceInputDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	conn := meta.(*conns.AWSClient).MediaLiveConn
+	conn := meta.(*conns.AWSClient).MediaLiveConn()
 
 	log.Printf("[INFO] Deleting MediaLive Input %s", d.Id())
 
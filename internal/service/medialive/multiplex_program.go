@@ -22,8 +22,13 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/names"
 )
 
-func NewResourceMultiplexProgram(_ context.Context) resource.Resource {
-	return &multiplexProgram{}
+func init() {
+	registerFrameworkResourceFactory(newResourceMultiplexProgram)
+}
+
+// newResourceMultiplexProgram instantiates a new Resource for the aws_medialive_multiplex_program resource.
+func newResourceMultiplexProgram(context.Context) (resource.ResourceWithConfigure, error) {
+	return &multiplexProgram{}, nil
 }
 
 const (
@@ -144,7 +149,7 @@ func (m *multiplexProgram) Configure(_ context.Context, request resource.Configu
 }
 
 func (m *multiplexProgram) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
-	conn := m.meta.MediaLiveConn
+	conn := m.meta.MediaLiveConn()
 
 	var plan resourceMultiplexProgramData
 	diags := req.Plan.Get(ctx, &plan)
@@ -189,7 +194,7 @@ func (m *multiplexProgram) Create(ctx context.Context, req resource.CreateReques
 }
 
 func (m *multiplexProgram) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
-	conn := m.meta.MediaLiveConn
+	conn := m.meta.MediaLiveConn()
 
 	var state resourceMultiplexProgramData
 	diags := req.State.Get(ctx, &state)
@@ -251,7 +256,7 @@ func (m *multiplexProgram) Update(ctx context.Context, req resource.UpdateReques
 }
 
 func (m *multiplexProgram) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
-	conn := m.meta.MediaLiveConn
+	conn := m.meta.MediaLiveConn()
 
 	var state resourceMultiplexProgramData
 	diags := req.State.Get(ctx, &state)
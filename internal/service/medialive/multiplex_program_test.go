@@ -99,7 +99,7 @@ func testAccCheckMultiplexProgramDisappears(resourceName string) resource.TestCh
 			return fmt.Errorf("resource ID missing: %s", resourceName)
 		}
 
-		conn := acctest.Provider.Meta().(*conns.AWSClient).MediaLiveConn
+		conn := acctest.Provider.Meta().(*conns.AWSClient).MediaLiveConn()
 		ctx := context.Background()
 		programName, multiplexId, err := tfmedialive.ParseMultiplexProgramID(rs.Primary.ID)
 
@@ -120,7 +120,7 @@ func testAccCheckMultiplexProgramDisappears(resourceName string) resource.TestCh
 	}
 }
 func testAccCheckMultiplexProgramDestroy(s *terraform.State) error {
-	conn := acctest.Provider.Meta().(*conns.AWSClient).MediaLiveConn
+	conn := acctest.Provider.Meta().(*conns.AWSClient).MediaLiveConn()
 	ctx := context.Background()
 
 	for _, rs := range s.RootModule().Resources {
@@ -161,7 +161,7 @@ func testAccCheckMultiplexProgramExists(name string, multiplexprogram *medialive
 			return create.Error(names.MediaLive, create.ErrActionCheckingExistence, tfmedialive.ResNameMultiplexProgram, rs.Primary.ID, err)
 		}
 
-		conn := acctest.Provider.Meta().(*conns.AWSClient).MediaLiveConn
+		conn := acctest.Provider.Meta().(*conns.AWSClient).MediaLiveConn()
 		ctx := context.Background()
 		resp, err := tfmedialive.FindMultipleProgramByID(ctx, conn, multiplexId, programName)
 
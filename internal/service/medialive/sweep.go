@@ -11,13 +11,12 @@ import (
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/medialive"
 	"github.com/hashicorp/go-multierror"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/sweep"
 )
 
 func init() {
-	resource.AddTestSweepers("aws_medialive_input", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_medialive_input", &sweep.Sweeper{
 		Name: "aws_medialive_input",
 		F:    sweepInputs,
 		Dependencies: []string{
@@ -25,25 +24,24 @@ func init() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_medialive_input_security_group", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_medialive_input_security_group", &sweep.Sweeper{
 		Name: "aws_medialive_input_security_group",
 		F:    sweepInputSecurityGroups,
 	})
 
-	resource.AddTestSweepers("aws_medialive_multiplex", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_medialive_multiplex", &sweep.Sweeper{
 		Name: "aws_medialive_multiplex",
 		F:    sweepMultiplexes,
 	})
 }
 
-func sweepInputs(region string) error {
+func sweepInputs(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(region)
 	if err != nil {
-		fmt.Errorf("error getting client: %s", err)
+		return fmt.Errorf("error getting client: %s", err)
 	}
 
-	ctx := context.Background()
-	conn := client.(*conns.AWSClient).MediaLiveConn
+	conn := client.(*conns.AWSClient).MediaLiveConn()
 	sweepResources := make([]sweep.Sweepable, 0)
 	in := &medialive.ListInputsInput{}
 	var errs *multierror.Error
@@ -54,7 +52,7 @@ func sweepInputs(region string) error {
 		page, err := pages.NextPage(ctx)
 
 		if sweep.SkipSweepError(err) {
-			log.Println("[WARN] Skipping MediaLive Inputs sweep for %s: %s", region, err)
+			log.Printf("[WARN] Skipping MediaLive Inputs sweep for %s: %s", region, err)
 			return nil
 		}
 
@@ -74,7 +72,7 @@ func sweepInputs(region string) error {
 		}
 	}
 
-	if err := sweep.SweepOrchestrator(sweepResources); err != nil {
+	if err := sweep.SweepOrchestrator(ctx, sweepResources); err != nil {
 		errs = multierror.Append(errs, fmt.Errorf("error sweeping MediaLive Inputs for %s: %w", region, err))
 	}
 
@@ -86,14 +84,13 @@ func sweepInputs(region string) error {
 	return errs.ErrorOrNil()
 }
 
-func sweepInputSecurityGroups(region string) error {
+func sweepInputSecurityGroups(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(region)
 	if err != nil {
-		fmt.Errorf("error getting client: %s", err)
+		return fmt.Errorf("error getting client: %s", err)
 	}
 
-	ctx := context.Background()
-	conn := client.(*conns.AWSClient).MediaLiveConn
+	conn := client.(*conns.AWSClient).MediaLiveConn()
 	sweepResources := make([]sweep.Sweepable, 0)
 	in := &medialive.ListInputSecurityGroupsInput{}
 	var errs *multierror.Error
@@ -104,7 +101,7 @@ func sweepInputSecurityGroups(region string) error {
 		page, err := pages.NextPage(ctx)
 
 		if sweep.SkipSweepError(err) {
-			log.Println("[WARN] Skipping MediaLive Input Security Groups sweep for %s: %s", region, err)
+			log.Printf("[WARN] Skipping MediaLive Input Security Groups sweep for %s: %s", region, err)
 			return nil
 		}
 
@@ -124,7 +121,7 @@ func sweepInputSecurityGroups(region string) error {
 		}
 	}
 
-	if err := sweep.SweepOrchestrator(sweepResources); err != nil {
+	if err := sweep.SweepOrchestrator(ctx, sweepResources); err != nil {
 		errs = multierror.Append(errs, fmt.Errorf("error sweeping MediaLive Input Security Groups for %s: %w", region, err))
 	}
 
@@ -136,14 +133,13 @@ func sweepInputSecurityGroups(region string) error {
 	return errs.ErrorOrNil()
 }
 
-func sweepMultiplexes(region string) error {
+func sweepMultiplexes(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(region)
 	if err != nil {
-		fmt.Errorf("error getting client: %s", err)
+		return fmt.Errorf("error getting client: %s", err)
 	}
 
-	ctx := context.Background()
-	conn := client.(*conns.AWSClient).MediaLiveConn
+	conn := client.(*conns.AWSClient).MediaLiveConn()
 	sweepResources := make([]sweep.Sweepable, 0)
 	in := &medialive.ListMultiplexesInput{}
 	var errs *multierror.Error
@@ -154,7 +150,7 @@ func sweepMultiplexes(region string) error {
 		page, err := pages.NextPage(ctx)
 
 		if sweep.SkipSweepError(err) {
-			log.Println("[WARN] Skipping MediaLive Multiplexes sweep for %s: %s", region, err)
+			log.Printf("[WARN] Skipping MediaLive Multiplexes sweep for %s: %s", region, err)
 			return nil
 		}
 
@@ -174,7 +170,7 @@ func sweepMultiplexes(region string) error {
 		}
 	}
 
-	if err := sweep.SweepOrchestrator(sweepResources); err != nil {
+	if err := sweep.SweepOrchestrator(ctx, sweepResources); err != nil {
 		errs = multierror.Append(errs, fmt.Errorf("error sweeping MediaLive Multiplexes for %s: %w", region, err))
 	}
 
@@ -42,6 +42,10 @@ func (d *dataSourceDefaultTags) GetSchema(context.Context) (tfsdk.Schema, diag.D
 				Optional: true,
 				Computed: true,
 			},
+			"resource_type": {
+				Type:     types.StringType,
+				Optional: true,
+			},
 			"tags": tftags.TagsAttributeComputed(),
 		},
 	}
@@ -69,7 +73,12 @@ func (d *dataSourceDefaultTags) Read(ctx context.Context, request datasource.Rea
 		return
 	}
 
-	defaultTagsConfig := d.meta.DefaultTagsConfig
+	// Include the tags of any matching resource type rules and substitute dynamic values.
+	defaultTagsConfig := d.meta.DefaultTagsConfig.ForResourceType(data.ResourceType.Value, tftags.DefaultValues{
+		AccountID: d.meta.AccountID,
+		Partition: d.meta.Partition,
+		Region:    d.meta.Region,
+	})
 	ignoreTagsConfig := d.meta.IgnoreTagsConfig
 	tags := defaultTagsConfig.GetTags()
 
@@ -84,6 +93,7 @@ func (d *dataSourceDefaultTags) Read(ctx context.Context, request datasource.Rea
 }
 
 type dataSourceDefaultTagsData struct {
-	ID   types.String `tfsdk:"id"`
-	Tags types.Map    `tfsdk:"tags"`
+	ID           types.String `tfsdk:"id"`
+	ResourceType types.String `tfsdk:"resource_type"`
+	Tags         types.Map    `tfsdk:"tags"`
 }
@@ -87,7 +87,7 @@ func (d *dataSourceRegions) Read(ctx context.Context, request datasource.ReadReq
 		input.AllRegions = aws.Bool(data.AllRegions.Value)
 	}
 
-	output, err := d.meta.EC2Conn.DescribeRegionsWithContext(ctx, input)
+	output, err := d.meta.EC2Conn().DescribeRegionsWithContext(ctx, input)
 
 	if err != nil {
 		response.Diagnostics.AddError("reading Regions", err.Error())
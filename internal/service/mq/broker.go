@@ -355,7 +355,7 @@ func ResourceBroker() *schema.Resource {
 }
 
 func resourceBrokerCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).MQConn
+	conn := meta.(*conns.AWSClient).MQConn()
 	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
 	tags := defaultTagsConfig.MergeTags(tftags.New(d.Get("tags").(map[string]interface{})))
 
@@ -423,7 +423,7 @@ func resourceBrokerCreate(d *schema.ResourceData, meta interface{}) error {
 }
 
 func resourceBrokerRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).MQConn
+	conn := meta.(*conns.AWSClient).MQConn()
 	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
 	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
 
@@ -503,7 +503,7 @@ func resourceBrokerRead(d *schema.ResourceData, meta interface{}) error {
 }
 
 func resourceBrokerUpdate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).MQConn
+	conn := meta.(*conns.AWSClient).MQConn()
 
 	requiresReboot := false
 
@@ -612,7 +612,7 @@ func resourceBrokerUpdate(d *schema.ResourceData, meta interface{}) error {
 }
 
 func resourceBrokerDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).MQConn
+	conn := meta.(*conns.AWSClient).MQConn()
 
 	log.Printf("[INFO] Deleting MQ Broker: %s", d.Id())
 	_, err := conn.DeleteBroker(&mq.DeleteBrokerInput{
@@ -1285,7 +1285,7 @@ func TestAccMQBroker_ldap(t *testing.T) {
 }
 
 func testAccCheckBrokerDestroy(s *terraform.State) error {
-	conn := acctest.Provider.Meta().(*conns.AWSClient).MQConn
+	conn := acctest.Provider.Meta().(*conns.AWSClient).MQConn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_mq_broker" {
@@ -1319,7 +1319,7 @@ func testAccCheckBrokerExists(n string, v *mq.DescribeBrokerResponse) resource.T
 			return fmt.Errorf("No MQ Broker ID is set")
 		}
 
-		conn := acctest.Provider.Meta().(*conns.AWSClient).MQConn
+		conn := acctest.Provider.Meta().(*conns.AWSClient).MQConn()
 
 		output, err := tfmq.FindBrokerByID(conn, rs.Primary.ID)
 
@@ -1334,7 +1334,7 @@ func testAccCheckBrokerExists(n string, v *mq.DescribeBrokerResponse) resource.T
 }
 
 func testAccPreCheck(t *testing.T) {
-	conn := acctest.Provider.Meta().(*conns.AWSClient).MQConn
+	conn := acctest.Provider.Meta().(*conns.AWSClient).MQConn()
 
 	input := &mq.ListBrokersInput{}
 
@@ -12,13 +12,12 @@ import (
 	"github.com/aws/aws-sdk-go/service/networkfirewall"
 	"github.com/hashicorp/go-multierror"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/sweep"
 )
 
 func init() {
-	resource.AddTestSweepers("aws_networkfirewall_firewall_policy", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_networkfirewall_firewall_policy", &sweep.Sweeper{
 		Name: "aws_networkfirewall_firewall_policy",
 		F:    sweepFirewallPolicies,
 		Dependencies: []string{
@@ -26,18 +25,18 @@ func init() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_networkfirewall_firewall", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_networkfirewall_firewall", &sweep.Sweeper{
 		Name:         "aws_networkfirewall_firewall",
 		F:            sweepFirewalls,
 		Dependencies: []string{"aws_networkfirewall_logging_configuration"},
 	})
 
-	resource.AddTestSweepers("aws_networkfirewall_logging_configuration", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_networkfirewall_logging_configuration", &sweep.Sweeper{
 		Name: "aws_networkfirewall_logging_configuration",
 		F:    sweepLoggingConfigurations,
 	})
 
-	resource.AddTestSweepers("aws_networkfirewall_rule_group", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_networkfirewall_rule_group", &sweep.Sweeper{
 		Name: "aws_networkfirewall_rule_group",
 		F:    sweepRuleGroups,
 		Dependencies: []string{
@@ -46,13 +45,12 @@ func init() {
 	})
 }
 
-func sweepFirewallPolicies(region string) error {
+func sweepFirewallPolicies(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %w", err)
 	}
-	conn := client.(*conns.AWSClient).NetworkFirewallConn
-	ctx := context.Background()
+	conn := client.(*conns.AWSClient).NetworkFirewallConn()
 	input := &networkfirewall.ListFirewallPoliciesInput{MaxResults: aws.Int64(100)}
 	var sweeperErrs *multierror.Error
 
@@ -96,13 +94,12 @@ func sweepFirewallPolicies(region string) error {
 	return sweeperErrs.ErrorOrNil()
 }
 
-func sweepFirewalls(region string) error {
+func sweepFirewalls(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
 	}
-	conn := client.(*conns.AWSClient).NetworkFirewallConn
-	ctx := context.TODO()
+	conn := client.(*conns.AWSClient).NetworkFirewallConn()
 	input := &networkfirewall.ListFirewallsInput{MaxResults: aws.Int64(100)}
 	var sweeperErrs *multierror.Error
 
@@ -147,13 +144,12 @@ func sweepFirewalls(region string) error {
 	return sweeperErrs.ErrorOrNil()
 }
 
-func sweepLoggingConfigurations(region string) error {
+func sweepLoggingConfigurations(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
 	}
-	conn := client.(*conns.AWSClient).NetworkFirewallConn
-	ctx := context.TODO()
+	conn := client.(*conns.AWSClient).NetworkFirewallConn()
 	input := &networkfirewall.ListFirewallsInput{MaxResults: aws.Int64(100)}
 	var sweeperErrs *multierror.Error
 
@@ -198,13 +194,12 @@ func sweepLoggingConfigurations(region string) error {
 	return sweeperErrs.ErrorOrNil()
 }
 
-func sweepRuleGroups(region string) error {
+func sweepRuleGroups(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %w", err)
 	}
-	conn := client.(*conns.AWSClient).NetworkFirewallConn
-	ctx := context.Background()
+	conn := client.(*conns.AWSClient).NetworkFirewallConn()
 	input := &networkfirewall.ListRuleGroupsInput{MaxResults: aws.Int64(100)}
 	var sweeperErrs *multierror.Error
 
@@ -80,7 +80,7 @@ func ResourceAttachmentAccepter() *schema.Resource {
 }
 
 func resourceAttachmentAccepterCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	conn := meta.(*conns.AWSClient).NetworkManagerConn
+	conn := meta.(*conns.AWSClient).NetworkManagerConn()
 
 	if attachmentType := d.Get("attachment_type").(string); attachmentType != networkmanager.AttachmentTypeVpc {
 		return diag.Errorf("unsupported Network Manager Attachment type: %s", attachmentType)
@@ -115,7 +115,7 @@ func resourceAttachmentAccepterCreate(ctx context.Context, d *schema.ResourceDat
 }
 
 func resourceAttachmentAccepterRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	conn := meta.(*conns.AWSClient).NetworkManagerConn
+	conn := meta.(*conns.AWSClient).NetworkManagerConn()
 
 	vpcAttachment, err := FindVPCAttachmentByID(ctx, conn, d.Id())
 
@@ -53,7 +53,7 @@ func ResourceLinkAssociation() *schema.Resource {
 }
 
 func resourceLinkAssociationCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	conn := meta.(*conns.AWSClient).NetworkManagerConn
+	conn := meta.(*conns.AWSClient).NetworkManagerConn()
 
 	globalNetworkID := d.Get("global_network_id").(string)
 	linkID := d.Get("link_id").(string)
@@ -82,7 +82,7 @@ func resourceLinkAssociationCreate(ctx context.Context, d *schema.ResourceData,
 }
 
 func resourceLinkAssociationRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	conn := meta.(*conns.AWSClient).NetworkManagerConn
+	conn := meta.(*conns.AWSClient).NetworkManagerConn()
 
 	globalNetworkID, linkID, deviceID, err := LinkAssociationParseResourceID(d.Id())
 
@@ -110,7 +110,7 @@ func resourceLinkAssociationRead(ctx context.Context, d *schema.ResourceData, me
 }
 
 func resourceLinkAssociationDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	conn := meta.(*conns.AWSClient).NetworkManagerConn
+	conn := meta.(*conns.AWSClient).NetworkManagerConn()
 
 	globalNetworkID, linkID, deviceID, err := LinkAssociationParseResourceID(d.Id())
 
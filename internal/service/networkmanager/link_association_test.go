@@ -63,7 +63,7 @@ func TestAccNetworkManagerLinkAssociation_disappears(t *testing.T) {
 }
 
 func testAccCheckLinkAssociationDestroy(s *terraform.State) error {
-	conn := acctest.Provider.Meta().(*conns.AWSClient).NetworkManagerConn
+	conn := acctest.Provider.Meta().(*conns.AWSClient).NetworkManagerConn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_networkmanager_link_association" {
@@ -103,7 +103,7 @@ func testAccCheckLinkAssociationExists(n string) resource.TestCheckFunc {
 			return fmt.Errorf("No Network Manager Link Association ID is set")
 		}
 
-		conn := acctest.Provider.Meta().(*conns.AWSClient).NetworkManagerConn
+		conn := acctest.Provider.Meta().(*conns.AWSClient).NetworkManagerConn()
 
 		globalNetworkID, linkID, deviceID, err := tfnetworkmanager.LinkAssociationParseResourceID(rs.Primary.ID)
 
@@ -151,7 +151,7 @@ func testAccCheckTransitGatewayRouteTableAttachmentExists(n string, v *networkma
 			return fmt.Errorf("No Network Manager Transit Gateway Route Table Attachment ID is set")
 		}
 
-		conn := acctest.Provider.Meta().(*conns.AWSClient).NetworkManagerConn
+		conn := acctest.Provider.Meta().(*conns.AWSClient).NetworkManagerConn()
 
 		output, err := tfnetworkmanager.FindTransitGatewayRouteTableAttachmentByID(context.Background(), conn, rs.Primary.ID)
 
@@ -166,7 +166,7 @@ func testAccCheckTransitGatewayRouteTableAttachmentExists(n string, v *networkma
 }
 
 func testAccCheckTransitGatewayRouteTableAttachmentDestroy(s *terraform.State) error {
-	conn := acctest.Provider.Meta().(*conns.AWSClient).NetworkManagerConn
+	conn := acctest.Provider.Meta().(*conns.AWSClient).NetworkManagerConn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_networkmanager_transit_gateway_route_table_attachment" {
@@ -0,0 +1,81 @@
+package opensearch
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/opensearchservice"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex/flextest"
+)
+
+func TestFlattenExpandCognitoOptions(t *testing.T) {
+	flextest.Run(t, flextest.Case[opensearchservice.CognitoOptions]{
+		Name:      "CognitoOptions",
+		Resource:  ResourceDomain(),
+		Attribute: "cognito_options",
+		Flatten: func(apiObject *opensearchservice.CognitoOptions) interface{} {
+			return flattenCognitoOptions(apiObject)
+		},
+		Expand: func(v interface{}) *opensearchservice.CognitoOptions {
+			return expandCognitoOptions(v.([]interface{}))
+		},
+		RoundTrip: true,
+	})
+}
+
+func TestFlattenExpandDomainEndpointOptions(t *testing.T) {
+	flextest.Run(t, flextest.Case[opensearchservice.DomainEndpointOptions]{
+		Name:      "DomainEndpointOptions",
+		Resource:  ResourceDomain(),
+		Attribute: "domain_endpoint_options",
+		Flatten: func(apiObject *opensearchservice.DomainEndpointOptions) interface{} {
+			return flattenDomainEndpointOptions(apiObject)
+		},
+		Expand: func(v interface{}) *opensearchservice.DomainEndpointOptions {
+			return expandDomainEndpointOptions(v.([]interface{}))
+		},
+		RoundTrip: true,
+	})
+}
+
+func TestFlattenExpandEBSOptions(t *testing.T) {
+	flatten := func(apiObject *opensearchservice.EBSOptions) interface{} {
+		return flattenEBSOptions(apiObject)
+	}
+	expand := func(v interface{}) *opensearchservice.EBSOptions {
+		return expandEBSOptions(v.([]interface{})[0].(map[string]interface{}))
+	}
+
+	flextest.Run(t,
+		flextest.Case[opensearchservice.EBSOptions]{
+			Name:      "EBSOptions",
+			Resource:  ResourceDomain(),
+			Attribute: "ebs_options",
+			Flatten:   flatten,
+			Expand:    expand,
+			RoundTrip: true,
+		},
+		flextest.Case[opensearchservice.EBSOptions]{
+			Name:      "EBSOptionsDisabled",
+			Resource:  ResourceDomain(),
+			Attribute: "ebs_options",
+			Flatten:   flatten,
+			Expand:    expand,
+			RoundTrip: true,
+		},
+	)
+}
+
+func TestFlattenExpandEncryptionAtRestOptions(t *testing.T) {
+	flextest.Run(t, flextest.Case[opensearchservice.EncryptionAtRestOptions]{
+		Name:      "EncryptionAtRestOptions",
+		Resource:  ResourceDomain(),
+		Attribute: "encrypt_at_rest",
+		Flatten: func(apiObject *opensearchservice.EncryptionAtRestOptions) interface{} {
+			return flattenEncryptAtRestOptions(apiObject)
+		},
+		Expand: func(v interface{}) *opensearchservice.EncryptionAtRestOptions {
+			return expandEncryptAtRestOptions(v.([]interface{})[0].(map[string]interface{}))
+		},
+		RoundTrip: true,
+	})
+}
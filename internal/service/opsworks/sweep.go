@@ -4,6 +4,7 @@
 package opsworks
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"log"
@@ -12,13 +13,12 @@ import (
 	"github.com/aws/aws-sdk-go/service/opsworks"
 	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
 	"github.com/hashicorp/go-multierror"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/sweep"
 )
 
 func init() {
-	resource.AddTestSweepers("aws_opsworks_stack", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_opsworks_stack", &sweep.Sweeper{
 		Name: "aws_opsworks_stack",
 		F:    sweepStacks,
 		Dependencies: []string{
@@ -29,18 +29,18 @@ func init() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_opsworks_application", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_opsworks_application", &sweep.Sweeper{
 		Name: "aws_opsworks_application",
 		F:    sweepApplication,
 	})
 
-	resource.AddTestSweepers("aws_opsworks_instance", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_opsworks_instance", &sweep.Sweeper{
 		Name: "aws_opsworks_instance",
 		F:    sweepInstance,
 	})
 
 	// This sweep all the custom, ecs, ganglia, etc. layers
-	resource.AddTestSweepers("aws_opsworks_layer", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_opsworks_layer", &sweep.Sweeper{
 		Name: "aws_opsworks_layer",
 		F:    sweepLayers,
 		Dependencies: []string{
@@ -49,24 +49,24 @@ func init() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_opsworks_rds_db_instance", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_opsworks_rds_db_instance", &sweep.Sweeper{
 		Name: "aws_opsworks_rds_db_instance",
 		F:    sweepRDSDBInstance,
 	})
 
-	resource.AddTestSweepers("aws_opsworks_user_profile", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_opsworks_user_profile", &sweep.Sweeper{
 		Name: "aws_opsworks_user_profile",
 		F:    sweepUserProfiles,
 	})
 }
 
-func sweepApplication(region string) error {
+func sweepApplication(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
 	}
 
-	conn := client.(*conns.AWSClient).OpsWorksConn
+	conn := client.(*conns.AWSClient).OpsWorksConn()
 	sweepResources := make([]sweep.Sweepable, 0)
 
 	output, err := conn.DescribeStacks(&opsworks.DescribeStacksInput{})
@@ -108,16 +108,16 @@ func sweepApplication(region string) error {
 		}
 	}
 
-	return sweep.SweepOrchestrator(sweepResources)
+	return sweep.SweepOrchestrator(ctx, sweepResources)
 }
 
-func sweepInstance(region string) error {
+func sweepInstance(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
 	}
 
-	conn := client.(*conns.AWSClient).OpsWorksConn
+	conn := client.(*conns.AWSClient).OpsWorksConn()
 	sweepResources := make([]sweep.Sweepable, 0)
 
 	output, err := conn.DescribeStacks(&opsworks.DescribeStacksInput{})
@@ -160,16 +160,16 @@ func sweepInstance(region string) error {
 		}
 	}
 
-	return sweep.SweepOrchestrator(sweepResources)
+	return sweep.SweepOrchestrator(ctx, sweepResources)
 }
 
-func sweepRDSDBInstance(region string) error {
+func sweepRDSDBInstance(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
 	}
 
-	conn := client.(*conns.AWSClient).OpsWorksConn
+	conn := client.(*conns.AWSClient).OpsWorksConn()
 	sweepResources := make([]sweep.Sweepable, 0)
 
 	output, err := conn.DescribeStacks(&opsworks.DescribeStacksInput{})
@@ -212,16 +212,16 @@ func sweepRDSDBInstance(region string) error {
 		}
 	}
 
-	return sweep.SweepOrchestrator(sweepResources)
+	return sweep.SweepOrchestrator(ctx, sweepResources)
 }
 
-func sweepStacks(region string) error {
+func sweepStacks(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
 	}
 
-	conn := client.(*conns.AWSClient).OpsWorksConn
+	conn := client.(*conns.AWSClient).OpsWorksConn()
 	sweepResources := make([]sweep.Sweepable, 0)
 
 	output, err := conn.DescribeStacks(&opsworks.DescribeStacksInput{})
@@ -254,16 +254,16 @@ func sweepStacks(region string) error {
 		sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
 	}
 
-	return sweep.SweepOrchestrator(sweepResources)
+	return sweep.SweepOrchestrator(ctx, sweepResources)
 }
 
-func sweepLayers(region string) error {
+func sweepLayers(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
 	}
 
-	conn := client.(*conns.AWSClient).OpsWorksConn
+	conn := client.(*conns.AWSClient).OpsWorksConn()
 	sweepResources := make([]sweep.Sweepable, 0)
 
 	output, err := conn.DescribeStacks(&opsworks.DescribeStacksInput{})
@@ -315,16 +315,16 @@ func sweepLayers(region string) error {
 		}
 	}
 
-	return sweep.SweepOrchestrator(sweepResources)
+	return sweep.SweepOrchestrator(ctx, sweepResources)
 }
 
-func sweepUserProfiles(region string) error {
+func sweepUserProfiles(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
 	}
 
-	conn := client.(*conns.AWSClient).OpsWorksConn
+	conn := client.(*conns.AWSClient).OpsWorksConn()
 	sweepResources := make([]sweep.Sweepable, 0)
 
 	output, err := conn.DescribeUserProfiles(&opsworks.DescribeUserProfilesInput{})
@@ -344,7 +344,7 @@ func sweepUserProfiles(region string) error {
 		sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
 	}
 
-	err = sweep.SweepOrchestrator(sweepResources)
+	err = sweep.SweepOrchestrator(ctx, sweepResources)
 
 	var errs *multierror.Error
 	if errors.As(err, &errs) {
@@ -0,0 +1,16 @@
+package organizations
+
+import (
+	"github.com/aws/aws-sdk-go/service/organizations"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func init() {
+	// Retry on the following error:
+	// ConcurrentModificationException: AWS Organizations can't complete your request because it conflicts with another attempt to modify the same entity. Try again later.
+	conns.RegisterRetryRules(names.Organizations, conns.RetryRule{
+		ErrorCode:    organizations.ErrCodeConcurrentModificationException,
+		ErrorMessage: "Try again later",
+	})
+}
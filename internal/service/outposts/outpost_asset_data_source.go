@@ -45,7 +45,7 @@ func DataSourceOutpostAsset() *schema.Resource {
 }
 
 func DataSourceOutpostAssetRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).OutpostsConn
+	conn := meta.(*conns.AWSClient).OutpostsConn()
 	outpost_id := aws.String(d.Get("arn").(string))
 
 	input := &outposts.ListAssetsInput{
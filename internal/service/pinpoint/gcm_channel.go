@@ -42,7 +42,7 @@ func ResourceGCMChannel() *schema.Resource {
 }
 
 func resourceGCMChannelUpsert(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).PinpointConn
+	conn := meta.(*conns.AWSClient).PinpointConn()
 
 	applicationId := d.Get("application_id").(string)
 
@@ -67,7 +67,7 @@ func resourceGCMChannelUpsert(d *schema.ResourceData, meta interface{}) error {
 }
 
 func resourceGCMChannelRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).PinpointConn
+	conn := meta.(*conns.AWSClient).PinpointConn()
 
 	log.Printf("[INFO] Reading Pinpoint GCM Channel for application %s", d.Id())
 
@@ -92,7 +92,7 @@ func resourceGCMChannelRead(d *schema.ResourceData, meta interface{}) error {
 }
 
 func resourceGCMChannelDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).PinpointConn
+	conn := meta.(*conns.AWSClient).PinpointConn()
 
 	log.Printf("[DEBUG] Deleting Pinpoint GCM Channel for application %s", d.Id())
 	_, err := conn.DeleteGcmChannel(&pinpoint.DeleteGcmChannelInput{
@@ -4,29 +4,29 @@
 package ram
 
 import (
+	"context"
 	"fmt"
 	"log"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/ram"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/sweep"
 )
 
 func init() {
-	resource.AddTestSweepers("aws_ram_resource_share", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_ram_resource_share", &sweep.Sweeper{
 		Name: "aws_ram_resource_share",
 		F:    sweepResourceShares,
 	})
 }
 
-func sweepResourceShares(region string) error {
+func sweepResourceShares(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
 	}
-	conn := client.(*conns.AWSClient).RAMConn
+	conn := client.(*conns.AWSClient).RAMConn()
 	input := &ram.GetResourceSharesInput{
 		ResourceOwner: aws.String(ram.ResourceOwnerSelf),
 	}
@@ -61,7 +61,7 @@ func sweepResourceShares(region string) error {
 		return fmt.Errorf("error listing RAM Resource Shares (%s): %w", region, err)
 	}
 
-	err = sweep.SweepOrchestrator(sweepResources)
+	err = sweep.SweepOrchestrator(ctx, sweepResources)
 
 	if err != nil {
 		return fmt.Errorf("error sweeping RAM Resource Shares (%s): %w", region, err)
@@ -99,7 +99,7 @@ func testAccCheckClusterActivityStreamExistsProvider(resourceName string, dbClus
 			return fmt.Errorf("DBCluster ID is not set")
 		}
 
-		conn := provider.Meta().(*conns.AWSClient).RDSConn
+		conn := provider.Meta().(*conns.AWSClient).RDSConn()
 
 		response, err := tfrds.FindDBClusterWithActivityStream(conn, rs.Primary.ID)
 
@@ -143,7 +143,7 @@ func testAccCheckClusterActivityStreamDestroy(s *terraform.State) error {
 }
 
 func testAccCheckClusterActivityStreamDestroyWithProvider(s *terraform.State, provider *schema.Provider) error {
-	conn := provider.Meta().(*conns.AWSClient).RDSConn
+	conn := provider.Meta().(*conns.AWSClient).RDSConn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_rds_cluster_activity_stream" {
@@ -2130,7 +2130,7 @@ func testAccCheckClusterDestroy(s *terraform.State) error {
 }
 
 func testAccCheckClusterDestroyWithProvider(s *terraform.State, provider *schema.Provider) error {
-	conn := provider.Meta().(*conns.AWSClient).RDSConn
+	conn := provider.Meta().(*conns.AWSClient).RDSConn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_rds_cluster" {
@@ -2154,7 +2154,7 @@ func testAccCheckClusterDestroyWithProvider(s *terraform.State, provider *schema
 }
 
 func testAccCheckClusterDestroyWithFinalSnapshot(s *terraform.State) error {
-	conn := acctest.Provider.Meta().(*conns.AWSClient).RDSConn
+	conn := acctest.Provider.Meta().(*conns.AWSClient).RDSConn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_rds_cluster" {
@@ -2207,7 +2207,7 @@ func testAccCheckClusterExistsWithProvider(n string, v *rds.DBCluster, providerF
 			return fmt.Errorf("No RDS Cluster ID is set")
 		}
 
-		conn := providerF().Meta().(*conns.AWSClient).RDSConn
+		conn := providerF().Meta().(*conns.AWSClient).RDSConn()
 
 		output, err := tfrds.FindDBClusterByID(conn, rs.Primary.ID)
 
@@ -64,7 +64,7 @@ func ResourceInstanceAutomatedBackupsReplication() *schema.Resource {
 }
 
 func resourceInstanceAutomatedBackupsReplicationCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).RDSConn
+	conn := meta.(*conns.AWSClient).RDSConn()
 
 	input := &rds.StartDBInstanceAutomatedBackupsReplicationInput{
 		BackupRetentionPeriod: aws.Int64(int64(d.Get("retention_period").(int))),
@@ -96,7 +96,7 @@ func resourceInstanceAutomatedBackupsReplicationCreate(d *schema.ResourceData, m
 }
 
 func resourceInstanceAutomatedBackupsReplicationRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).RDSConn
+	conn := meta.(*conns.AWSClient).RDSConn()
 
 	backup, err := FindDBInstanceAutomatedBackupByARN(conn, d.Id())
 
@@ -118,7 +118,7 @@ func resourceInstanceAutomatedBackupsReplicationRead(d *schema.ResourceData, met
 }
 
 func resourceInstanceAutomatedBackupsReplicationDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).RDSConn
+	conn := meta.(*conns.AWSClient).RDSConn()
 
 	backup, err := FindDBInstanceAutomatedBackupByARN(conn, d.Id())
 
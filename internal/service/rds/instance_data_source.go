@@ -184,7 +184,7 @@ func DataSourceInstance() *schema.Resource {
 }
 
 func dataSourceInstanceRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).RDSConn
+	conn := meta.(*conns.AWSClient).RDSConn()
 	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
 
 	v, err := FindDBInstanceByID(conn, d.Get("db_instance_identifier").(string))
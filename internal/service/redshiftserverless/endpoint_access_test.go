@@ -102,7 +102,7 @@ func TestAccRedshiftServerlessEndpointAccess_disappears(t *testing.T) {
 }
 
 func testAccCheckEndpointAccessDestroy(s *terraform.State) error {
-	conn := acctest.Provider.Meta().(*conns.AWSClient).RedshiftServerlessConn
+	conn := acctest.Provider.Meta().(*conns.AWSClient).RedshiftServerlessConn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_redshiftserverless_endpoint_access" {
@@ -135,7 +135,7 @@ func testAccCheckEndpointAccessExists(name string) resource.TestCheckFunc {
 			return fmt.Errorf("Redshift Serverless EndpointAccess ID is not set")
 		}
 
-		conn := acctest.Provider.Meta().(*conns.AWSClient).RedshiftServerlessConn
+		conn := acctest.Provider.Meta().(*conns.AWSClient).RedshiftServerlessConn()
 
 		_, err := tfredshiftserverless.FindEndpointAccessByName(conn, rs.Primary.ID)
 
@@ -125,7 +125,7 @@ func TestAccRedshiftServerlessNamespace_disappears(t *testing.T) {
 }
 
 func testAccCheckNamespaceDestroy(s *terraform.State) error {
-	conn := acctest.Provider.Meta().(*conns.AWSClient).RedshiftServerlessConn
+	conn := acctest.Provider.Meta().(*conns.AWSClient).RedshiftServerlessConn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_redshiftserverless_namespace" {
@@ -158,7 +158,7 @@ func testAccCheckNamespaceExists(name string) resource.TestCheckFunc {
 			return fmt.Errorf("Redshift Serverless Namespace is not set")
 		}
 
-		conn := acctest.Provider.Meta().(*conns.AWSClient).RedshiftServerlessConn
+		conn := acctest.Provider.Meta().(*conns.AWSClient).RedshiftServerlessConn()
 
 		_, err := tfredshiftserverless.FindNamespaceByName(conn, rs.Primary.ID)
 
@@ -85,7 +85,7 @@ func ResourceTrustAnchor() *schema.Resource {
 }
 
 func resourceTrustAnchorCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	conn := meta.(*conns.AWSClient).RolesAnywhereConn
+	conn := meta.(*conns.AWSClient).RolesAnywhereConn()
 	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
 	tags := defaultTagsConfig.MergeTags(tftags.New(d.Get("tags").(map[string]interface{})))
 	name := d.Get("name").(string)
@@ -110,7 +110,7 @@ func resourceTrustAnchorCreate(ctx context.Context, d *schema.ResourceData, meta
 }
 
 func resourceTrustAnchorRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	conn := meta.(*conns.AWSClient).RolesAnywhereConn
+	conn := meta.(*conns.AWSClient).RolesAnywhereConn()
 	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
 	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
 
@@ -155,7 +155,7 @@ func resourceTrustAnchorRead(ctx context.Context, d *schema.ResourceData, meta i
 }
 
 func resourceTrustAnchorUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	conn := meta.(*conns.AWSClient).RolesAnywhereConn
+	conn := meta.(*conns.AWSClient).RolesAnywhereConn()
 
 	if d.HasChangesExcept("tags", "tags_all") {
 		input := &rolesanywhere.UpdateTrustAnchorInput{
@@ -196,7 +196,7 @@ func resourceTrustAnchorUpdate(ctx context.Context, d *schema.ResourceData, meta
 }
 
 func resourceTrustAnchorDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	conn := meta.(*conns.AWSClient).RolesAnywhereConn
+	conn := meta.(*conns.AWSClient).RolesAnywhereConn()
 
 	log.Printf("[DEBUG] Deleting RolesAnywhere Trust Anchor (%s)", d.Id())
 	_, err := conn.DeleteTrustAnchor(ctx, &rolesanywhere.DeleteTrustAnchorInput{
@@ -297,7 +297,7 @@ func expandSourceDataCertificateBundle(tfMap map[string]interface{}) *types.Sour
 }
 
 func disableTrustAnchor(ctx context.Context, trustAnchorId string, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).RolesAnywhereConn
+	conn := meta.(*conns.AWSClient).RolesAnywhereConn()
 
 	input := &rolesanywhere.DisableTrustAnchorInput{
 		TrustAnchorId: aws.String(trustAnchorId),
@@ -308,7 +308,7 @@ func disableTrustAnchor(ctx context.Context, trustAnchorId string, meta interfac
 }
 
 func enableTrustAnchor(ctx context.Context, trustAnchorId string, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).RolesAnywhereConn
+	conn := meta.(*conns.AWSClient).RolesAnywhereConn()
 
 	input := &rolesanywhere.EnableTrustAnchorInput{
 		TrustAnchorId: aws.String(trustAnchorId),
@@ -46,7 +46,7 @@ func ResourceDelegationSet() *schema.Resource {
 }
 
 func resourceDelegationSetCreate(d *schema.ResourceData, meta interface{}) error {
-	r53 := meta.(*conns.AWSClient).Route53Conn
+	r53 := meta.(*conns.AWSClient).Route53Conn()
 
 	callerRef := resource.UniqueId()
 	if v, ok := d.GetOk("reference_name"); ok {
@@ -72,7 +72,7 @@ func resourceDelegationSetCreate(d *schema.ResourceData, meta interface{}) error
 }
 
 func resourceDelegationSetRead(d *schema.ResourceData, meta interface{}) error {
-	r53 := meta.(*conns.AWSClient).Route53Conn
+	r53 := meta.(*conns.AWSClient).Route53Conn()
 
 	input := &route53.GetReusableDelegationSetInput{
 		Id: aws.String(CleanDelegationSetID(d.Id())),
@@ -102,7 +102,7 @@ func resourceDelegationSetRead(d *schema.ResourceData, meta interface{}) error {
 }
 
 func resourceDelegationSetDelete(d *schema.ResourceData, meta interface{}) error {
-	r53 := meta.(*conns.AWSClient).Route53Conn
+	r53 := meta.(*conns.AWSClient).Route53Conn()
 
 	input := &route53.DeleteReusableDelegationSetInput{
 		Id: aws.String(CleanDelegationSetID(d.Id())),
@@ -108,7 +108,7 @@ func TestAccRoute53QueryLog_Disappears_hostedZone(t *testing.T) {
 
 func testAccCheckQueryLogExists(pr string, queryLoggingConfig *route53.QueryLoggingConfig) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
-		conn := testAccProviderRoute53QueryLog.Meta().(*conns.AWSClient).Route53Conn
+		conn := testAccProviderRoute53QueryLog.Meta().(*conns.AWSClient).Route53Conn()
 		rs, ok := s.RootModule().Resources[pr]
 		if !ok {
 			return fmt.Errorf("Not found: %s", pr)
@@ -135,7 +135,7 @@ func testAccCheckQueryLogExists(pr string, queryLoggingConfig *route53.QueryLogg
 }
 
 func testAccCheckQueryLogDestroy(s *terraform.State) error {
-	conn := testAccProviderRoute53QueryLog.Meta().(*conns.AWSClient).Route53Conn
+	conn := testAccProviderRoute53QueryLog.Meta().(*conns.AWSClient).Route53Conn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_route53_query_log" {
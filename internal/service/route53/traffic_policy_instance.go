@@ -63,7 +63,7 @@ func ResourceTrafficPolicyInstance() *schema.Resource {
 }
 
 func resourceTrafficPolicyInstanceCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	conn := meta.(*conns.AWSClient).Route53Conn
+	conn := meta.(*conns.AWSClient).Route53Conn()
 
 	name := d.Get("name").(string)
 	input := &route53.CreateTrafficPolicyInstanceInput{
@@ -93,7 +93,7 @@ func resourceTrafficPolicyInstanceCreate(ctx context.Context, d *schema.Resource
 }
 
 func resourceTrafficPolicyInstanceRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	conn := meta.(*conns.AWSClient).Route53Conn
+	conn := meta.(*conns.AWSClient).Route53Conn()
 
 	trafficPolicyInstance, err := FindTrafficPolicyInstanceByID(ctx, conn, d.Id())
 
@@ -117,7 +117,7 @@ func resourceTrafficPolicyInstanceRead(ctx context.Context, d *schema.ResourceDa
 }
 
 func resourceTrafficPolicyInstanceUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	conn := meta.(*conns.AWSClient).Route53Conn
+	conn := meta.(*conns.AWSClient).Route53Conn()
 
 	input := &route53.UpdateTrafficPolicyInstanceInput{
 		Id:                   aws.String(d.Id()),
@@ -141,7 +141,7 @@ func resourceTrafficPolicyInstanceUpdate(ctx context.Context, d *schema.Resource
 }
 
 func resourceTrafficPolicyInstanceDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	conn := meta.(*conns.AWSClient).Route53Conn
+	conn := meta.(*conns.AWSClient).Route53Conn()
 
 	log.Printf("[INFO] Delete Route53 Traffic Policy Instance: %s", d.Id())
 	_, err := conn.DeleteTrafficPolicyInstanceWithContext(ctx, &route53.DeleteTrafficPolicyInstanceInput{
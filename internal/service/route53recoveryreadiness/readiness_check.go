@@ -53,7 +53,7 @@ func ResourceReadinessCheck() *schema.Resource {
 }
 
 func resourceReadinessCheckCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).Route53RecoveryReadinessConn
+	conn := meta.(*conns.AWSClient).Route53RecoveryReadinessConn()
 	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
 	tags := defaultTagsConfig.MergeTags(tftags.New(d.Get("tags").(map[string]interface{})))
 
@@ -62,16 +62,37 @@ func resourceReadinessCheckCreate(d *schema.ResourceData, meta interface{}) erro
 		ResourceSetName:    aws.String(d.Get("resource_set_name").(string)),
 	}
 
+	if len(tags) > 0 {
+		input.Tags = Tags(tags.IgnoreAWS())
+	}
+
 	resp, err := conn.CreateReadinessCheck(input)
+
+	// Some partitions (i.e., ISO) may not support tag-on-create, and the caller may not be authorized to tag on create
+	if input.Tags != nil && verify.ErrorCreateWithTagsDenied(conn.PartitionID, err) {
+		log.Printf("[WARN] failed creating Route53 Recovery Readiness ReadinessCheck with tags: %s. Trying create without tags.", err)
+
+		input.Tags = nil
+		resp, err = conn.CreateReadinessCheck(input)
+	}
+
 	if err != nil {
 		return fmt.Errorf("error creating Route53 Recovery Readiness ReadinessCheck: %w", err)
 	}
 
 	d.SetId(aws.StringValue(resp.ReadinessCheckName))
 
-	if len(tags) > 0 {
-		arn := aws.StringValue(resp.ReadinessCheckArn)
-		if err := UpdateTags(conn, arn, nil, tags); err != nil {
+	// Only post-create tagging supported in some partitions
+	if input.Tags == nil && len(tags) > 0 {
+		err := UpdateTags(conn, aws.StringValue(resp.ReadinessCheckArn), nil, tags)
+
+		if v, ok := d.GetOk("tags"); (!ok || len(v.(map[string]interface{})) == 0) && verify.ErrorISOUnsupported(conn.PartitionID, err) {
+			// if default tags only, log and continue (i.e., should error if explicitly setting tags and they can't be)
+			log.Printf("[WARN] failed adding tags after create for Route53 Recovery Readiness ReadinessCheck (%s): %s", d.Id(), err)
+			return resourceReadinessCheckRead(d, meta)
+		}
+
+		if err != nil {
 			return fmt.Errorf("error adding Route53 Recovery Readiness ReadinessCheck (%s) tags: %w", d.Id(), err)
 		}
 	}
@@ -80,7 +101,7 @@ func resourceReadinessCheckCreate(d *schema.ResourceData, meta interface{}) erro
 }
 
 func resourceReadinessCheckRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).Route53RecoveryReadinessConn
+	conn := meta.(*conns.AWSClient).Route53RecoveryReadinessConn()
 	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
 	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
 
@@ -125,7 +146,7 @@ func resourceReadinessCheckRead(d *schema.ResourceData, meta interface{}) error
 }
 
 func resourceReadinessCheckUpdate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).Route53RecoveryReadinessConn
+	conn := meta.(*conns.AWSClient).Route53RecoveryReadinessConn()
 
 	input := &route53recoveryreadiness.UpdateReadinessCheckInput{
 		ReadinessCheckName: aws.String(d.Get("readiness_check_name").(string)),
@@ -149,7 +170,7 @@ func resourceReadinessCheckUpdate(d *schema.ResourceData, meta interface{}) erro
 }
 
 func resourceReadinessCheckDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).Route53RecoveryReadinessConn
+	conn := meta.(*conns.AWSClient).Route53RecoveryReadinessConn()
 
 	input := &route53recoveryreadiness.DeleteReadinessCheckInput{
 		ReadinessCheckName: aws.String(d.Id()),
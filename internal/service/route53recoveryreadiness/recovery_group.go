@@ -57,7 +57,7 @@ func ResourceRecoveryGroup() *schema.Resource {
 }
 
 func resourceRecoveryGroupCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).Route53RecoveryReadinessConn
+	conn := meta.(*conns.AWSClient).Route53RecoveryReadinessConn()
 	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
 	tags := defaultTagsConfig.MergeTags(tftags.New(d.Get("tags").(map[string]interface{})))
 
@@ -66,16 +66,37 @@ func resourceRecoveryGroupCreate(d *schema.ResourceData, meta interface{}) error
 		Cells:             flex.ExpandStringList(d.Get("cells").([]interface{})),
 	}
 
+	if len(tags) > 0 {
+		input.Tags = Tags(tags.IgnoreAWS())
+	}
+
 	resp, err := conn.CreateRecoveryGroup(input)
+
+	// Some partitions (i.e., ISO) may not support tag-on-create, and the caller may not be authorized to tag on create
+	if input.Tags != nil && verify.ErrorCreateWithTagsDenied(conn.PartitionID, err) {
+		log.Printf("[WARN] failed creating Route53 Recovery Readiness RecoveryGroup with tags: %s. Trying create without tags.", err)
+
+		input.Tags = nil
+		resp, err = conn.CreateRecoveryGroup(input)
+	}
+
 	if err != nil {
 		return fmt.Errorf("error creating Route53 Recovery Readiness RecoveryGroup: %w", err)
 	}
 
 	d.SetId(aws.StringValue(resp.RecoveryGroupName))
 
-	if len(tags) > 0 {
-		arn := aws.StringValue(resp.RecoveryGroupArn)
-		if err := UpdateTags(conn, arn, nil, tags); err != nil {
+	// Only post-create tagging supported in some partitions
+	if input.Tags == nil && len(tags) > 0 {
+		err := UpdateTags(conn, aws.StringValue(resp.RecoveryGroupArn), nil, tags)
+
+		if v, ok := d.GetOk("tags"); (!ok || len(v.(map[string]interface{})) == 0) && verify.ErrorISOUnsupported(conn.PartitionID, err) {
+			// if default tags only, log and continue (i.e., should error if explicitly setting tags and they can't be)
+			log.Printf("[WARN] failed adding tags after create for Route53 Recovery Readiness RecoveryGroup (%s): %s", d.Id(), err)
+			return resourceRecoveryGroupRead(d, meta)
+		}
+
+		if err != nil {
 			return fmt.Errorf("error adding Route53 Recovery Readiness RecoveryGroup (%s) tags: %w", d.Id(), err)
 		}
 	}
@@ -84,7 +105,7 @@ func resourceRecoveryGroupCreate(d *schema.ResourceData, meta interface{}) error
 }
 
 func resourceRecoveryGroupRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).Route53RecoveryReadinessConn
+	conn := meta.(*conns.AWSClient).Route53RecoveryReadinessConn()
 	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
 	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
 
@@ -128,7 +149,7 @@ func resourceRecoveryGroupRead(d *schema.ResourceData, meta interface{}) error {
 }
 
 func resourceRecoveryGroupUpdate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).Route53RecoveryReadinessConn
+	conn := meta.(*conns.AWSClient).Route53RecoveryReadinessConn()
 
 	input := &route53recoveryreadiness.UpdateRecoveryGroupInput{
 		RecoveryGroupName: aws.String(d.Id()),
@@ -153,7 +174,7 @@ func resourceRecoveryGroupUpdate(d *schema.ResourceData, meta interface{}) error
 }
 
 func resourceRecoveryGroupDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).Route53RecoveryReadinessConn
+	conn := meta.(*conns.AWSClient).Route53RecoveryReadinessConn()
 
 	input := &route53recoveryreadiness.DeleteRecoveryGroupInput{
 		RecoveryGroupName: aws.String(d.Id()),
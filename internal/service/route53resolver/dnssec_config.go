@@ -47,7 +47,7 @@ func ResourceDNSSECConfig() *schema.Resource {
 }
 
 func resourceDNSSECConfigCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).Route53ResolverConn
+	conn := meta.(*conns.AWSClient).Route53ResolverConn()
 
 	req := &route53resolver.UpdateResolverDnssecConfigInput{
 		ResourceId: aws.String(d.Get("resource_id").(string)),
@@ -71,7 +71,7 @@ func resourceDNSSECConfigCreate(d *schema.ResourceData, meta interface{}) error
 }
 
 func resourceDNSSECConfigRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).Route53ResolverConn
+	conn := meta.(*conns.AWSClient).Route53ResolverConn()
 
 	config, err := FindResolverDNSSECConfigByID(conn, d.Id())
 
@@ -102,7 +102,7 @@ func resourceDNSSECConfigRead(d *schema.ResourceData, meta interface{}) error {
 }
 
 func resourceDNSSECConfigDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).Route53ResolverConn
+	conn := meta.(*conns.AWSClient).Route53ResolverConn()
 
 	// To delete a Route 53 ResolverDnssecConfig, it must be:
 	// (1) updated to a "DISABLED" state
@@ -69,7 +69,7 @@ func TestAccRoute53ResolverDNSSECConfig_disappear(t *testing.T) {
 }
 
 func testAccCheckDNSSECConfigDestroy(s *terraform.State) error {
-	conn := acctest.Provider.Meta().(*conns.AWSClient).Route53ResolverConn
+	conn := acctest.Provider.Meta().(*conns.AWSClient).Route53ResolverConn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_route53_resolver_dnssec_config" {
@@ -108,7 +108,7 @@ func testAccCheckDNSSECConfigExists(n string) resource.TestCheckFunc {
 		}
 
 		id := rs.Primary.ID
-		conn := acctest.Provider.Meta().(*conns.AWSClient).Route53ResolverConn
+		conn := acctest.Provider.Meta().(*conns.AWSClient).Route53ResolverConn()
 
 		config, err := tfroute53resolver.FindResolverDNSSECConfigByID(conn, id)
 
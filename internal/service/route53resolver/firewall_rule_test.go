@@ -130,7 +130,7 @@ func TestAccRoute53ResolverFirewallRule_disappears(t *testing.T) {
 }
 
 func testAccCheckFirewallRuleDestroy(s *terraform.State) error {
-	conn := acctest.Provider.Meta().(*conns.AWSClient).Route53ResolverConn
+	conn := acctest.Provider.Meta().(*conns.AWSClient).Route53ResolverConn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_route53_resolver_firewall_rule" {
@@ -163,7 +163,7 @@ func testAccCheckFirewallRuleExists(n string, v *route53resolver.FirewallRule) r
 			return fmt.Errorf("No Route 53 Resolver DNS Firewall rule ID is set")
 		}
 
-		conn := acctest.Provider.Meta().(*conns.AWSClient).Route53ResolverConn
+		conn := acctest.Provider.Meta().(*conns.AWSClient).Route53ResolverConn()
 		out, err := tfroute53resolver.FindFirewallRuleByID(conn, rs.Primary.ID)
 		if err != nil {
 			return err
@@ -37,7 +37,7 @@ func ResourceQueryLogConfigAssociation() *schema.Resource {
 }
 
 func resourceQueryLogConfigAssociationCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).Route53ResolverConn
+	conn := meta.(*conns.AWSClient).Route53ResolverConn()
 
 	input := &route53resolver.AssociateResolverQueryLogConfigInput{
 		ResolverQueryLogConfigId: aws.String(d.Get("resolver_query_log_config_id").(string)),
@@ -63,7 +63,7 @@ func resourceQueryLogConfigAssociationCreate(d *schema.ResourceData, meta interf
 }
 
 func resourceQueryLogConfigAssociationRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).Route53ResolverConn
+	conn := meta.(*conns.AWSClient).Route53ResolverConn()
 
 	queryLogConfigAssociation, err := FindResolverQueryLogConfigAssociationByID(conn, d.Id())
 
@@ -90,7 +90,7 @@ func resourceQueryLogConfigAssociationRead(d *schema.ResourceData, meta interfac
 }
 
 func resourceQueryLogConfigAssociationDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).Route53ResolverConn
+	conn := meta.(*conns.AWSClient).Route53ResolverConn()
 
 	log.Printf("[DEBUG] Deleting Route53 Resolver Query Log Config Association (%s)", d.Id())
 	_, err := conn.DisassociateResolverQueryLogConfig(&route53resolver.DisassociateResolverQueryLogConfigInput{
@@ -128,7 +128,7 @@ func TestAccRoute53ResolverQueryLogConfig_tags(t *testing.T) {
 }
 
 func testAccCheckQueryLogConfigDestroy(s *terraform.State) error {
-	conn := acctest.Provider.Meta().(*conns.AWSClient).Route53ResolverConn
+	conn := acctest.Provider.Meta().(*conns.AWSClient).Route53ResolverConn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_route53_resolver_query_log_config" {
@@ -161,7 +161,7 @@ func testAccCheckQueryLogConfigExists(n string, v *route53resolver.ResolverQuery
 			return fmt.Errorf("No Route 53 Resolver Query Log Config ID is set")
 		}
 
-		conn := acctest.Provider.Meta().(*conns.AWSClient).Route53ResolverConn
+		conn := acctest.Provider.Meta().(*conns.AWSClient).Route53ResolverConn()
 		out, err := tfroute53resolver.FindResolverQueryLogConfigByID(conn, rs.Primary.ID)
 		if err != nil {
 			return err
@@ -71,7 +71,7 @@ func DataSourceRules() *schema.Resource {
 }
 
 func dataSourceRulesRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).Route53ResolverConn
+	conn := meta.(*conns.AWSClient).Route53ResolverConn()
 
 	req := &route53resolver.ListResolverRulesInput{}
 	resolverRuleIds := []*string{}
@@ -123,7 +123,7 @@ func ResourceAppMonitor() *schema.Resource {
 }
 
 func resourceAppMonitorCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).RUMConn
+	conn := meta.(*conns.AWSClient).RUMConn()
 	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
 	tags := defaultTagsConfig.MergeTags(tftags.New(d.Get("tags").(map[string]interface{})))
 
@@ -154,7 +154,7 @@ func resourceAppMonitorCreate(d *schema.ResourceData, meta interface{}) error {
 }
 
 func resourceAppMonitorRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).RUMConn
+	conn := meta.(*conns.AWSClient).RUMConn()
 	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
 	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
 
@@ -201,7 +201,7 @@ func resourceAppMonitorRead(d *schema.ResourceData, meta interface{}) error {
 }
 
 func resourceAppMonitorUpdate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).RUMConn
+	conn := meta.(*conns.AWSClient).RUMConn()
 
 	if d.HasChangesExcept("tags", "tags_all") {
 		input := &cloudwatchrum.UpdateAppMonitorInput{
@@ -239,7 +239,7 @@ func resourceAppMonitorUpdate(d *schema.ResourceData, meta interface{}) error {
 }
 
 func resourceAppMonitorDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).RUMConn
+	conn := meta.(*conns.AWSClient).RUMConn()
 
 	input := &cloudwatchrum.DeleteAppMonitorInput{
 		Name: aws.String(d.Id()),
@@ -134,7 +134,7 @@ func TestAccRUMAppMonitor_disappears(t *testing.T) {
 }
 
 func testAccCheckAppMonitorDestroy(s *terraform.State) error {
-	conn := acctest.Provider.Meta().(*conns.AWSClient).RUMConn
+	conn := acctest.Provider.Meta().(*conns.AWSClient).RUMConn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_rum_app_monitor" {
@@ -169,7 +169,7 @@ func testAccCheckAppMonitorExists(n string, appMon *cloudwatchrum.AppMonitor) re
 			return fmt.Errorf("No cloudwatchrum App Monitor ID is set")
 		}
 
-		conn := acctest.Provider.Meta().(*conns.AWSClient).RUMConn
+		conn := acctest.Provider.Meta().(*conns.AWSClient).RUMConn()
 		resp, err := tfcloudwatchrum.FindAppMonitorByName(conn, rs.Primary.ID)
 		if err != nil {
 			return err
@@ -389,7 +389,7 @@ func TestAccS3BucketServerSideEncryptionConfiguration_migrate_withChange(t *test
 }
 
 func testAccCheckBucketServerSideEncryptionConfigurationDestroy(s *terraform.State) error {
-	conn := acctest.Provider.Meta().(*conns.AWSClient).S3Conn
+	conn := acctest.Provider.Meta().(*conns.AWSClient).S3Conn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_s3_bucket_server_side_encryption_configuration" {
@@ -438,7 +438,7 @@ func testAccCheckBucketServerSideEncryptionConfigurationExists(resourceName stri
 			return fmt.Errorf("Resource (%s) ID not set", resourceName)
 		}
 
-		conn := acctest.Provider.Meta().(*conns.AWSClient).S3Conn
+		conn := acctest.Provider.Meta().(*conns.AWSClient).S3Conn()
 
 		bucket, expectedBucketOwner, err := tfs3.ParseResourceID(rs.Primary.ID)
 		if err != nil {
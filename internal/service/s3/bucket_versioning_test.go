@@ -465,7 +465,7 @@ func TestAccS3BucketVersioning_Status_suspendedToDisabled(t *testing.T) {
 }
 
 func testAccCheckBucketVersioningDestroy(s *terraform.State) error {
-	conn := acctest.Provider.Meta().(*conns.AWSClient).S3Conn
+	conn := acctest.Provider.Meta().(*conns.AWSClient).S3Conn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_s3_bucket_versioning" {
@@ -505,7 +505,7 @@ func testAccCheckBucketVersioningExists(resourceName string) resource.TestCheckF
 			return fmt.Errorf("Resource (%s) ID not set", resourceName)
 		}
 
-		conn := acctest.Provider.Meta().(*conns.AWSClient).S3Conn
+		conn := acctest.Provider.Meta().(*conns.AWSClient).S3Conn()
 
 		input := &s3.GetBucketVersioningInput{
 			Bucket: aws.String(rs.Primary.ID),
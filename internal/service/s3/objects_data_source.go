@@ -65,7 +65,7 @@ func DataSourceObjects() *schema.Resource {
 }
 
 func dataSourceObjectsRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).S3Conn
+	conn := meta.(*conns.AWSClient).S3Conn()
 
 	bucket := d.Get("bucket").(string)
 	prefix := d.Get("prefix").(string)
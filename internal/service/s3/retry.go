@@ -0,0 +1,13 @@
+package s3
+
+import (
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func init() {
+	conns.RegisterRetryRules(names.S3, conns.RetryRule{
+		ErrorCode:    ErrCodeOperationAborted,
+		ErrorMessage: "A conflicting conditional operation is currently in progress against this resource. Please try again.",
+	})
+}
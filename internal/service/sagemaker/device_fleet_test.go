@@ -152,7 +152,7 @@ func TestAccSageMakerDeviceFleet_disappears(t *testing.T) {
 }
 
 func testAccCheckDeviceFleetDestroy(s *terraform.State) error {
-	conn := acctest.Provider.Meta().(*conns.AWSClient).SageMakerConn
+	conn := acctest.Provider.Meta().(*conns.AWSClient).SageMakerConn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_sagemaker_device_fleet" {
@@ -187,7 +187,7 @@ func testAccCheckDeviceFleetExists(n string, device_fleet *sagemaker.DescribeDev
 			return fmt.Errorf("No sagmaker Device Fleet ID is set")
 		}
 
-		conn := acctest.Provider.Meta().(*conns.AWSClient).SageMakerConn
+		conn := acctest.Provider.Meta().(*conns.AWSClient).SageMakerConn()
 		resp, err := tfsagemaker.FindDeviceFleetByName(conn, rs.Primary.ID)
 		if err != nil {
 			return err
@@ -386,7 +386,7 @@ func TestAccSageMakerEndpointConfiguration_Async_client(t *testing.T) {
 }
 
 func testAccCheckEndpointConfigurationDestroy(s *terraform.State) error {
-	conn := acctest.Provider.Meta().(*conns.AWSClient).SageMakerConn
+	conn := acctest.Provider.Meta().(*conns.AWSClient).SageMakerConn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_sagemaker_endpoint_configuration" {
@@ -420,7 +420,7 @@ func testAccCheckEndpointConfigurationExists(n string) resource.TestCheckFunc {
 			return fmt.Errorf("no SageMaker endpoint config ID is set")
 		}
 
-		conn := acctest.Provider.Meta().(*conns.AWSClient).SageMakerConn
+		conn := acctest.Provider.Meta().(*conns.AWSClient).SageMakerConn()
 		_, err := tfsagemaker.FindEndpointConfigByName(conn, rs.Primary.ID)
 
 		return err
@@ -4,6 +4,7 @@
 package schemas
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"strings"
@@ -11,29 +12,28 @@ import (
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/schemas"
 	"github.com/hashicorp/go-multierror"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/sweep"
 )
 
 func init() {
-	resource.AddTestSweepers("aws_schemas_discoverer", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_schemas_discoverer", &sweep.Sweeper{
 		Name: "aws_schemas_discoverer",
 		F:    sweepDiscoverers,
 	})
 
-	resource.AddTestSweepers("aws_schemas_registry", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_schemas_registry", &sweep.Sweeper{
 		Name: "aws_schemas_registry",
 		F:    sweepRegistries,
 	})
 }
 
-func sweepDiscoverers(region string) error {
+func sweepDiscoverers(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(region)
 	if err != nil {
 		return fmt.Errorf("Error getting client: %w", err)
 	}
-	conn := client.(*conns.AWSClient).SchemasConn
+	conn := client.(*conns.AWSClient).SchemasConn()
 	input := &schemas.ListDiscoverersInput{}
 	var sweeperErrs *multierror.Error
 
@@ -70,12 +70,12 @@ func sweepDiscoverers(region string) error {
 	return sweeperErrs.ErrorOrNil()
 }
 
-func sweepRegistries(region string) error {
+func sweepRegistries(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(region)
 	if err != nil {
 		return fmt.Errorf("Error getting client: %w", err)
 	}
-	conn := client.(*conns.AWSClient).SchemasConn
+	conn := client.(*conns.AWSClient).SchemasConn()
 	input := &schemas.ListRegistriesInput{}
 	var sweeperErrs *multierror.Error
 
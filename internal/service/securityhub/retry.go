@@ -0,0 +1,15 @@
+package securityhub
+
+import (
+	"github.com/aws/aws-sdk-go/service/securityhub"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func init() {
+	// Reference: https://github.com/hashicorp/terraform-provider-aws/issues/17996
+	conns.RegisterRetryRules(names.SecurityHub, conns.RetryRule{
+		Operations: []string{"EnableOrganizationAdminAccount"},
+		ErrorCode:  securityhub.ErrCodeResourceConflictException,
+	})
+}
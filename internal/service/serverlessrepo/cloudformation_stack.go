@@ -91,7 +91,7 @@ func ResourceCloudFormationStack() *schema.Resource {
 }
 
 func resourceCloudFormationStackCreate(d *schema.ResourceData, meta interface{}) error {
-	cfConn := meta.(*conns.AWSClient).CloudFormationConn
+	cfConn := meta.(*conns.AWSClient).CloudFormationConn()
 
 	changeSet, err := createCloudFormationChangeSet(d, meta.(*conns.AWSClient))
 	if err != nil {
@@ -124,8 +124,8 @@ func resourceCloudFormationStackCreate(d *schema.ResourceData, meta interface{})
 }
 
 func resourceCloudFormationStackRead(d *schema.ResourceData, meta interface{}) error {
-	serverlessConn := meta.(*conns.AWSClient).ServerlessRepoConn
-	cfConn := meta.(*conns.AWSClient).CloudFormationConn
+	serverlessConn := meta.(*conns.AWSClient).ServerlessRepoConn()
+	cfConn := meta.(*conns.AWSClient).CloudFormationConn()
 	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
 	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
 
@@ -219,7 +219,7 @@ func flattenParameterDefinitions(parameterDefinitions []*serverlessrepo.Paramete
 }
 
 func resourceCloudFormationStackUpdate(d *schema.ResourceData, meta interface{}) error {
-	cfConn := meta.(*conns.AWSClient).CloudFormationConn
+	cfConn := meta.(*conns.AWSClient).CloudFormationConn()
 
 	changeSet, err := createCloudFormationChangeSet(d, meta.(*conns.AWSClient))
 	if err != nil {
@@ -250,7 +250,7 @@ func resourceCloudFormationStackUpdate(d *schema.ResourceData, meta interface{})
 }
 
 func resourceCloudFormationStackDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).CloudFormationConn
+	conn := meta.(*conns.AWSClient).CloudFormationConn()
 
 	requestToken := resource.UniqueId()
 	input := &cloudformation.DeleteStackInput{
@@ -286,7 +286,7 @@ func resourceCloudFormationStackImport(d *schema.ResourceData, meta interface{})
 		}
 	}
 
-	cfConn := meta.(*conns.AWSClient).CloudFormationConn
+	cfConn := meta.(*conns.AWSClient).CloudFormationConn()
 	stack, err := tfcloudformation.FindStackByID(cfConn, stackID)
 	if err != nil {
 		return nil, fmt.Errorf("error describing Serverless Application Repository CloudFormation Stack (%s): %w", stackID, err)
@@ -298,8 +298,8 @@ func resourceCloudFormationStackImport(d *schema.ResourceData, meta interface{})
 }
 
 func createCloudFormationChangeSet(d *schema.ResourceData, client *conns.AWSClient) (*cloudformation.DescribeChangeSetOutput, error) {
-	serverlessConn := client.ServerlessRepoConn
-	cfConn := client.CloudFormationConn
+	serverlessConn := client.ServerlessRepoConn()
+	cfConn := client.CloudFormationConn()
 	defaultTagsConfig := client.DefaultTagsConfig
 	tags := defaultTagsConfig.MergeTags(tftags.New(d.Get("tags").(map[string]interface{})))
 
@@ -59,7 +59,7 @@ const (
 )
 
 func dataSourceDedicatedIPPoolRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	conn := meta.(*conns.AWSClient).SESV2Conn
+	conn := meta.(*conns.AWSClient).SESV2Conn()
 	poolName := d.Get("pool_name").(string)
 
 	out, err := FindDedicatedIPPoolByID(ctx, conn, poolName)
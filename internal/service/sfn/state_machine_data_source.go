@@ -44,7 +44,7 @@ func DataSourceStateMachine() *schema.Resource {
 }
 
 func dataSourceStateMachineRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).SFNConn
+	conn := meta.(*conns.AWSClient).SFNConn()
 	params := &sfn.ListStateMachinesInput{}
 	log.Printf("[DEBUG] Reading Step Function State Machine: %s", d.Id())
 
@@ -83,7 +83,7 @@ func (r *resourceDomain) Create(ctx context.Context, request resource.CreateRequ
 		DomainName: aws.String(name),
 	}
 
-	_, err := r.meta.SimpleDBConn.CreateDomainWithContext(ctx, input)
+	_, err := r.meta.SimpleDBConn().CreateDomainWithContext(ctx, input)
 
 	if err != nil {
 		response.Diagnostics.AddError("creating SimpleDB Domain", err.Error())
@@ -107,7 +107,7 @@ func (r *resourceDomain) Read(ctx context.Context, request resource.ReadRequest,
 		return
 	}
 
-	_, err := FindDomainByName(ctx, r.meta.SimpleDBConn, data.ID.Value)
+	_, err := FindDomainByName(ctx, r.meta.SimpleDBConn(), data.ID.Value)
 
 	if tfresource.NotFound(err) {
 		response.Diagnostics.Append(errs.NewResourceNotFoundWarningDiagnostic(err))
@@ -149,7 +149,7 @@ func (r *resourceDomain) Delete(ctx context.Context, request resource.DeleteRequ
 		"id": data.ID.Value,
 	})
 
-	_, err := r.meta.SimpleDBConn.DeleteDomainWithContext(ctx, &simpledb.DeleteDomainInput{
+	_, err := r.meta.SimpleDBConn().DeleteDomainWithContext(ctx, &simpledb.DeleteDomainInput{
 		DomainName: aws.String(data.ID.Value),
 	})
 
@@ -113,7 +113,7 @@ func ResourcePlatformApplication() *schema.Resource {
 }
 
 func resourcePlatformApplicationCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	conn := meta.(*conns.AWSClient).SNSConn
+	conn := meta.(*conns.AWSClient).SNSConn()
 
 	attributes, err := platformApplicationAttributeMap.ResourceDataToAPIAttributesCreate(d)
 
@@ -142,7 +142,7 @@ func resourcePlatformApplicationCreate(ctx context.Context, d *schema.ResourceDa
 }
 
 func resourcePlatformApplicationRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	conn := meta.(*conns.AWSClient).SNSConn
+	conn := meta.(*conns.AWSClient).SNSConn()
 
 	// There is no SNS Describe/GetPlatformApplication to fetch attributes like name and platform
 	// We will use the ID, which should be a platform application ARN, to:
@@ -180,7 +180,7 @@ func resourcePlatformApplicationRead(ctx context.Context, d *schema.ResourceData
 }
 
 func resourcePlatformApplicationUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	conn := meta.(*conns.AWSClient).SNSConn
+	conn := meta.(*conns.AWSClient).SNSConn()
 
 	attributes, err := platformApplicationAttributeMap.ResourceDataToAPIAttributesUpdate(d)
 
@@ -233,7 +233,7 @@ func resourcePlatformApplicationUpdate(ctx context.Context, d *schema.ResourceDa
 }
 
 func resourcePlatformApplicationDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	conn := meta.(*conns.AWSClient).SNSConn
+	conn := meta.(*conns.AWSClient).SNSConn()
 
 	log.Printf("[DEBUG] Deleting SNS Platform Application: %s", d.Id())
 	_, err := conn.DeletePlatformApplicationWithContext(ctx, &sns.DeletePlatformApplicationInput{
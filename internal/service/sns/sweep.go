@@ -4,23 +4,23 @@
 package sns
 
 import (
+	"context"
 	"fmt"
 	"log"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/sns"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/sweep"
 )
 
 func init() {
-	resource.AddTestSweepers("aws_sns_platform_application", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_sns_platform_application", &sweep.Sweeper{
 		Name: "aws_sns_platform_application",
 		F:    sweepPlatformApplications,
 	})
 
-	resource.AddTestSweepers("aws_sns_topic", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_sns_topic", &sweep.Sweeper{
 		Name: "aws_sns_topic",
 		F:    sweepTopics,
 		Dependencies: []string{
@@ -45,19 +45,19 @@ func init() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_sns_topic_subscription", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_sns_topic_subscription", &sweep.Sweeper{
 		Name: "aws_sns_topic_subscription",
 		F:    sweepTopicSubscriptions,
 	})
 }
 
-func sweepPlatformApplications(region string) error {
+func sweepPlatformApplications(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %w", err)
 	}
 	input := &sns.ListPlatformApplicationsInput{}
-	conn := client.(*conns.AWSClient).SNSConn
+	conn := client.(*conns.AWSClient).SNSConn()
 	sweepResources := make([]sweep.Sweepable, 0)
 
 	err = conn.ListPlatformApplicationsPages(input, func(page *sns.ListPlatformApplicationsOutput, lastPage bool) bool {
@@ -85,7 +85,7 @@ func sweepPlatformApplications(region string) error {
 		return fmt.Errorf("error listing SNS Platform Applications: %w", err)
 	}
 
-	err = sweep.SweepOrchestrator(sweepResources)
+	err = sweep.SweepOrchestrator(ctx, sweepResources)
 
 	if err != nil {
 		return fmt.Errorf("error sweeping SNS Platform Applications (%s): %w", region, err)
@@ -94,13 +94,13 @@ func sweepPlatformApplications(region string) error {
 	return nil
 }
 
-func sweepTopics(region string) error {
+func sweepTopics(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %w", err)
 	}
 	input := &sns.ListTopicsInput{}
-	conn := client.(*conns.AWSClient).SNSConn
+	conn := client.(*conns.AWSClient).SNSConn()
 	sweepResources := make([]sweep.Sweepable, 0)
 
 	err = conn.ListTopicsPages(input, func(page *sns.ListTopicsOutput, lastPage bool) bool {
@@ -128,7 +128,7 @@ func sweepTopics(region string) error {
 		return fmt.Errorf("error listing SNS Topics: %w", err)
 	}
 
-	err = sweep.SweepOrchestrator(sweepResources)
+	err = sweep.SweepOrchestrator(ctx, sweepResources)
 
 	if err != nil {
 		return fmt.Errorf("error sweeping SNS Topics (%s): %w", region, err)
@@ -137,13 +137,13 @@ func sweepTopics(region string) error {
 	return nil
 }
 
-func sweepTopicSubscriptions(region string) error {
+func sweepTopicSubscriptions(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %w", err)
 	}
 	input := &sns.ListSubscriptionsInput{}
-	conn := client.(*conns.AWSClient).SNSConn
+	conn := client.(*conns.AWSClient).SNSConn()
 	sweepResources := make([]sweep.Sweepable, 0)
 
 	err = conn.ListSubscriptionsPages(input, func(page *sns.ListSubscriptionsOutput, lastPage bool) bool {
@@ -176,7 +176,7 @@ func sweepTopicSubscriptions(region string) error {
 		return fmt.Errorf("error listing SNS Topic Subscriptions: %w", err)
 	}
 
-	err = sweep.SweepOrchestrator(sweepResources)
+	err = sweep.SweepOrchestrator(ctx, sweepResources)
 
 	if err != nil {
 		return fmt.Errorf("error sweeping SNS Topic Subscriptions (%s): %w", region, err)
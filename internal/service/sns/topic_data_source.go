@@ -30,7 +30,7 @@ func DataSourceTopic() *schema.Resource {
 }
 
 func dataSourceTopicRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	conn := meta.(*conns.AWSClient).SNSConn
+	conn := meta.(*conns.AWSClient).SNSConn()
 
 	resourceArn := ""
 	name := d.Get("name").(string)
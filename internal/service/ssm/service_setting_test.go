@@ -50,7 +50,7 @@ func TestAccSSMServiceSetting_basic(t *testing.T) {
 }
 
 func testAccServiceSettingDestroy(s *terraform.State) error {
-	conn := acctest.Provider.Meta().(*conns.AWSClient).SSMConn
+	conn := acctest.Provider.Meta().(*conns.AWSClient).SSMConn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_ssm_service_setting" {
@@ -88,7 +88,7 @@ func testAccServiceSettingExists(n string, v *ssm.ServiceSetting) resource.TestC
 			return fmt.Errorf("No SSM Service Setting ID is set")
 		}
 
-		conn := acctest.Provider.Meta().(*conns.AWSClient).SSMConn
+		conn := acctest.Provider.Meta().(*conns.AWSClient).SSMConn()
 
 		output, err := tfssm.FindServiceSettingByID(conn, rs.Primary.Attributes["setting_id"])
 
@@ -0,0 +1,15 @@
+package ssoadmin
+
+import (
+	"github.com/aws/aws-sdk-go/service/ssoadmin"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func init() {
+	// Reference: https://github.com/hashicorp/terraform-provider-aws/issues/19215
+	conns.RegisterRetryRules(names.SSOAdmin, conns.RetryRule{
+		Operations: []string{"AttachManagedPolicyToPermissionSet", "DetachManagedPolicyFromPermissionSet"},
+		ErrorCode:  ssoadmin.ErrCodeConflictException,
+	})
+}
@@ -0,0 +1,15 @@
+package storagegateway
+
+import (
+	"github.com/aws/aws-sdk-go/service/storagegateway"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func init() {
+	// InvalidGatewayRequestException: The specified gateway proxy network connection is busy.
+	conns.RegisterRetryRules(names.StorageGateway, conns.RetryRule{
+		ErrorCode:    storagegateway.ErrCodeInvalidGatewayRequestException,
+		ErrorMessage: "The specified gateway proxy network connection is busy",
+	})
+}
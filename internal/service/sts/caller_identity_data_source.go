@@ -79,7 +79,7 @@ func (d *dataSourceCallerIdentity) Read(ctx context.Context, request datasource.
 		return
 	}
 
-	output, err := FindCallerIdentity(ctx, d.meta.STSConn)
+	output, err := FindCallerIdentity(ctx, d.meta.STSConn())
 
 	if err != nil {
 		response.Diagnostics.AddError("reading STS Caller Identity", err.Error())
@@ -12,32 +12,29 @@ import (
 	"github.com/aws/aws-sdk-go/service/timestreamwrite"
 	"github.com/hashicorp/go-multierror"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/sweep"
 )
 
 func init() {
-	resource.AddTestSweepers("aws_timestreamwrite_database", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_timestreamwrite_database", &sweep.Sweeper{
 		Name:         "aws_timestreamwrite_database",
 		F:            sweepDatabases,
 		Dependencies: []string{"aws_timestreamwrite_table"},
 	})
 
-	resource.AddTestSweepers("aws_timestreamwrite_table", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_timestreamwrite_table", &sweep.Sweeper{
 		Name: "aws_timestreamwrite_table",
 		F:    sweepTables,
 	})
 }
 
-func sweepDatabases(region string) error {
+func sweepDatabases(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
 	}
-	conn := client.(*conns.AWSClient).TimestreamWriteConn
-	ctx := context.Background()
-
+	conn := client.(*conns.AWSClient).TimestreamWriteConn()
 	var sweeperErrs *multierror.Error
 
 	input := &timestreamwrite.ListDatabasesInput{}
@@ -87,14 +84,12 @@ func sweepDatabases(region string) error {
 	return sweeperErrs.ErrorOrNil()
 }
 
-func sweepTables(region string) error {
+func sweepTables(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
 	}
-	conn := client.(*conns.AWSClient).TimestreamWriteConn
-	ctx := context.Background()
-
+	conn := client.(*conns.AWSClient).TimestreamWriteConn()
 	var sweeperErrs *multierror.Error
 
 	input := &timestreamwrite.ListTablesInput{}
@@ -73,7 +73,7 @@ func ResourceMedicalVocabulary() *schema.Resource {
 }
 
 func resourceMedicalVocabularyCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	conn := meta.(*conns.AWSClient).TranscribeConn
+	conn := meta.(*conns.AWSClient).TranscribeConn()
 
 	vocabularyName := d.Get("vocabulary_name").(string)
 	in := &transcribe.CreateMedicalVocabularyInput{
@@ -108,7 +108,7 @@ func resourceMedicalVocabularyCreate(ctx context.Context, d *schema.ResourceData
 }
 
 func resourceMedicalVocabularyRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	conn := meta.(*conns.AWSClient).TranscribeConn
+	conn := meta.(*conns.AWSClient).TranscribeConn()
 
 	out, err := FindMedicalVocabularyByName(ctx, conn, d.Id())
 
@@ -157,7 +157,7 @@ func resourceMedicalVocabularyRead(ctx context.Context, d *schema.ResourceData,
 }
 
 func resourceMedicalVocabularyUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	conn := meta.(*conns.AWSClient).TranscribeConn
+	conn := meta.(*conns.AWSClient).TranscribeConn()
 
 	if d.HasChangesExcept("tags", "tags_all") {
 		in := &transcribe.UpdateMedicalVocabularyInput{
@@ -192,7 +192,7 @@ func resourceMedicalVocabularyUpdate(ctx context.Context, d *schema.ResourceData
 }
 
 func resourceMedicalVocabularyDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	conn := meta.(*conns.AWSClient).TranscribeConn
+	conn := meta.(*conns.AWSClient).TranscribeConn()
 
 	log.Printf("[INFO] Deleting Transcribe MedicalVocabulary %s", d.Id())
 
@@ -11,13 +11,12 @@ import (
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/transcribe"
 	"github.com/hashicorp/go-multierror"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/sweep"
 )
 
 func init() {
-	resource.AddTestSweepers("aws_transcribe_language_model", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_transcribe_language_model", &sweep.Sweeper{
 		Name: "aws_transcribe_language_model",
 		F:    sweepLanguageModels,
 		Dependencies: []string{
@@ -26,7 +25,7 @@ func init() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_transcribe_medical_vocabulary", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_transcribe_medical_vocabulary", &sweep.Sweeper{
 		Name: "aws_transcribe_medical_vocabulary",
 		F:    sweepMedicalVocabularies,
 		Dependencies: []string{
@@ -34,7 +33,7 @@ func init() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_transcribe_vocabulary", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_transcribe_vocabulary", &sweep.Sweeper{
 		Name: "aws_transcribe_vocabulary",
 		F:    sweepVocabularies,
 		Dependencies: []string{
@@ -42,7 +41,7 @@ func init() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_transcribe_vocabulary_filter", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_transcribe_vocabulary_filter", &sweep.Sweeper{
 		Name: "aws_transcribe_vocabulary_filter",
 		F:    sweepVocabularyFilters,
 		Dependencies: []string{
@@ -51,14 +50,13 @@ func init() {
 	})
 }
 
-func sweepLanguageModels(region string) error {
+func sweepLanguageModels(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(region)
 	if err != nil {
-		fmt.Errorf("error getting client: %s", err)
+		return fmt.Errorf("error getting client: %s", err)
 	}
 
-	ctx := context.Background()
-	conn := client.(*conns.AWSClient).TranscribeConn
+	conn := client.(*conns.AWSClient).TranscribeConn()
 	sweepResources := make([]sweep.Sweepable, 0)
 	in := &transcribe.ListLanguageModelsInput{}
 	var errs *multierror.Error
@@ -69,7 +67,7 @@ func sweepLanguageModels(region string) error {
 		page, err := pages.NextPage(ctx)
 
 		if sweep.SkipSweepError(err) {
-			log.Println("[WARN] Skipping Transcribe Language Models sweep for %s: %s", region, err)
+			log.Printf("[WARN] Skipping Transcribe Language Models sweep for %s: %s", region, err)
 			return nil
 		}
 
@@ -89,7 +87,7 @@ func sweepLanguageModels(region string) error {
 		}
 	}
 
-	if err := sweep.SweepOrchestrator(sweepResources); err != nil {
+	if err := sweep.SweepOrchestrator(ctx, sweepResources); err != nil {
 		errs = multierror.Append(errs, fmt.Errorf("error sweeping Transcribe Language Models for %s: %w", region, err))
 	}
 
@@ -101,14 +99,13 @@ func sweepLanguageModels(region string) error {
 	return errs.ErrorOrNil()
 }
 
-func sweepMedicalVocabularies(region string) error {
+func sweepMedicalVocabularies(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(region)
 	if err != nil {
-		fmt.Errorf("error getting client: %s", err)
+		return fmt.Errorf("error getting client: %s", err)
 	}
 
-	ctx := context.Background()
-	conn := client.(*conns.AWSClient).TranscribeConn
+	conn := client.(*conns.AWSClient).TranscribeConn()
 	sweepResources := make([]sweep.Sweepable, 0)
 	in := &transcribe.ListMedicalVocabulariesInput{}
 	var errs *multierror.Error
@@ -116,7 +113,7 @@ func sweepMedicalVocabularies(region string) error {
 	for {
 		out, err := conn.ListMedicalVocabularies(ctx, in)
 		if sweep.SkipSweepError(err) {
-			log.Println("[WARN] Skipping Transcribe Medical Vocabularies sweep for %s: %s", region, err)
+			log.Printf("[WARN] Skipping Transcribe Medical Vocabularies sweep for %s: %s", region, err)
 			return nil
 		}
 		if err != nil {
@@ -140,7 +137,7 @@ func sweepMedicalVocabularies(region string) error {
 		in.NextToken = out.NextToken
 	}
 
-	if err := sweep.SweepOrchestrator(sweepResources); err != nil {
+	if err := sweep.SweepOrchestrator(ctx, sweepResources); err != nil {
 		errs = multierror.Append(errs, fmt.Errorf("error sweeping Transcribe Medical Vocabularies for %s: %w", region, err))
 	}
 
@@ -152,14 +149,13 @@ func sweepMedicalVocabularies(region string) error {
 	return errs.ErrorOrNil()
 }
 
-func sweepVocabularies(region string) error {
+func sweepVocabularies(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(region)
 	if err != nil {
-		fmt.Errorf("error getting client: %s", err)
+		return fmt.Errorf("error getting client: %s", err)
 	}
 
-	ctx := context.Background()
-	conn := client.(*conns.AWSClient).TranscribeConn
+	conn := client.(*conns.AWSClient).TranscribeConn()
 	sweepResources := make([]sweep.Sweepable, 0)
 	in := &transcribe.ListVocabulariesInput{}
 	var errs *multierror.Error
@@ -167,7 +163,7 @@ func sweepVocabularies(region string) error {
 	for {
 		out, err := conn.ListVocabularies(ctx, in)
 		if sweep.SkipSweepError(err) {
-			log.Println("[WARN] Skipping Transcribe Vocabularies sweep for %s: %s", region, err)
+			log.Printf("[WARN] Skipping Transcribe Vocabularies sweep for %s: %s", region, err)
 			return nil
 		}
 		if err != nil {
@@ -191,7 +187,7 @@ func sweepVocabularies(region string) error {
 		in.NextToken = out.NextToken
 	}
 
-	if err := sweep.SweepOrchestrator(sweepResources); err != nil {
+	if err := sweep.SweepOrchestrator(ctx, sweepResources); err != nil {
 		errs = multierror.Append(errs, fmt.Errorf("error sweeping Transcribe Vocabularies for %s: %w", region, err))
 	}
 
@@ -203,14 +199,13 @@ func sweepVocabularies(region string) error {
 	return errs.ErrorOrNil()
 }
 
-func sweepVocabularyFilters(region string) error {
+func sweepVocabularyFilters(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(region)
 	if err != nil {
-		fmt.Errorf("error getting client: %s", err)
+		return fmt.Errorf("error getting client: %s", err)
 	}
 
-	ctx := context.Background()
-	conn := client.(*conns.AWSClient).TranscribeConn
+	conn := client.(*conns.AWSClient).TranscribeConn()
 	sweepResources := make([]sweep.Sweepable, 0)
 	in := &transcribe.ListVocabularyFiltersInput{}
 	var errs *multierror.Error
@@ -218,7 +213,7 @@ func sweepVocabularyFilters(region string) error {
 	for {
 		out, err := conn.ListVocabularyFilters(ctx, in)
 		if sweep.SkipSweepError(err) {
-			log.Println("[WARN] Skipping Transcribe Vocabulary Filter sweep for %s: %s", region, err)
+			log.Printf("[WARN] Skipping Transcribe Vocabulary Filter sweep for %s: %s", region, err)
 			return nil
 		}
 		if err != nil {
@@ -243,7 +238,7 @@ func sweepVocabularyFilters(region string) error {
 		in.NextToken = out.NextToken
 	}
 
-	if err := sweep.SweepOrchestrator(sweepResources); err != nil {
+	if err := sweep.SweepOrchestrator(ctx, sweepResources); err != nil {
 		errs = multierror.Append(errs, fmt.Errorf("error sweeping Transcribe Vocabulary Filters for %s: %w", region, err))
 	}
 
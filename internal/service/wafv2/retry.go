@@ -0,0 +1,31 @@
+package wafv2
+
+import (
+	"github.com/aws/aws-sdk-go/service/wafv2"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func init() {
+	conns.RegisterRetryRules(names.WAFV2,
+		conns.RetryRule{
+			ErrorCode:    wafv2.ErrCodeWAFInternalErrorException,
+			ErrorMessage: "Retry your request",
+		},
+		conns.RetryRule{
+			ErrorCode:    wafv2.ErrCodeWAFServiceLinkedRoleErrorException,
+			ErrorMessage: "Retry",
+		},
+		// WAFv2 supports tag on create which can result in the below error codes according to the documentation
+		conns.RetryRule{
+			Operations:   []string{"CreateIPSet", "CreateRegexPatternSet", "CreateRuleGroup", "CreateWebACL"},
+			ErrorCode:    wafv2.ErrCodeWAFTagOperationException,
+			ErrorMessage: "Retry your request",
+		},
+		conns.RetryRule{
+			Operations:   []string{"CreateIPSet", "CreateRegexPatternSet", "CreateRuleGroup", "CreateWebACL"},
+			ErrorCode:    wafv2.ErrCodeWAFTagOperationInternalErrorException,
+			ErrorMessage: "Retry your request",
+		},
+	)
+}
@@ -50,7 +50,7 @@ func ResourceWebACLAssociation() *schema.Resource {
 }
 
 func resourceWebACLAssociationCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	conn := meta.(*conns.AWSClient).WAFV2Conn
+	conn := meta.(*conns.AWSClient).WAFV2Conn()
 
 	webACLARN := d.Get("web_acl_arn").(string)
 	resourceARN := d.Get("resource_arn").(string)
@@ -75,7 +75,7 @@ func resourceWebACLAssociationCreate(ctx context.Context, d *schema.ResourceData
 }
 
 func resourceWebACLAssociationRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	conn := meta.(*conns.AWSClient).WAFV2Conn
+	conn := meta.(*conns.AWSClient).WAFV2Conn()
 
 	_, resourceARN, err := WebACLAssociationParseResourceID(d.Id())
 
@@ -102,7 +102,7 @@ func resourceWebACLAssociationRead(ctx context.Context, d *schema.ResourceData,
 }
 
 func resourceWebACLAssociationDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	conn := meta.(*conns.AWSClient).WAFV2Conn
+	conn := meta.(*conns.AWSClient).WAFV2Conn()
 
 	_, resourceARN, err := WebACLAssociationParseResourceID(d.Id())
 
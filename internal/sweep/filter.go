@@ -0,0 +1,318 @@
+//go:build sweep
+// +build sweep
+
+package sweep
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	fwresource "github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+)
+
+// Filter constrains the resources deleted by sweepers.
+// The zero value sweeps every resource listed by a sweeper.
+type Filter struct {
+	// DryRun logs the resources that would be swept without deleting them.
+	DryRun bool
+
+	// NamePrefixes, if set, restricts sweeping to resources whose name (or ID, if the resource has no name) starts with one of the prefixes.
+	NamePrefixes []string
+
+	// Tags, if set, restricts sweeping to resources with all of the tags.
+	// An empty value matches any value for the tag key.
+	Tags map[string]string
+
+	// MinAge, if set, restricts sweeping to resources created at least this long ago.
+	MinAge time.Duration
+}
+
+// creationTimeAttributeNames are the names of attributes that commonly hold a resource's creation time.
+var creationTimeAttributeNames = []string{
+	"created_at",
+	"created_date",
+	"created_time",
+	"creation_date",
+	"creation_time",
+	"create_date",
+	"create_time",
+	"launch_time",
+}
+
+// activeFilter is the filter applied to all sweepable resources.
+var activeFilter Filter
+
+// errFiltered is returned when deleting a sweepable resource that doesn't match the active filter.
+// Filtered resources are neither deleted nor counted as swept.
+var errFiltered = errors.New("resource does not match sweeper filter")
+
+// ParseFilterTags parses a comma-separated list of `key=value` (or `key`) tag filters.
+func ParseFilterTags(s string) (map[string]string, error) {
+	if s == "" {
+		return nil, nil
+	}
+
+	tags := make(map[string]string)
+
+	for _, v := range strings.Split(s, ",") {
+		k, v, _ := strings.Cut(strings.TrimSpace(v), "=")
+
+		if k == "" {
+			return nil, fmt.Errorf("invalid tag filter (%s): empty key", s)
+		}
+
+		tags[k] = v
+	}
+
+	return tags, nil
+}
+
+// String returns a description of the filter for logging.
+func (f Filter) String() string {
+	var parts []string
+
+	if f.DryRun {
+		parts = append(parts, "dry run")
+	}
+	if len(f.NamePrefixes) > 0 {
+		parts = append(parts, fmt.Sprintf("name prefixes %v", f.NamePrefixes))
+	}
+	if len(f.Tags) > 0 {
+		parts = append(parts, fmt.Sprintf("tags %v", f.Tags))
+	}
+	if f.MinAge > 0 {
+		parts = append(parts, fmt.Sprintf("minimum age %s", f.MinAge))
+	}
+
+	if len(parts) == 0 {
+		return "none"
+	}
+
+	return strings.Join(parts, ", ")
+}
+
+// restricted returns whether the filter restricts deletion: in dry-run mode or when the resources swept are filtered.
+func (f Filter) restricted() bool {
+	return f.DryRun || f.selective()
+}
+
+// selective returns whether the filter restricts the resources swept.
+func (f Filter) selective() bool {
+	return len(f.NamePrefixes) > 0 || len(f.Tags) > 0 || f.MinAge > 0
+}
+
+// matchResource returns whether the Plugin SDK resource matches the filter.
+// The resource is read first so that its name, tags and creation time are known.
+func (f Filter) matchResource(ctx context.Context, resource *schema.Resource, d *schema.ResourceData, meta interface{}) (bool, error) {
+	if !f.selective() {
+		return true, nil
+	}
+
+	if err := ReadResource(ctx, resource, d, meta); err != nil {
+		return false, err
+	}
+
+	// The resource no longer exists.
+	if d.Id() == "" {
+		return false, nil
+	}
+
+	name := d.Id()
+	if _, ok := resource.Schema["name"]; ok {
+		if v, ok := d.Get("name").(string); ok && v != "" {
+			name = v
+		}
+	}
+
+	if !f.matchName(name) {
+		return false, nil
+	}
+
+	if len(f.Tags) > 0 {
+		tags := make(map[string]string)
+
+		for _, k := range []string{"tags_all", "tags"} {
+			if _, ok := resource.Schema[k]; ok {
+				if v, ok := d.Get(k).(map[string]interface{}); ok && len(v) > 0 {
+					for k, v := range v {
+						tags[k], _ = v.(string)
+					}
+					break
+				}
+			}
+		}
+
+		if !f.matchTags(tags) {
+			return false, nil
+		}
+	}
+
+	if f.MinAge > 0 {
+		var created string
+
+		for _, k := range creationTimeAttributeNames {
+			if _, ok := resource.Schema[k]; !ok {
+				continue
+			}
+
+			if v, ok := d.Get(k).(string); ok && v != "" {
+				created = v
+				break
+			}
+		}
+
+		if !f.matchCreationTime(created) {
+			return false, nil
+		}
+	}
+
+	return true, nil
+}
+
+// matchFrameworkResource returns whether the Plugin Framework resource with the specified ID matches the filter.
+// The resource is read first so that its name, tags and creation time are known.
+func (f Filter) matchFrameworkResource(ctx context.Context, factory func(context.Context) (fwresource.ResourceWithConfigure, error), id string, meta interface{}, supplementalAttributes ...FrameworkSupplementalAttribute) (bool, error) {
+	if !f.selective() {
+		return true, nil
+	}
+
+	state, err := ReadFrameworkResourceWithContext(ctx, factory, id, meta, supplementalAttributes...)
+
+	if err != nil {
+		return false, err
+	}
+
+	// The resource no longer exists.
+	if state.Raw.IsNull() {
+		return false, nil
+	}
+
+	name := id
+	if _, ok := state.Schema.Attributes["name"]; ok {
+		var v types.String
+
+		if diags := state.GetAttribute(ctx, path.Root("name"), &v); diags.HasError() {
+			return false, errs.NewDiagnosticsError(diags)
+		}
+
+		if v.Value != "" {
+			name = v.Value
+		}
+	}
+
+	if !f.matchName(name) {
+		return false, nil
+	}
+
+	if len(f.Tags) > 0 {
+		tags := make(map[string]string)
+
+		for _, k := range []string{"tags_all", "tags"} {
+			if _, ok := state.Schema.Attributes[k]; !ok {
+				continue
+			}
+
+			var v types.Map
+
+			if diags := state.GetAttribute(ctx, path.Root(k), &v); diags.HasError() {
+				return false, errs.NewDiagnosticsError(diags)
+			}
+
+			if len(v.Elems) > 0 {
+				for k, v := range v.Elems {
+					if v, ok := v.(types.String); ok {
+						tags[k] = v.Value
+					}
+				}
+				break
+			}
+		}
+
+		if !f.matchTags(tags) {
+			return false, nil
+		}
+	}
+
+	if f.MinAge > 0 {
+		var created string
+
+		for _, k := range creationTimeAttributeNames {
+			if _, ok := state.Schema.Attributes[k]; !ok {
+				continue
+			}
+
+			var v types.String
+
+			if diags := state.GetAttribute(ctx, path.Root(k), &v); diags.HasError() {
+				return false, errs.NewDiagnosticsError(diags)
+			}
+
+			if v.Value != "" {
+				created = v.Value
+				break
+			}
+		}
+
+		if !f.matchCreationTime(created) {
+			return false, nil
+		}
+	}
+
+	return true, nil
+}
+
+// matchTags returns whether a resource with the specified tags matches the filter's tags.
+func (f Filter) matchTags(tags map[string]string) bool {
+	for k, v := range f.Tags {
+		value, ok := tags[k]
+
+		if !ok || (v != "" && value != v) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// matchCreationTime returns whether a resource created at the specified RFC 3339 time matches the filter's minimum age.
+// Resources whose age is unknown don't match.
+func (f Filter) matchCreationTime(created string) bool {
+	t, err := time.Parse(time.RFC3339, created)
+
+	if err != nil {
+		return false
+	}
+
+	return time.Since(t) >= f.MinAge
+}
+
+func (f Filter) matchName(name string) bool {
+	if len(f.NamePrefixes) == 0 {
+		return true
+	}
+
+	for _, prefix := range f.NamePrefixes {
+		if strings.HasPrefix(name, prefix) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// dryRun logs that the resource would be swept and returns whether deletion should be skipped.
+func (f Filter) dryRun(id string) bool {
+	if f.DryRun {
+		log.Printf("[INFO] Dry run: would sweep resource (%s)", id)
+	}
+
+	return f.DryRun
+}
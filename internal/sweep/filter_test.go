@@ -0,0 +1,234 @@
+//go:build sweep
+// +build sweep
+
+package sweep
+
+import (
+	"context"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func TestParseFilterTags(t *testing.T) {
+	testCases := []struct {
+		Name          string
+		Input         string
+		Expected      map[string]string
+		ExpectedError bool
+	}{
+		{
+			Name: "empty",
+		},
+		{
+			Name:     "key value",
+			Input:    "Owner=tf-acc-test, Environment=test",
+			Expected: map[string]string{"Owner": "tf-acc-test", "Environment": "test"},
+		},
+		{
+			Name:     "key only",
+			Input:    "Ephemeral",
+			Expected: map[string]string{"Ephemeral": ""},
+		},
+		{
+			Name:          "empty key",
+			Input:         "=test",
+			ExpectedError: true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			got, err := ParseFilterTags(testCase.Input)
+
+			if err == nil && testCase.ExpectedError {
+				t.Fatalf("expected error")
+			}
+
+			if err != nil && !testCase.ExpectedError {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if !reflect.DeepEqual(got, testCase.Expected) {
+				t.Errorf("got %v, expected %v", got, testCase.Expected)
+			}
+		})
+	}
+}
+
+func TestFilterMatchResource(t *testing.T) {
+	created := time.Now().Add(-48 * time.Hour).Format(time.RFC3339)
+
+	r := &schema.Resource{
+		Read: func(d *schema.ResourceData, meta interface{}) error {
+			if d.Id() == "gone" {
+				d.SetId("")
+				return nil
+			}
+
+			d.Set("name", "tf-acc-test-"+d.Id())
+			d.Set("creation_date", created)
+			d.Set("tags", map[string]interface{}{"Owner": "tf-acc-test"})
+
+			return nil
+		},
+		Schema: map[string]*schema.Schema{
+			"creation_date": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"tags": {
+				Type:     schema.TypeMap,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+		},
+	}
+
+	testCases := []struct {
+		Name     string
+		ID       string
+		Filter   Filter
+		Expected bool
+	}{
+		{
+			Name:     "no filter",
+			ID:       "1",
+			Expected: true,
+		},
+		{
+			Name:     "name prefix",
+			ID:       "1",
+			Filter:   Filter{NamePrefixes: []string{"other-", "tf-acc-test-"}},
+			Expected: true,
+		},
+		{
+			Name:   "name prefix mismatch",
+			ID:     "1",
+			Filter: Filter{NamePrefixes: []string{"other-"}},
+		},
+		{
+			Name:     "tag",
+			ID:       "1",
+			Filter:   Filter{Tags: map[string]string{"Owner": "tf-acc-test"}},
+			Expected: true,
+		},
+		{
+			Name:     "tag key",
+			ID:       "1",
+			Filter:   Filter{Tags: map[string]string{"Owner": ""}},
+			Expected: true,
+		},
+		{
+			Name:   "tag value mismatch",
+			ID:     "1",
+			Filter: Filter{Tags: map[string]string{"Owner": "someone"}},
+		},
+		{
+			Name:     "min age",
+			ID:       "1",
+			Filter:   Filter{MinAge: 24 * time.Hour},
+			Expected: true,
+		},
+		{
+			Name:   "too new",
+			ID:     "1",
+			Filter: Filter{MinAge: 72 * time.Hour},
+		},
+		{
+			Name:   "not found",
+			ID:     "gone",
+			Filter: Filter{NamePrefixes: []string{"tf-acc-test-"}},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			d := r.Data(nil)
+			d.SetId(testCase.ID)
+
+			got, err := testCase.Filter.matchResource(context.Background(), r, d, nil)
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if got != testCase.Expected {
+				t.Errorf("got %t, expected %t", got, testCase.Expected)
+			}
+		})
+	}
+}
+
+func TestSweepOrchestratorFilter(t *testing.T) {
+	var deleted []string
+
+	r := &schema.Resource{
+		Read: func(d *schema.ResourceData, meta interface{}) error {
+			return d.Set("name", d.Id())
+		},
+		Delete: func(d *schema.ResourceData, meta interface{}) error {
+			deleted = append(deleted, d.Id())
+			return nil
+		},
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+		},
+	}
+
+	sweepables := func() []Sweepable {
+		var sweepables []Sweepable
+
+		for _, id := range []string{"tf-acc-test-1", "production"} {
+			d := r.Data(nil)
+			d.SetId(id)
+			sweepables = append(sweepables, NewSweepResource(r, d, nil))
+		}
+
+		return sweepables
+	}
+
+	testCases := []struct {
+		Name     string
+		Filter   Filter
+		Expected []string
+	}{
+		{
+			Name:   "dry run",
+			Filter: Filter{DryRun: true},
+		},
+		{
+			Name:     "name prefix",
+			Filter:   Filter{NamePrefixes: []string{ResourcePrefix}},
+			Expected: []string{"tf-acc-test-1"},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			activeFilter = testCase.Filter
+			defer func() {
+				activeFilter = Filter{}
+			}()
+
+			deleted = nil
+
+			if err := SweepOrchestrator(context.Background(), sweepables()); err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if !reflect.DeepEqual(deleted, testCase.Expected) {
+				t.Errorf("got deleted %v, expected %v", deleted, testCase.Expected)
+			}
+		})
+	}
+}
@@ -7,6 +7,7 @@ import (
 	"context"
 	"log"
 	"strings"
+	"time"
 
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	fwresource "github.com/hashicorp/terraform-plugin-framework/resource"
@@ -19,23 +20,60 @@ import (
 
 // Terraform Plugin Framework variants of sweeper helpers.
 
+// defaultFrameworkDeleteTimeout is the delete timeout used for Plugin Framework resources,
+// matching the Plugin SDK's default.
+const defaultFrameworkDeleteTimeout = 20 * time.Minute
+
+// FrameworkSupplementalAttribute is a top-level attribute, other than "id", set in the state used to delete a resource.
+type FrameworkSupplementalAttribute struct {
+	Path  string
+	Value interface{}
+}
+
 type SweepFrameworkResource struct {
-	factory func(context.Context) (fwresource.ResourceWithConfigure, error)
-	id      string // TODO Currently we can only delete a resource if "id" is the only attribute used.
-	meta    interface{}
+	factory                func(context.Context) (fwresource.ResourceWithConfigure, error)
+	id                     string
+	meta                   interface{}
+	supplementalAttributes []FrameworkSupplementalAttribute
+	deleteTimeout          time.Duration
 }
 
-func NewSweepFrameworkResource(factory func(context.Context) (fwresource.ResourceWithConfigure, error), id string, meta interface{}) *SweepFrameworkResource {
+func NewSweepFrameworkResource(factory func(context.Context) (fwresource.ResourceWithConfigure, error), id string, meta interface{}, supplementalAttributes ...FrameworkSupplementalAttribute) *SweepFrameworkResource {
 	return &SweepFrameworkResource{
-		factory: factory,
-		id:      id,
-		meta:    meta,
+		factory:                factory,
+		id:                     id,
+		meta:                   meta,
+		supplementalAttributes: supplementalAttributes,
+		deleteTimeout:          defaultFrameworkDeleteTimeout,
 	}
 }
 
+// WithDeleteTimeout sets the maximum time allowed for each attempt to delete the resource.
+func (sr *SweepFrameworkResource) WithDeleteTimeout(timeout time.Duration) *SweepFrameworkResource {
+	sr.deleteTimeout = timeout
+
+	return sr
+}
+
 func (sr *SweepFrameworkResource) Delete(ctx context.Context, rc RetryConfig) error {
-	err := tfresource.RetryConfigContext(ctx, rc.Delay, rc.DelayRand, rc.MinTimeout, rc.PollInterval, rc.Timeout, func() *resource.RetryError {
-		err := DeleteFrameworkResource(sr.factory, sr.id, sr.meta)
+	if ok, err := activeFilter.matchFrameworkResource(ctx, sr.factory, sr.id, sr.meta, sr.supplementalAttributes...); err != nil {
+		return err
+	} else if !ok {
+		return errFiltered
+	}
+
+	if activeFilter.dryRun(sr.id) {
+		return nil
+	}
+
+	meta, err := writableSweepClient(ctx, sr.meta)
+
+	if err != nil {
+		return err
+	}
+
+	err = tfresource.RetryConfigContext(ctx, rc.Delay, rc.DelayRand, rc.MinTimeout, rc.PollInterval, rc.Timeout, func() *resource.RetryError {
+		err := sr.delete(ctx, meta)
 
 		if err != nil {
 			if strings.Contains(err.Error(), "Throttling") {
@@ -50,42 +88,95 @@ func (sr *SweepFrameworkResource) Delete(ctx context.Context, rc RetryConfig) er
 	})
 
 	if tfresource.TimedOut(err) {
-		err = DeleteFrameworkResource(sr.factory, sr.id, sr.meta)
+		err = sr.delete(ctx, meta)
 	}
 
 	return err
+}
 
+func (sr *SweepFrameworkResource) delete(ctx context.Context, meta interface{}) error {
+	if sr.deleteTimeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, sr.deleteTimeout)
+		defer cancel()
+	}
+
+	return DeleteFrameworkResourceWithContext(ctx, sr.factory, sr.id, meta, sr.supplementalAttributes...)
 }
 
 func DeleteFrameworkResource(factory func(context.Context) (fwresource.ResourceWithConfigure, error), id string, meta interface{}) error {
-	ctx := context.Background()
+	return DeleteFrameworkResourceWithContext(context.Background(), factory, id, meta)
+}
 
-	resource, err := factory(ctx)
+// ReadFrameworkResourceWithContext reads the Plugin Framework resource with the specified ID.
+// The resource is read using a minimal state containing only the ID and any supplemental attributes.
+// The returned state is null if the resource no longer exists.
+func ReadFrameworkResourceWithContext(ctx context.Context, factory func(context.Context) (fwresource.ResourceWithConfigure, error), id string, meta interface{}, supplementalAttributes ...FrameworkSupplementalAttribute) (tfsdk.State, error) {
+	resource, state, err := newFrameworkResource(ctx, factory, id, meta, supplementalAttributes...)
+
+	if err != nil {
+		return tfsdk.State{}, err
+	}
+
+	response := fwresource.ReadResponse{State: state}
+	resource.Read(ctx, fwresource.ReadRequest{State: state}, &response)
+
+	if response.Diagnostics.HasError() {
+		return tfsdk.State{}, errs.NewDiagnosticsError(response.Diagnostics)
+	}
+
+	return response.State, nil
+}
+
+// DeleteFrameworkResourceWithContext deletes the Plugin Framework resource with the specified ID.
+// The resource is deleted using a minimal state containing only the ID and any supplemental attributes.
+func DeleteFrameworkResourceWithContext(ctx context.Context, factory func(context.Context) (fwresource.ResourceWithConfigure, error), id string, meta interface{}, supplementalAttributes ...FrameworkSupplementalAttribute) error {
+	resource, state, err := newFrameworkResource(ctx, factory, id, meta, supplementalAttributes...)
 
 	if err != nil {
 		return err
 	}
 
+	response := fwresource.DeleteResponse{}
+	resource.Delete(ctx, fwresource.DeleteRequest{State: state}, &response)
+
+	if response.Diagnostics.HasError() {
+		return errs.NewDiagnosticsError(response.Diagnostics)
+	}
+
+	return nil
+}
+
+// newFrameworkResource returns the configured Plugin Framework resource
+// and a minimal state containing only the resource ID and any supplemental attributes.
+func newFrameworkResource(ctx context.Context, factory func(context.Context) (fwresource.ResourceWithConfigure, error), id string, meta interface{}, supplementalAttributes ...FrameworkSupplementalAttribute) (fwresource.ResourceWithConfigure, tfsdk.State, error) {
+	resource, err := factory(ctx)
+
+	if err != nil {
+		return nil, tfsdk.State{}, err
+	}
+
 	resource.Configure(ctx, fwresource.ConfigureRequest{ProviderData: meta}, &fwresource.ConfigureResponse{})
 
 	schema, diags := resource.GetSchema(ctx)
 
 	if diags.HasError() {
-		return errs.NewDiagnosticsError(diags)
+		return nil, tfsdk.State{}, errs.NewDiagnosticsError(diags)
 	}
 
-	// Simple Terraform State that contains just the resource ID.
+	// Simple Terraform State that contains just the resource ID and any supplemental attributes.
 	state := tfsdk.State{
 		Raw:    tftypes.NewValue(schema.Type().TerraformType(ctx), nil),
 		Schema: schema,
 	}
-	state.SetAttribute(ctx, path.Root("id"), id)
-	response := fwresource.DeleteResponse{}
-	resource.Delete(ctx, fwresource.DeleteRequest{State: state}, &response)
+	diags.Append(state.SetAttribute(ctx, path.Root("id"), id)...)
+	for _, v := range supplementalAttributes {
+		diags.Append(state.SetAttribute(ctx, path.Root(v.Path), v.Value)...)
+	}
 
-	if response.Diagnostics.HasError() {
-		return errs.NewDiagnosticsError(response.Diagnostics)
+	if diags.HasError() {
+		return nil, tfsdk.State{}, errs.NewDiagnosticsError(diags)
 	}
 
-	return nil
+	return resource, state, nil
 }
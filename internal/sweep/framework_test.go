@@ -0,0 +1,263 @@
+//go:build sweep
+// +build sweep
+
+package sweep
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	fwresource "github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	sdkdiag "github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+type testFrameworkResource struct {
+	deleted  map[string]string
+	deadline time.Time
+}
+
+func (r *testFrameworkResource) Metadata(_ context.Context, request fwresource.MetadataRequest, response *fwresource.MetadataResponse) {
+	response.TypeName = "aws_test"
+}
+
+func (r *testFrameworkResource) GetSchema(context.Context) (tfsdk.Schema, diag.Diagnostics) {
+	return tfsdk.Schema{
+		Attributes: map[string]tfsdk.Attribute{
+			"id": {
+				Type:     types.StringType,
+				Computed: true,
+			},
+			"parent_id": {
+				Type:     types.StringType,
+				Required: true,
+			},
+		},
+	}, nil
+}
+
+func (r *testFrameworkResource) Configure(context.Context, fwresource.ConfigureRequest, *fwresource.ConfigureResponse) {
+}
+
+func (r *testFrameworkResource) Create(context.Context, fwresource.CreateRequest, *fwresource.CreateResponse) {
+}
+
+func (r *testFrameworkResource) Read(context.Context, fwresource.ReadRequest, *fwresource.ReadResponse) {
+}
+
+func (r *testFrameworkResource) Update(context.Context, fwresource.UpdateRequest, *fwresource.UpdateResponse) {
+}
+
+func (r *testFrameworkResource) Delete(ctx context.Context, request fwresource.DeleteRequest, response *fwresource.DeleteResponse) {
+	if err := ctx.Err(); err != nil {
+		response.Diagnostics.AddError("deleting", err.Error())
+		return
+	}
+
+	var id, parentID types.String
+
+	response.Diagnostics.Append(request.State.GetAttribute(ctx, path.Root("id"), &id)...)
+	response.Diagnostics.Append(request.State.GetAttribute(ctx, path.Root("parent_id"), &parentID)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	r.deleted[id.Value] = parentID.Value
+	r.deadline, _ = ctx.Deadline()
+}
+
+func TestSweepFrameworkResourceDelete(t *testing.T) {
+	r := &testFrameworkResource{deleted: make(map[string]string)}
+	factory := func(context.Context) (fwresource.ResourceWithConfigure, error) {
+		return r, nil
+	}
+
+	sweepable := NewSweepFrameworkResource(factory, "child", nil, FrameworkSupplementalAttribute{
+		Path:  "parent_id",
+		Value: "parent",
+	}).WithDeleteTimeout(time.Minute)
+
+	if err := sweepable.Delete(context.Background(), RetryConfig{Timeout: time.Minute}); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if got, expected := r.deleted["child"], "parent"; got != expected {
+		t.Errorf("got parent_id %q, expected %q", got, expected)
+	}
+
+	if r.deadline.IsZero() || time.Until(r.deadline) > time.Minute {
+		t.Errorf("got deadline %s, expected within delete timeout", r.deadline)
+	}
+}
+
+func TestSweepFrameworkResourceDeleteCancelled(t *testing.T) {
+	r := &testFrameworkResource{deleted: make(map[string]string)}
+	factory := func(context.Context) (fwresource.ResourceWithConfigure, error) {
+		return r, nil
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	err := SweepOrchestratorWithContext(ctx, []Sweepable{NewSweepFrameworkResource(factory, "child", nil)}, 0, 0, 0, 0, time.Minute)
+
+	if !errors.Is(err, context.Canceled) {
+		t.Errorf("got error %v, expected %s", err, context.Canceled)
+	}
+
+	if len(r.deleted) > 0 {
+		t.Errorf("got deleted %v, expected none", r.deleted)
+	}
+}
+
+func TestDeleteResourceWithContextTimeout(t *testing.T) {
+	var deadline time.Time
+
+	r := &schema.Resource{
+		DeleteContext: func(ctx context.Context, d *schema.ResourceData, meta interface{}) sdkdiag.Diagnostics {
+			deadline, _ = ctx.Deadline()
+			return nil
+		},
+		Schema: map[string]*schema.Schema{},
+		Timeouts: &schema.ResourceTimeout{
+			Delete: schema.DefaultTimeout(5 * time.Minute),
+		},
+	}
+
+	d := r.Data(nil)
+	d.SetId("test")
+
+	if err := DeleteResourceWithContext(context.Background(), r, d, nil); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if deadline.IsZero() || time.Until(deadline) > 5*time.Minute {
+		t.Errorf("got deadline %s, expected within delete timeout", deadline)
+	}
+}
+
+type testFrameworkFilterResource struct {
+	testFrameworkResource
+	created string
+}
+
+func (r *testFrameworkFilterResource) GetSchema(context.Context) (tfsdk.Schema, diag.Diagnostics) {
+	return tfsdk.Schema{
+		Attributes: map[string]tfsdk.Attribute{
+			"creation_date": {
+				Type:     types.StringType,
+				Computed: true,
+			},
+			"id": {
+				Type:     types.StringType,
+				Computed: true,
+			},
+			"name": {
+				Type:     types.StringType,
+				Required: true,
+			},
+			"tags_all": {
+				Type:     types.MapType{ElemType: types.StringType},
+				Computed: true,
+			},
+		},
+	}, nil
+}
+
+func (r *testFrameworkFilterResource) Read(ctx context.Context, request fwresource.ReadRequest, response *fwresource.ReadResponse) {
+	var id types.String
+
+	response.Diagnostics.Append(request.State.GetAttribute(ctx, path.Root("id"), &id)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	if id.Value == "gone" {
+		response.State.RemoveResource(ctx)
+		return
+	}
+
+	response.Diagnostics.Append(response.State.SetAttribute(ctx, path.Root("creation_date"), r.created)...)
+	response.Diagnostics.Append(response.State.SetAttribute(ctx, path.Root("name"), "tf-acc-test-"+id.Value)...)
+	response.Diagnostics.Append(response.State.SetAttribute(ctx, path.Root("tags_all"), map[string]string{"Owner": "tf-acc-test"})...)
+}
+
+func TestFilterMatchFrameworkResource(t *testing.T) {
+	r := &testFrameworkFilterResource{created: time.Now().Add(-48 * time.Hour).Format(time.RFC3339)}
+	factory := func(context.Context) (fwresource.ResourceWithConfigure, error) {
+		return r, nil
+	}
+
+	testCases := []struct {
+		Name     string
+		ID       string
+		Filter   Filter
+		Expected bool
+	}{
+		{
+			Name:     "no filter",
+			ID:       "1",
+			Expected: true,
+		},
+		{
+			Name:     "name prefix",
+			ID:       "1",
+			Filter:   Filter{NamePrefixes: []string{"other-", "tf-acc-test-"}},
+			Expected: true,
+		},
+		{
+			Name:   "name prefix mismatch",
+			ID:     "1",
+			Filter: Filter{NamePrefixes: []string{"other-"}},
+		},
+		{
+			Name:     "tag",
+			ID:       "1",
+			Filter:   Filter{Tags: map[string]string{"Owner": "tf-acc-test"}},
+			Expected: true,
+		},
+		{
+			Name:   "tag value mismatch",
+			ID:     "1",
+			Filter: Filter{Tags: map[string]string{"Owner": "someone"}},
+		},
+		{
+			Name:     "min age",
+			ID:       "1",
+			Filter:   Filter{MinAge: 24 * time.Hour},
+			Expected: true,
+		},
+		{
+			Name:   "too new",
+			ID:     "1",
+			Filter: Filter{MinAge: 72 * time.Hour},
+		},
+		{
+			Name:   "not found",
+			ID:     "gone",
+			Filter: Filter{NamePrefixes: []string{"tf-acc-test-"}},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			got, err := testCase.Filter.matchFrameworkResource(context.Background(), factory, testCase.ID, nil)
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if got != testCase.Expected {
+				t.Errorf("got %t, expected %t", got, testCase.Expected)
+			}
+		})
+	}
+}
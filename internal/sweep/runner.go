@@ -0,0 +1,412 @@
+//go:build sweep
+// +build sweep
+
+package sweep
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"log"
+	"os"
+	"sort"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/experimental/depgraph"
+)
+
+const (
+	defaultSweeperParallelism = 10
+)
+
+// Sweeper run statuses.
+const (
+	SweeperStatusCompleted = "completed"
+	SweeperStatusSkipped   = "skipped"
+	SweeperStatusFailed    = "failed"
+	SweeperStatusNotRun    = "not_run"
+)
+
+var (
+	flagSweepParallelism = flag.Int("sweep-parallelism", defaultSweeperParallelism, "Maximum number of sweepers to run concurrently")
+	flagSweepReport      = flag.String("sweep-report", "", "File to write the JSON sweeper report to")
+	flagSweepDryRun      = flag.Bool("sweep-dry-run", false, "List the resources that would be swept without deleting them")
+	flagSweepNamePrefix  = flag.String("sweep-name-prefix", "", "Comma-separated list of name prefixes of the resources to sweep")
+	flagSweepTag         = flag.String("sweep-tag", "", "Comma-separated list of tags (key=value or key) of the resources to sweep")
+	flagSweepMinAge      = flag.Duration("sweep-min-age", 0, "Minimum age of the resources to sweep")
+)
+
+// sweepers is the registry of all sweepers, keyed by name.
+var sweepers = make(map[string]*Sweeper)
+
+// Sweeper is a resource type's sweeper.
+type Sweeper struct {
+	// Name is the name of the sweeper, usually the resource type.
+	Name string
+	// Dependencies is the list of sweepers that must be run before this sweeper.
+	Dependencies []string
+	// F deletes the resources in the specified region.
+	// Resources deleted via SweepOrchestrator with F's context are counted in the sweeper report.
+	F func(ctx context.Context, region string) error
+}
+
+// sweptCountKey is the context key of the number of resources deleted by a running sweeper.
+type sweptCountKey struct{}
+
+// AddTestSweepers registers a sweeper with both the Plugin SDK and the provider's sweeper runner.
+func AddTestSweepers(name string, s *Sweeper) {
+	resource.AddTestSweepers(name, &resource.Sweeper{
+		Name:         s.Name,
+		Dependencies: s.Dependencies,
+		F: func(region string) error {
+			return s.F(context.Background(), region)
+		},
+	})
+
+	sweepers[name] = s
+}
+
+// Report is the machine-readable result of a sweeper run.
+type Report struct {
+	DryRun  bool            `json:"dry_run"`
+	Filter  string          `json:"filter"`
+	Regions []*RegionReport `json:"regions"`
+}
+
+// RegionReport is the result of running sweepers in a single region.
+type RegionReport struct {
+	Region   string           `json:"region"`
+	Layers   [][]string       `json:"layers"`
+	Sweepers []*SweeperReport `json:"sweepers"`
+	Duration string           `json:"duration"`
+}
+
+// SweeperReport is the result of running a single resource type's sweeper.
+type SweeperReport struct {
+	Name         string   `json:"name"`
+	Dependencies []string `json:"dependencies,omitempty"`
+	Layer        int      `json:"layer"`
+	Status       string   `json:"status"`
+	Swept        int      `json:"swept"`
+	Error        string   `json:"error,omitempty"`
+	Duration     string   `json:"duration,omitempty"`
+}
+
+// RunnerConfig configures a sweeper run.
+type RunnerConfig struct {
+	// Regions is the list of regions to sweep.
+	Regions []string
+	// Filter is a comma-separated list of sweeper name substrings.
+	// Matching sweepers and their (transitive) dependencies are run. An empty filter runs all sweepers.
+	Filter string
+	// AllowFailures continues to later layers after a sweeper failure.
+	AllowFailures bool
+	// Parallelism is the maximum number of sweepers to run concurrently.
+	Parallelism int
+	// Resources filters the resources deleted by the sweepers.
+	Resources Filter
+}
+
+// TestMain runs the registered sweepers in dependency order if the -sweep flag is set,
+// otherwise it runs the tests.
+func TestMain(m interface {
+	Run() int
+}) {
+	flag.Parse()
+
+	regions := flagValue("sweep")
+
+	if regions == "" {
+		resource.TestMain(m)
+
+		return
+	}
+
+	tags, err := ParseFilterTags(*flagSweepTag)
+
+	if err != nil {
+		log.Printf("[ERROR] %s", err)
+		os.Exit(1)
+	}
+
+	var namePrefixes []string
+	if v := *flagSweepNamePrefix; v != "" {
+		namePrefixes = strings.Split(v, ",")
+	}
+
+	config := &RunnerConfig{
+		Regions:       strings.Split(regions, ","),
+		Filter:        flagValue("sweep-run"),
+		AllowFailures: flagValue("sweep-allow-failures") == "true",
+		Parallelism:   *flagSweepParallelism,
+		Resources: Filter{
+			DryRun:       *flagSweepDryRun,
+			NamePrefixes: namePrefixes,
+			Tags:         tags,
+			MinAge:       *flagSweepMinAge,
+		},
+	}
+
+	report, err := Run(config, sweepers)
+
+	if report != nil && *flagSweepReport != "" {
+		if err := writeReport(*flagSweepReport, report); err != nil {
+			log.Printf("[ERROR] %s", err)
+		}
+	}
+
+	if err != nil {
+		log.Printf("[ERROR] %s", err)
+		os.Exit(1)
+	}
+
+	os.Exit(0)
+}
+
+// Run runs the specified sweepers in each region.
+// Sweepers are grouped into layers by their dependencies and the sweepers in each layer are run concurrently.
+func Run(config *RunnerConfig, sweepers map[string]*Sweeper) (*Report, error) {
+	layers, err := sweeperLayers(sweepers, config.Filter)
+
+	if err != nil {
+		return nil, err
+	}
+
+	parallelism := config.Parallelism
+	if parallelism < 1 {
+		parallelism = 1
+	}
+
+	activeFilter = config.Resources
+	defer func() {
+		activeFilter = Filter{}
+	}()
+
+	log.Printf("[DEBUG] Sweeping resources with filter: %s", activeFilter)
+
+	report := &Report{
+		DryRun: activeFilter.DryRun,
+		Filter: activeFilter.String(),
+	}
+	var failed bool
+
+	for _, region := range config.Regions {
+		region = strings.TrimSpace(region)
+
+		log.Printf("[DEBUG] Running sweepers for region (%s)", region)
+
+		regionReport := runRegion(region, layers, sweepers, parallelism, config.AllowFailures)
+
+		log.Printf("[DEBUG] Completed sweepers for region (%s) in %s", region, regionReport.Duration)
+
+		for _, v := range regionReport.Sweepers {
+			switch v.Status {
+			case SweeperStatusFailed:
+				failed = true
+				log.Printf("[ERROR] Sweeper (%s) for region (%s) failed: %s", v.Name, region, v.Error)
+			case SweeperStatusSkipped:
+				log.Printf("[WARN] Sweeper (%s) for region (%s) skipped: %s", v.Name, region, v.Error)
+			}
+		}
+
+		report.Regions = append(report.Regions, regionReport)
+
+		if failed && !config.AllowFailures {
+			break
+		}
+	}
+
+	if failed {
+		return report, errors.New("at least one sweeper failed")
+	}
+
+	return report, nil
+}
+
+func runRegion(region string, layers [][]string, sweepers map[string]*Sweeper, parallelism int, allowFailures bool) *RegionReport {
+	start := time.Now()
+	regionReport := &RegionReport{
+		Region: region,
+		Layers: layers,
+	}
+	semaphore := make(chan struct{}, parallelism)
+	var failed bool
+
+	for i, layer := range layers {
+		sweeperReports := make([]*SweeperReport, len(layer))
+
+		for j, name := range layer {
+			sweeperReports[j] = &SweeperReport{
+				Name:         name,
+				Dependencies: sweepers[name].Dependencies,
+				Layer:        i,
+				Status:       SweeperStatusNotRun,
+			}
+		}
+
+		regionReport.Sweepers = append(regionReport.Sweepers, sweeperReports...)
+
+		if failed && !allowFailures {
+			continue
+		}
+
+		var wg sync.WaitGroup
+
+		for j, name := range layer {
+			sweeper, sweeperReport := sweepers[name], sweeperReports[j]
+
+			wg.Add(1)
+			semaphore <- struct{}{}
+
+			go func() {
+				defer func() {
+					<-semaphore
+					wg.Done()
+				}()
+
+				runSweeper(region, sweeper, sweeperReport)
+			}()
+		}
+
+		wg.Wait()
+
+		for _, v := range sweeperReports {
+			if v.Status == SweeperStatusFailed {
+				failed = true
+			}
+		}
+	}
+
+	regionReport.Duration = time.Since(start).String()
+
+	return regionReport
+}
+
+func runSweeper(region string, sweeper *Sweeper, report *SweeperReport) {
+	log.Printf("[DEBUG] Running sweeper (%s) in region (%s)", sweeper.Name, region)
+
+	var swept int64
+	ctx := context.WithValue(context.Background(), sweptCountKey{}, &swept)
+
+	start := time.Now()
+	err := sweeper.F(ctx, region)
+	report.Duration = time.Since(start).String()
+	report.Swept = int(atomic.LoadInt64(&swept))
+
+	switch {
+	case err == nil:
+		report.Status = SweeperStatusCompleted
+	case SkipSweepError(err):
+		report.Status = SweeperStatusSkipped
+		report.Error = err.Error()
+	case errors.Is(err, conns.ErrReadOnlyOperation):
+		// The sweeper deletes resources directly, rather than via SweepOrchestrator, so can't be filtered.
+		report.Status = SweeperStatusSkipped
+		report.Error = fmt.Sprintf("sweeper does not support dry run or filtering: %s", err)
+	default:
+		report.Status = SweeperStatusFailed
+		report.Error = err.Error()
+	}
+}
+
+// countSwept adds a deleted resource to the count of the running sweeper whose context is ctx, if any.
+func countSwept(ctx context.Context) {
+	if v, ok := ctx.Value(sweptCountKey{}).(*int64); ok {
+		atomic.AddInt64(v, 1)
+	}
+}
+
+// sweeperLayers returns the names of the sweepers to run, grouped into layers by their dependencies.
+// Returns an error if a dependency is not registered or a dependency cycle is detected.
+func sweeperLayers(sweepers map[string]*Sweeper, filter string) ([][]string, error) {
+	names := make([]string, 0, len(sweepers))
+	for name := range sweepers {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	g := depgraph.New()
+
+	for _, name := range names {
+		g.AddNode(name)
+	}
+
+	for _, name := range names {
+		for _, dependency := range sweepers[name].Dependencies {
+			if err := g.AddDependency(name, dependency); err != nil {
+				return nil, fmt.Errorf("sweeper (%s) has dependency (%s), but that sweeper was not found", name, dependency)
+			}
+		}
+	}
+
+	if filter := strings.Split(strings.ToLower(filter), ","); !(len(filter) == 1 && filter[0] == "") {
+		selected := make(map[string]struct{})
+
+		for _, name := range names {
+			for _, v := range filter {
+				if !strings.Contains(strings.ToLower(name), v) {
+					continue
+				}
+
+				dependencies, err := g.DependenciesOf(name)
+
+				if err != nil {
+					return nil, err
+				}
+
+				selected[name] = struct{}{}
+				for _, dependency := range dependencies {
+					selected[dependency] = struct{}{}
+				}
+			}
+		}
+
+		for _, name := range names {
+			if _, ok := selected[name]; !ok {
+				g.RemoveNode(name)
+			}
+		}
+	}
+
+	layers, err := g.Layers()
+
+	if err != nil {
+		return nil, err
+	}
+
+	for _, layer := range layers {
+		sort.Strings(layer)
+	}
+
+	return layers, nil
+}
+
+func writeReport(filename string, report *Report) error {
+	b, err := json.MarshalIndent(report, "", "  ")
+
+	if err != nil {
+		return fmt.Errorf("marshaling sweeper report: %w", err)
+	}
+
+	if err := os.WriteFile(filename, b, 0644); err != nil {
+		return fmt.Errorf("writing sweeper report (%s): %w", filename, err)
+	}
+
+	return nil
+}
+
+// flagValue returns the value of the specified command line flag, or "" if the flag is not defined.
+func flagValue(name string) string {
+	if f := flag.Lookup(name); f != nil {
+		return f.Value.String()
+	}
+
+	return ""
+}
@@ -0,0 +1,221 @@
+//go:build sweep
+// +build sweep
+
+package sweep
+
+import (
+	"context"
+	"errors"
+	"net"
+	"reflect"
+	"testing"
+)
+
+func TestSweeperLayers(t *testing.T) {
+	noop := func(context.Context, string) error { return nil }
+
+	testCases := []struct {
+		Name          string
+		Sweepers      map[string]*Sweeper
+		Filter        string
+		Expected      [][]string
+		ExpectedError bool
+	}{
+		{
+			Name: "no dependencies",
+			Sweepers: map[string]*Sweeper{
+				"aws_b": {Name: "aws_b", F: noop},
+				"aws_a": {Name: "aws_a", F: noop},
+			},
+			Expected: [][]string{{"aws_a", "aws_b"}},
+		},
+		{
+			Name: "dependencies",
+			Sweepers: map[string]*Sweeper{
+				"aws_vpc":    {Name: "aws_vpc", F: noop, Dependencies: []string{"aws_subnet", "aws_internet_gateway"}},
+				"aws_subnet": {Name: "aws_subnet", F: noop, Dependencies: []string{"aws_instance"}},
+				"aws_internet_gateway": {
+					Name: "aws_internet_gateway",
+					F:    noop,
+				},
+				"aws_instance": {Name: "aws_instance", F: noop},
+			},
+			Expected: [][]string{{"aws_instance", "aws_internet_gateway"}, {"aws_subnet"}, {"aws_vpc"}},
+		},
+		{
+			Name: "filter",
+			Sweepers: map[string]*Sweeper{
+				"aws_vpc":      {Name: "aws_vpc", F: noop, Dependencies: []string{"aws_subnet"}},
+				"aws_subnet":   {Name: "aws_subnet", F: noop, Dependencies: []string{"aws_instance"}},
+				"aws_instance": {Name: "aws_instance", F: noop},
+				"aws_queue":    {Name: "aws_queue", F: noop},
+			},
+			Filter:   "aws_SUBNET",
+			Expected: [][]string{{"aws_instance"}, {"aws_subnet"}},
+		},
+		{
+			Name: "missing dependency",
+			Sweepers: map[string]*Sweeper{
+				"aws_vpc": {Name: "aws_vpc", F: noop, Dependencies: []string{"aws_subnet"}},
+			},
+			ExpectedError: true,
+		},
+		{
+			Name: "cycle",
+			Sweepers: map[string]*Sweeper{
+				"aws_a": {Name: "aws_a", F: noop, Dependencies: []string{"aws_b"}},
+				"aws_b": {Name: "aws_b", F: noop, Dependencies: []string{"aws_a"}},
+			},
+			ExpectedError: true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			got, err := sweeperLayers(testCase.Sweepers, testCase.Filter)
+
+			if err == nil && testCase.ExpectedError {
+				t.Fatalf("expected error")
+			}
+
+			if err != nil && !testCase.ExpectedError {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if !testCase.ExpectedError && !reflect.DeepEqual(got, testCase.Expected) {
+				t.Errorf("got %v, expected %v", got, testCase.Expected)
+			}
+		})
+	}
+}
+
+func TestRun(t *testing.T) {
+	sweepers := map[string]*Sweeper{
+		"aws_vpc": {
+			Name: "aws_vpc",
+			F: func(ctx context.Context, _ string) error {
+				return SweepOrchestrator(ctx, []Sweepable{testSweepable{}, testSweepable{}, testSweepable{err: errors.New("DependencyViolation")}})
+			},
+			Dependencies: []string{"aws_subnet", "aws_endpoint"},
+		},
+		"aws_subnet": {
+			Name: "aws_subnet",
+			F:    func(context.Context, string) error { return errors.New("DependencyViolation") },
+		},
+		"aws_endpoint": {
+			Name: "aws_endpoint",
+			F:    func(context.Context, string) error { return &net.DNSError{IsNotFound: true} },
+		},
+	}
+
+	testCases := []struct {
+		Name          string
+		AllowFailures bool
+		Expected      map[string]string
+		ExpectedSwept map[string]int
+	}{
+		{
+			Name: "stop on failure",
+			Expected: map[string]string{
+				"aws_endpoint": SweeperStatusSkipped,
+				"aws_subnet":   SweeperStatusFailed,
+				"aws_vpc":      SweeperStatusNotRun,
+			},
+			ExpectedSwept: map[string]int{
+				"aws_endpoint": 0,
+				"aws_subnet":   0,
+				"aws_vpc":      0,
+			},
+		},
+		{
+			Name:          "allow failures",
+			AllowFailures: true,
+			Expected: map[string]string{
+				"aws_endpoint": SweeperStatusSkipped,
+				"aws_subnet":   SweeperStatusFailed,
+				"aws_vpc":      SweeperStatusFailed,
+			},
+			ExpectedSwept: map[string]int{
+				"aws_endpoint": 0,
+				"aws_subnet":   0,
+				"aws_vpc":      2,
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			report, err := Run(&RunnerConfig{
+				Regions:       []string{"us-west-2"},
+				AllowFailures: testCase.AllowFailures,
+				Parallelism:   2,
+			}, sweepers)
+
+			if err == nil {
+				t.Fatalf("expected error")
+			}
+
+			if n := len(report.Regions); n != 1 {
+				t.Fatalf("expected 1 region report, got %d", n)
+			}
+
+			got := make(map[string]string)
+			gotSwept := make(map[string]int)
+			for _, v := range report.Regions[0].Sweepers {
+				got[v.Name] = v.Status
+				gotSwept[v.Name] = v.Swept
+			}
+
+			if !reflect.DeepEqual(got, testCase.Expected) {
+				t.Errorf("got %v, expected %v", got, testCase.Expected)
+			}
+
+			if !reflect.DeepEqual(gotSwept, testCase.ExpectedSwept) {
+				t.Errorf("got swept %v, expected %v", gotSwept, testCase.ExpectedSwept)
+			}
+		})
+	}
+}
+
+func TestRunSweptCount(t *testing.T) {
+	// The sweepers share a function, which deletes resources from another goroutine.
+	f := func(ctx context.Context, _ string) error {
+		errs := make(chan error)
+
+		go func() {
+			errs <- SweepOrchestrator(ctx, []Sweepable{testSweepable{}, testSweepable{}})
+		}()
+
+		return <-errs
+	}
+	sweepers := map[string]*Sweeper{
+		"aws_a": {Name: "aws_a", F: f},
+		"aws_b": {Name: "aws_b", F: f},
+	}
+
+	report, err := Run(&RunnerConfig{
+		Regions:     []string{"us-west-2"},
+		Parallelism: 2,
+	}, sweepers)
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	got := make(map[string]int)
+	for _, v := range report.Regions[0].Sweepers {
+		got[v.Name] = v.Swept
+	}
+
+	if expected := map[string]int{"aws_a": 2, "aws_b": 2}; !reflect.DeepEqual(got, expected) {
+		t.Errorf("got swept %v, expected %v", got, expected)
+	}
+}
+
+type testSweepable struct {
+	err error
+}
+
+func (s testSweepable) Delete(context.Context, RetryConfig) error {
+	return s.err
+}
@@ -12,6 +12,7 @@ import (
 	"os"
 	"strconv"
 	"strings"
+	"sync"
 	"time"
 
 	"github.com/aws/aws-sdk-go/aws/endpoints"
@@ -37,14 +38,42 @@ const defaultSweeperAssumeRoleDurationSeconds = 3600
 // This prevents client re-initialization for every resource with no benefit.
 var SweeperClients map[string]interface{}
 
+// writableSweeperClients is a cache of regional conns.AWSClient used to delete filtered resources
+// when the sweeper clients are read-only.
+var writableSweeperClients = make(map[string]interface{})
+
+// sweeperClientsLock serializes access to the client caches, as sweepers run concurrently.
+var sweeperClientsLock sync.Mutex
+
 // SharedRegionalSweepClient returns a common conns.AWSClient setup needed for the sweeper
 // functions for a given region
 func SharedRegionalSweepClient(region string) (interface{}, error) {
 	return SharedRegionalSweepClientWithContext(context.Background(), region)
 }
 
+// SharedRegionalSweepClientWithContext returns a common conns.AWSClient for the sweeper functions for a given region.
+// In dry-run mode or when resources are filtered, the client is read-only: sweepers can list resources,
+// but only resources deleted via SweepOrchestrator, which applies the filter, are deleted.
 func SharedRegionalSweepClientWithContext(ctx context.Context, region string) (interface{}, error) {
-	if client, ok := SweeperClients[region]; ok {
+	return sharedRegionalSweepClient(ctx, region, SweeperClients, activeFilter.restricted())
+}
+
+// writableSweepClient returns a conns.AWSClient that can delete resources in the specified AWSClient's region.
+func writableSweepClient(ctx context.Context, meta interface{}) (interface{}, error) {
+	client, ok := meta.(*conns.AWSClient)
+
+	if !ok || !activeFilter.restricted() {
+		return meta, nil
+	}
+
+	return sharedRegionalSweepClient(ctx, client.Region, writableSweeperClients, false)
+}
+
+func sharedRegionalSweepClient(ctx context.Context, region string, clients map[string]interface{}, readOnly bool) (interface{}, error) {
+	sweeperClientsLock.Lock()
+	defer sweeperClientsLock.Unlock()
+
+	if client, ok := clients[region]; ok {
 		return client, nil
 	}
 
@@ -62,6 +91,7 @@ func SharedRegionalSweepClientWithContext(ctx context.Context, region string) (i
 
 	conf := &conns.Config{
 		MaxRetries:       5,
+		ReadOnly:         readOnly,
 		Region:           region,
 		SuppressDebugLog: true,
 	}
@@ -94,7 +124,7 @@ func SharedRegionalSweepClientWithContext(ctx context.Context, region string) (i
 		return nil, fmt.Errorf("getting AWS client: %#v", diags)
 	}
 
-	SweeperClients[region] = client
+	clients[region] = client
 
 	return client, nil
 }
@@ -126,8 +156,24 @@ type RetryConfig struct {
 }
 
 func (sr *SweepResource) Delete(ctx context.Context, rc RetryConfig) error {
-	err := tfresource.RetryConfigContext(ctx, rc.Delay, rc.DelayRand, rc.MinTimeout, rc.PollInterval, rc.Timeout, func() *resource.RetryError {
-		err := DeleteResource(sr.resource, sr.d, sr.meta)
+	if ok, err := activeFilter.matchResource(ctx, sr.resource, sr.d, sr.meta); err != nil {
+		return err
+	} else if !ok {
+		return errFiltered
+	}
+
+	if activeFilter.dryRun(sr.d.Id()) {
+		return nil
+	}
+
+	meta, err := writableSweepClient(ctx, sr.meta)
+
+	if err != nil {
+		return err
+	}
+
+	err = tfresource.RetryConfigContext(ctx, rc.Delay, rc.DelayRand, rc.MinTimeout, rc.PollInterval, rc.Timeout, func() *resource.RetryError {
+		err := DeleteResourceWithContext(ctx, sr.resource, sr.d, meta)
 
 		if err != nil {
 			if strings.Contains(err.Error(), "Throttling") {
@@ -142,15 +188,15 @@ func (sr *SweepResource) Delete(ctx context.Context, rc RetryConfig) error {
 	})
 
 	if tfresource.TimedOut(err) {
-		err = DeleteResource(sr.resource, sr.d, sr.meta)
+		err = DeleteResourceWithContext(ctx, sr.resource, sr.d, meta)
 	}
 
 	return err
 
 }
 
-func SweepOrchestrator(sweepables []Sweepable) error {
-	return SweepOrchestratorWithContext(context.Background(), sweepables, 0*time.Millisecond, 0*time.Millisecond, 0*time.Millisecond, 0*time.Millisecond, SweepThrottlingRetryTimeout)
+func SweepOrchestrator(ctx context.Context, sweepables []Sweepable) error {
+	return SweepOrchestratorWithContext(ctx, sweepables, 0*time.Millisecond, 0*time.Millisecond, 0*time.Millisecond, 0*time.Millisecond, SweepThrottlingRetryTimeout)
 }
 
 func SweepOrchestratorWithContext(ctx context.Context, sweepables []Sweepable, delay time.Duration, delayRand time.Duration, minTimeout time.Duration, pollInterval time.Duration, timeout time.Duration) error {
@@ -160,13 +206,28 @@ func SweepOrchestratorWithContext(ctx context.Context, sweepables []Sweepable, d
 		sweepable := sweepable
 
 		g.Go(func() error {
-			return sweepable.Delete(ctx, RetryConfig{
+			// Don't start deleting further resources once the sweep has been cancelled.
+			if err := ctx.Err(); err != nil {
+				return err
+			}
+
+			err := sweepable.Delete(ctx, RetryConfig{
 				Delay:        delay,
 				DelayRand:    delayRand,
 				MinTimeout:   minTimeout,
 				PollInterval: pollInterval,
 				Timeout:      timeout,
 			})
+
+			if errors.Is(err, errFiltered) {
+				return nil
+			}
+
+			if err == nil {
+				countSwept(ctx)
+			}
+
+			return err
 		})
 	}
 
@@ -249,14 +310,45 @@ func SkipSweepError(err error) bool {
 	return false
 }
 
+func ReadResource(ctx context.Context, resource *schema.Resource, d *schema.ResourceData, meta interface{}) error {
+	if resource.ReadContext != nil || resource.ReadWithoutTimeout != nil {
+		var diags diag.Diagnostics
+
+		if resource.ReadContext != nil {
+			diags = resource.ReadContext(ctx, d, meta)
+		} else {
+			diags = resource.ReadWithoutTimeout(ctx, d, meta)
+		}
+
+		for i := range diags {
+			if diags[i].Severity == diag.Error {
+				return fmt.Errorf("reading resource: %s", diags[i].Summary)
+			}
+		}
+
+		return nil
+	}
+
+	return resource.Read(d, meta)
+}
+
 func DeleteResource(resource *schema.Resource, d *schema.ResourceData, meta interface{}) error {
+	return DeleteResourceWithContext(context.Background(), resource, d, meta)
+}
+
+// DeleteResourceWithContext deletes the Plugin SDK resource.
+// As when Terraform deletes the resource, DeleteContext is bounded by the resource's delete timeout.
+func DeleteResourceWithContext(ctx context.Context, resource *schema.Resource, d *schema.ResourceData, meta interface{}) error {
 	if resource.DeleteContext != nil || resource.DeleteWithoutTimeout != nil {
 		var diags diag.Diagnostics
 
 		if resource.DeleteContext != nil {
-			diags = resource.DeleteContext(context.Background(), d, meta)
+			ctx, cancel := context.WithTimeout(ctx, d.Timeout(schema.TimeoutDelete))
+			defer cancel()
+
+			diags = resource.DeleteContext(ctx, d, meta)
 		} else {
-			diags = resource.DeleteWithoutTimeout(context.Background(), d, meta)
+			diags = resource.DeleteWithoutTimeout(ctx, d, meta)
 		}
 
 		for i := range diags {
@@ -1,12 +1,26 @@
 package tags
 
 import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 )
 
 // Terraform Plugin Framework variants of tags schemas.
 
+func TagsAttribute() tfsdk.Attribute {
+	return tfsdk.Attribute{
+		Type:     types.MapType{ElemType: types.StringType},
+		Optional: true,
+	}
+}
+
 func TagsAttributeComputed() tfsdk.Attribute {
 	return tfsdk.Attribute{
 		Type:     types.MapType{ElemType: types.StringType},
@@ -14,3 +28,86 @@ func TagsAttributeComputed() tfsdk.Attribute {
 		Computed: true,
 	}
 }
+
+func TagsAttributeTrulyComputed() tfsdk.Attribute {
+	return tfsdk.Attribute{
+		Type:     types.MapType{ElemType: types.StringType},
+		Computed: true,
+	}
+}
+
+// FrameworkMap returns tag keys mapped to their values as a Terraform Plugin Framework Map value.
+func (tags KeyValueTags) FrameworkMap() types.Map {
+	elems := make(map[string]attr.Value, len(tags))
+
+	for k, v := range tags.Map() {
+		elems[k] = types.String{Value: v}
+	}
+
+	return types.Map{ElemType: types.StringType, Elems: elems}
+}
+
+// ModifyPlan is the Terraform Plugin Framework equivalent of verify.SetTagsDiff.
+// It is called from the ModifyPlan method of resources with "tags" and "tags_all" attributes
+// and sets the planned value of "tags_all" to the resource's "tags" merged onto the provider's default tags,
+// excluding any tags ignored by the provider configuration.
+func ModifyPlan(ctx context.Context, defaultConfig *DefaultConfig, ignoreConfig *IgnoreConfig, request resource.ModifyPlanRequest, response *resource.ModifyPlanResponse) {
+	// Resource is being destroyed.
+	if request.Plan.Raw.IsNull() {
+		return
+	}
+
+	var planTags types.Map
+
+	response.Diagnostics.Append(request.Plan.GetAttribute(ctx, path.Root("tags"), &planTags)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	// Tags can only be merged once they, and all their values, are known.
+	if planTags.IsUnknown() || frameworkMapHasUnknownElems(planTags) {
+		response.Diagnostics.Append(response.Plan.SetAttribute(ctx, path.Root("tags_all"), types.Map{ElemType: types.StringType, Unknown: true})...)
+
+		return
+	}
+
+	resourceTags := New(planTags)
+
+	if defaultConfig.TagsEqual(resourceTags) {
+		response.Diagnostics.AddAttributeError(
+			path.Root("tags"),
+			"Duplicate tags",
+			`"tags" are identical to those in the "default_tags" configuration block of the provider: please de-duplicate and try again`,
+		)
+
+		return
+	}
+
+	mergedTags := defaultConfig.MergeTags(resourceTags)
+
+	if keys := defaultConfig.MissingRequiredTagKeys(mergedTags); len(keys) > 0 {
+		response.Diagnostics.AddAttributeError(
+			path.Root("tags"),
+			"Missing required tags",
+			fmt.Sprintf(`"tags" are missing keys required by the "default_tags" configuration block of the provider: %s`, strings.Join(keys, ", ")),
+		)
+
+		return
+	}
+
+	allTags := mergedTags.IgnoreConfig(ignoreConfig)
+
+	response.Diagnostics.Append(response.Plan.SetAttribute(ctx, path.Root("tags_all"), allTags.FrameworkMap())...)
+}
+
+// frameworkMapHasUnknownElems returns whether any of a Map value's elements are unknown.
+func frameworkMapHasUnknownElems(m types.Map) bool {
+	for _, v := range m.Elems {
+		if v.IsUnknown() {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,152 @@
+package tags
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func TestModifyPlan(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	schema := tfsdk.Schema{
+		Attributes: map[string]tfsdk.Attribute{
+			"tags":     TagsAttribute(),
+			"tags_all": TagsAttributeTrulyComputed(),
+		},
+	}
+	objectType := schema.Type().TerraformType(ctx)
+	mapType := tftypes.Map{ElementType: tftypes.String}
+	unknownMap := tftypes.NewValue(mapType, tftypes.UnknownValue)
+
+	tagsValue := func(m map[string]string) tftypes.Value {
+		if m == nil {
+			return tftypes.NewValue(mapType, nil)
+		}
+
+		vs := make(map[string]tftypes.Value, len(m))
+
+		for k, v := range m {
+			vs[k] = tftypes.NewValue(tftypes.String, v)
+		}
+
+		return tftypes.NewValue(mapType, vs)
+	}
+
+	type testCase struct {
+		tags          tftypes.Value
+		defaultConfig *DefaultConfig
+		ignoreConfig  *IgnoreConfig
+		expected      types.Map
+		expectError   bool
+	}
+	tests := map[string]testCase{
+		"no tags": {
+			tags:     tagsValue(nil),
+			expected: types.Map{ElemType: types.StringType, Elems: map[string]attr.Value{}},
+		},
+		"unknown tags": {
+			tags:     unknownMap,
+			expected: types.Map{ElemType: types.StringType, Unknown: true},
+		},
+		"unknown tag value": {
+			tags: tftypes.NewValue(mapType, map[string]tftypes.Value{
+				"key1": tftypes.NewValue(tftypes.String, "value1"),
+				"key2": tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
+			}),
+			defaultConfig: &DefaultConfig{
+				Tags: New(map[string]string{"key3": "default3"}),
+			},
+			expected: types.Map{ElemType: types.StringType, Unknown: true},
+		},
+		"resource tags only": {
+			tags: tagsValue(map[string]string{"key1": "value1"}),
+			expected: types.Map{ElemType: types.StringType, Elems: map[string]attr.Value{
+				"key1": types.String{Value: "value1"},
+			}},
+		},
+		"merged with default tags": {
+			tags: tagsValue(map[string]string{"key1": "value1", "key2": "value2"}),
+			defaultConfig: &DefaultConfig{
+				Tags: New(map[string]string{"key2": "default2", "key3": "default3"}),
+			},
+			expected: types.Map{ElemType: types.StringType, Elems: map[string]attr.Value{
+				"key1": types.String{Value: "value1"},
+				"key2": types.String{Value: "value2"},
+				"key3": types.String{Value: "default3"},
+			}},
+		},
+		"ignored tags": {
+			tags: tagsValue(map[string]string{"key1": "value1", "prefix1": "value2"}),
+			defaultConfig: &DefaultConfig{
+				Tags: New(map[string]string{"key3": "default3"}),
+			},
+			ignoreConfig: &IgnoreConfig{
+				Keys:        New([]string{"key3"}),
+				KeyPrefixes: New([]string{"prefix"}),
+			},
+			expected: types.Map{ElemType: types.StringType, Elems: map[string]attr.Value{
+				"key1": types.String{Value: "value1"},
+			}},
+		},
+		"identical to default tags": {
+			tags: tagsValue(map[string]string{"key1": "value1"}),
+			defaultConfig: &DefaultConfig{
+				Tags: New(map[string]string{"key1": "value1"}),
+			},
+			expectError: true,
+		},
+		"missing required tag keys": {
+			tags: tagsValue(map[string]string{"key1": "value1"}),
+			defaultConfig: &DefaultConfig{
+				RequiredTagKeys: []string{"key1", "key2"},
+			},
+			expectError: true,
+		},
+	}
+
+	for name, test := range tests {
+		name, test := name, test
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			plan := tfsdk.Plan{
+				Schema: schema,
+				Raw: tftypes.NewValue(objectType, map[string]tftypes.Value{
+					"tags":     test.tags,
+					"tags_all": unknownMap,
+				}),
+			}
+			request := resource.ModifyPlanRequest{Plan: plan}
+			response := resource.ModifyPlanResponse{Plan: plan}
+
+			ModifyPlan(ctx, test.defaultConfig, test.ignoreConfig, request, &response)
+
+			if got, want := response.Diagnostics.HasError(), test.expectError; got != want {
+				t.Fatalf("got error %t, expected %t: %v", got, want, response.Diagnostics)
+			}
+
+			if test.expectError {
+				return
+			}
+
+			var got types.Map
+
+			if diags := response.Plan.GetAttribute(ctx, path.Root("tags_all"), &got); diags.HasError() {
+				t.Fatalf("unexpected error: %v", diags)
+			}
+
+			if diff := cmp.Diff(got, test.expected); diff != "" {
+				t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+			}
+		})
+	}
+}
@@ -3,11 +3,13 @@ package tags
 import (
 	"fmt"
 	"net/url"
+	"path"
 	"reflect"
 	"regexp"
 	"sort"
 	"strings"
 
+	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-provider-aws/internal/create"
 )
 
@@ -19,9 +21,43 @@ const (
 	ServerlessApplicationRepositoryTagKeyPrefix = `serverlessrepo:`
 )
 
+// Dynamic default tag values.
+// Each is replaced by the corresponding value when default tags are resolved for a resource type.
+// Values are limited to the provider's configuration and the resource type:
+// Terraform doesn't send the resource's address to providers, and a resource's ID
+// isn't known when it is created with its tags.
+const (
+	DefaultTagValueAccountID    = `{{account_id}}`
+	DefaultTagValuePartition    = `{{partition}}`
+	DefaultTagValueRegion       = `{{region}}`
+	DefaultTagValueResourceType = `{{resource_type}}`
+)
+
 // DefaultConfig contains tags to default across all resources.
 type DefaultConfig struct {
 	Tags KeyValueTags
+
+	// Rules contains tags to default across resources of specific types.
+	// Rules are applied in order after Tags, so later rules override earlier ones.
+	Rules []DefaultRule
+
+	// RequiredTagKeys contains tag keys that every taggable resource must have
+	// after resource tags are merged with default tags.
+	RequiredTagKeys []string
+}
+
+// DefaultRule contains tags to default across resources whose type matches any of ResourceTypes.
+// Resource type patterns use `path.Match` syntax, e.g. `aws_s3_*`.
+type DefaultRule struct {
+	ResourceTypes []string
+	Tags          KeyValueTags
+}
+
+// DefaultValues contains the values substituted for dynamic default tag values.
+type DefaultValues struct {
+	AccountID string
+	Partition string
+	Region    string
 }
 
 // IgnoreConfig contains various options for removing resource tags.
@@ -58,6 +94,86 @@ func (dc *DefaultConfig) GetTags() KeyValueTags {
 	return dc.Tags
 }
 
+// IsStatic returns whether the DefaultConfig's tags are the same for every resource type,
+// i.e. there are no resource type rules and no dynamic tag values.
+func (dc *DefaultConfig) IsStatic() bool {
+	if dc == nil {
+		return true
+	}
+
+	return len(dc.Rules) == 0 && !dc.Tags.hasDynamicValues()
+}
+
+// ForResourceType returns a DefaultConfig whose Tags are the default tags for the specified resource type:
+// the DefaultConfig's Tags merged with the tags of every matching rule, with dynamic values substituted.
+func (dc *DefaultConfig) ForResourceType(typeName string, values DefaultValues) *DefaultConfig {
+	if dc == nil {
+		return nil
+	}
+
+	result := &DefaultConfig{
+		Tags:            dc.Tags,
+		RequiredTagKeys: dc.RequiredTagKeys,
+	}
+
+	for _, rule := range dc.Rules {
+		if rule.Matches(typeName) {
+			result.Tags = result.Tags.Merge(rule.Tags)
+		}
+	}
+
+	if result.Tags.hasDynamicValues() {
+		replacer := strings.NewReplacer(
+			DefaultTagValueAccountID, values.AccountID,
+			DefaultTagValuePartition, values.Partition,
+			DefaultTagValueRegion, values.Region,
+			DefaultTagValueResourceType, typeName,
+		)
+		tags := make(KeyValueTags, len(result.Tags))
+
+		for k, v := range result.Tags {
+			if v != nil && v.Value != nil {
+				value := replacer.Replace(*v.Value)
+				v = &TagData{Value: &value}
+			}
+
+			tags[k] = v
+		}
+
+		result.Tags = tags
+	}
+
+	return result
+}
+
+// MissingRequiredTagKeys returns the DefaultConfig's RequiredTagKeys not present in the given KeyValueTags.
+func (dc *DefaultConfig) MissingRequiredTagKeys(tags KeyValueTags) []string {
+	if dc == nil {
+		return nil
+	}
+
+	var result []string
+
+	for _, k := range dc.RequiredTagKeys {
+		if _, ok := tags[k]; !ok {
+			result = append(result, k)
+		}
+	}
+
+	return result
+}
+
+// Matches returns whether the specified resource type matches any of the rule's resource type patterns.
+func (rule DefaultRule) Matches(typeName string) bool {
+	for _, pattern := range rule.ResourceTypes {
+		if ok, _ := path.Match(pattern, typeName); ok {
+			return true
+		}
+	}
+
+	return false
+}
+
 // MergeTags returns the result of keyvaluetags.Merge() on the given
 // DefaultConfig.Tags with KeyValueTags provided as an argument,
 // overriding the value of any tag with a matching key.
@@ -100,6 +216,84 @@ func (tags KeyValueTags) IgnoreConfig(config *IgnoreConfig) KeyValueTags {
 	return result
 }
 
+// Ignored returns whether a tag key is removed by the configuration.
+func (config *IgnoreConfig) Ignored(key string) bool {
+	if config == nil {
+		return false
+	}
+
+	if config.Keys.KeyExists(key) {
+		return true
+	}
+
+	for prefix := range config.KeyPrefixes {
+		if strings.HasPrefix(key, prefix) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// IgnoreConfigNested returns a copy of a list of flattened configuration blocks
+// with any tags removed by a given configuration from each block's tags attribute.
+// Compatible with blocks, such as launch template tag specifications, whose tags
+// are a TypeMap nested inside another configuration block.
+func IgnoreConfigNested(tfList []interface{}, tagsAttrName string, config *IgnoreConfig) []interface{} {
+	if config == nil || tfList == nil {
+		return tfList
+	}
+
+	result := make([]interface{}, 0, len(tfList))
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			result = append(result, tfMapRaw)
+			continue
+		}
+
+		m := make(map[string]interface{}, len(tfMap))
+
+		for k, v := range tfMap {
+			if k != tagsAttrName {
+				m[k] = v
+				continue
+			}
+
+			switch v := v.(type) {
+			case map[string]interface{}:
+				tags := make(map[string]interface{}, len(v))
+
+				for k, v := range v {
+					if !config.Ignored(k) {
+						tags[k] = v
+					}
+				}
+
+				m[k] = tags
+			case map[string]string:
+				tags := make(map[string]string, len(v))
+
+				for k, v := range v {
+					if !config.Ignored(k) {
+						tags[k] = v
+					}
+				}
+
+				m[k] = tags
+			default:
+				m[k] = v
+			}
+		}
+
+		result = append(result, m)
+	}
+
+	return result
+}
+
 // IgnoreElasticbeanstalk returns non-AWS and non-Elasticbeanstalk tag keys.
 func (tags KeyValueTags) IgnoreElasticbeanstalk() KeyValueTags {
 	result := make(KeyValueTags)
@@ -488,6 +682,23 @@ func (tags KeyValueTags) RemoveDefaultConfig(dc *DefaultConfig) KeyValueTags {
 	return result
 }
 
+// hasDynamicValues returns whether any tag value contains a dynamic default tag value.
+func (tags KeyValueTags) hasDynamicValues() bool {
+	for _, v := range tags {
+		if v == nil || v.Value == nil {
+			continue
+		}
+
+		for _, dynamicValue := range []string{DefaultTagValueAccountID, DefaultTagValuePartition, DefaultTagValueRegion, DefaultTagValueResourceType} {
+			if strings.Contains(*v.Value, dynamicValue) {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
 // String returns the default string representation of the KeyValueTags.
 func (tags KeyValueTags) String() string {
 	var builder strings.Builder
@@ -611,6 +822,19 @@ func New(i interface{}) KeyValueTags {
 			kvtm[v.(string)] = nil
 		}
 
+		return kvtm
+	case types.Map:
+		kvtm := make(KeyValueTags, len(value.Elems))
+
+		for k, v := range value.Elems {
+			kvtm[k] = &TagData{}
+
+			if v, ok := v.(types.String); ok && !v.IsNull() && !v.IsUnknown() {
+				str := v.Value // Prevent referencing issues
+				kvtm[k].Value = &str
+			}
+		}
+
 		return kvtm
 	default:
 		return make(KeyValueTags)
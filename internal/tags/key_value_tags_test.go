@@ -1,7 +1,11 @@
 package tags
 
 import (
+	"reflect"
 	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
 )
 
 func TestKeyValueTagsDefaultConfigGetTags(t *testing.T) {
@@ -291,6 +295,226 @@ func TestKeyValueTagsDefaultConfigTagsEqual(t *testing.T) {
 	}
 }
 
+func TestKeyValueTagsDefaultConfigForResourceType(t *testing.T) {
+	values := DefaultValues{
+		AccountID: "123456789012",
+		Partition: "aws",
+		Region:    "us-west-2", //lintignore:AWSAT003
+	}
+
+	testCases := []struct {
+		name          string
+		typeName      string
+		defaultConfig *DefaultConfig
+		want          map[string]string
+	}{
+		{
+			name:          "empty config",
+			typeName:      "aws_s3_bucket",
+			defaultConfig: &DefaultConfig{},
+			want:          map[string]string{},
+		},
+		{
+			name:     "no rules",
+			typeName: "aws_s3_bucket",
+			defaultConfig: &DefaultConfig{
+				Tags: New(map[string]string{
+					"key1": "value1",
+				}),
+			},
+			want: map[string]string{
+				"key1": "value1",
+			},
+		},
+		{
+			name:     "matching rule",
+			typeName: "aws_s3_bucket",
+			defaultConfig: &DefaultConfig{
+				Tags: New(map[string]string{
+					"key1": "value1",
+					"key2": "value2",
+				}),
+				Rules: []DefaultRule{
+					{
+						ResourceTypes: []string{"aws_s3_*"},
+						Tags: New(map[string]string{
+							"key2": "s3value2",
+							"key3": "s3value3",
+						}),
+					},
+				},
+			},
+			want: map[string]string{
+				"key1": "value1",
+				"key2": "s3value2",
+				"key3": "s3value3",
+			},
+		},
+		{
+			name:     "non-matching rule",
+			typeName: "aws_s3control_bucket",
+			defaultConfig: &DefaultConfig{
+				Tags: New(map[string]string{
+					"key1": "value1",
+				}),
+				Rules: []DefaultRule{
+					{
+						ResourceTypes: []string{"aws_s3_*", "aws_vpc"},
+						Tags: New(map[string]string{
+							"key2": "value2",
+						}),
+					},
+				},
+			},
+			want: map[string]string{
+				"key1": "value1",
+			},
+		},
+		{
+			name:     "later rules override",
+			typeName: "aws_vpc",
+			defaultConfig: &DefaultConfig{
+				Rules: []DefaultRule{
+					{
+						ResourceTypes: []string{"*"},
+						Tags: New(map[string]string{
+							"key1": "value1",
+						}),
+					},
+					{
+						ResourceTypes: []string{"aws_vpc"},
+						Tags: New(map[string]string{
+							"key1": "vpcvalue1",
+						}),
+					},
+				},
+			},
+			want: map[string]string{
+				"key1": "vpcvalue1",
+			},
+		},
+		{
+			name:     "dynamic values",
+			typeName: "aws_vpc",
+			defaultConfig: &DefaultConfig{
+				Tags: New(map[string]string{
+					"key1": "{{resource_type}}",
+					"key2": "arn:{{partition}}:ec2:{{region}}:{{account_id}}",
+					"key3": "{{unknown}}",
+				}),
+			},
+			want: map[string]string{
+				"key1": "aws_vpc",
+				"key2": "arn:aws:ec2:us-west-2:123456789012", //lintignore:AWSAT003,AWSAT005
+				"key3": "{{unknown}}",
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			got := testCase.defaultConfig.ForResourceType(testCase.typeName, values)
+			testKeyValueTagsVerifyMap(t, got.GetTags().Map(), testCase.want)
+		})
+	}
+}
+
+func TestKeyValueTagsDefaultConfigIsStatic(t *testing.T) {
+	testCases := []struct {
+		name          string
+		defaultConfig *DefaultConfig
+		want          bool
+	}{
+		{
+			name: "no config",
+			want: true,
+		},
+		{
+			name: "static tags",
+			defaultConfig: &DefaultConfig{
+				Tags: New(map[string]string{
+					"key1": "value1",
+				}),
+			},
+			want: true,
+		},
+		{
+			name: "dynamic tags",
+			defaultConfig: &DefaultConfig{
+				Tags: New(map[string]string{
+					"key1": "{{region}}",
+				}),
+			},
+			want: false,
+		},
+		{
+			name: "rules",
+			defaultConfig: &DefaultConfig{
+				Rules: []DefaultRule{
+					{
+						ResourceTypes: []string{"aws_vpc"},
+					},
+				},
+			},
+			want: false,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			got := testCase.defaultConfig.IsStatic()
+
+			if got != testCase.want {
+				t.Errorf("got %t; want %t", got, testCase.want)
+			}
+		})
+	}
+}
+
+func TestKeyValueTagsDefaultConfigMissingRequiredTagKeys(t *testing.T) {
+	testCases := []struct {
+		name          string
+		tags          KeyValueTags
+		defaultConfig *DefaultConfig
+		want          []string
+	}{
+		{
+			name: "no config",
+			tags: New(map[string]string{}),
+		},
+		{
+			name: "all present",
+			tags: New(map[string]string{
+				"key1": "value1",
+				"key2": "value2",
+			}),
+			defaultConfig: &DefaultConfig{
+				RequiredTagKeys: []string{"key1", "key2"},
+			},
+		},
+		{
+			name: "some missing",
+			tags: New(map[string]string{
+				"key2": "value2",
+			}),
+			defaultConfig: &DefaultConfig{
+				RequiredTagKeys: []string{"key1", "key2", "key3"},
+			},
+			want: []string{"key1", "key3"},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			got := testCase.defaultConfig.MissingRequiredTagKeys(testCase.tags)
+
+			if !reflect.DeepEqual(got, testCase.want) {
+				t.Errorf("got %v; want %v", got, testCase.want)
+			}
+		})
+	}
+}
+
 func TestKeyValueTagsIgnoreAWS(t *testing.T) { // nosemgrep:ci.aws-in-func-name
 	testCases := []struct {
 		name string
@@ -544,6 +768,165 @@ func TestKeyValueTagsIgnoreConfig(t *testing.T) {
 	}
 }
 
+func TestIgnoreConfigIgnored(t *testing.T) {
+	testCases := []struct {
+		name         string
+		key          string
+		ignoreConfig *IgnoreConfig
+		want         bool
+	}{
+		{
+			name:         "no config",
+			key:          "key1",
+			ignoreConfig: nil,
+			want:         false,
+		},
+		{
+			name:         "empty config",
+			key:          "key1",
+			ignoreConfig: &IgnoreConfig{},
+			want:         false,
+		},
+		{
+			name: "matching key",
+			key:  "key1",
+			ignoreConfig: &IgnoreConfig{
+				Keys: New([]string{"key1"}),
+			},
+			want: true,
+		},
+		{
+			name: "matching key prefix",
+			key:  "AmazonECSManaged",
+			ignoreConfig: &IgnoreConfig{
+				KeyPrefixes: New([]string{"AmazonECS"}),
+			},
+			want: true,
+		},
+		{
+			name: "no match",
+			key:  "key1",
+			ignoreConfig: &IgnoreConfig{
+				Keys:        New([]string{"key2"}),
+				KeyPrefixes: New([]string{"key3"}),
+			},
+			want: false,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			got := testCase.ignoreConfig.Ignored(testCase.key)
+
+			if got != testCase.want {
+				t.Errorf("got %t, expected %t", got, testCase.want)
+			}
+		})
+	}
+}
+
+func TestIgnoreConfigNested(t *testing.T) {
+	testCases := []struct {
+		name         string
+		tfList       []interface{}
+		ignoreConfig *IgnoreConfig
+		want         []interface{}
+	}{
+		{
+			name: "no config",
+			tfList: []interface{}{
+				map[string]interface{}{
+					"resource_type": "instance",
+					"tags": map[string]string{
+						"key1": "value1",
+					},
+				},
+			},
+			ignoreConfig: nil,
+			want: []interface{}{
+				map[string]interface{}{
+					"resource_type": "instance",
+					"tags": map[string]string{
+						"key1": "value1",
+					},
+				},
+			},
+		},
+		{
+			name:   "no blocks",
+			tfList: nil,
+			ignoreConfig: &IgnoreConfig{
+				Keys: New([]string{"key1"}),
+			},
+			want: nil,
+		},
+		{
+			name: "string map",
+			tfList: []interface{}{
+				map[string]interface{}{
+					"resource_type": "instance",
+					"tags": map[string]string{
+						"key1":      "value1",
+						"key2":      "value2",
+						"prefixkey": "value3",
+					},
+				},
+				map[string]interface{}{
+					"resource_type": "volume",
+				},
+			},
+			ignoreConfig: &IgnoreConfig{
+				Keys:        New([]string{"key1"}),
+				KeyPrefixes: New([]string{"prefix"}),
+			},
+			want: []interface{}{
+				map[string]interface{}{
+					"resource_type": "instance",
+					"tags": map[string]string{
+						"key2": "value2",
+					},
+				},
+				map[string]interface{}{
+					"resource_type": "volume",
+				},
+			},
+		},
+		{
+			name: "interface map",
+			tfList: []interface{}{
+				map[string]interface{}{
+					"volume_size": 8,
+					"tags": map[string]interface{}{
+						"key1": "value1",
+						"key2": "value2",
+					},
+				},
+			},
+			ignoreConfig: &IgnoreConfig{
+				Keys: New([]string{"key2"}),
+			},
+			want: []interface{}{
+				map[string]interface{}{
+					"volume_size": 8,
+					"tags": map[string]interface{}{
+						"key1": "value1",
+					},
+				},
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			got := IgnoreConfigNested(testCase.tfList, "tags", testCase.ignoreConfig)
+
+			if !reflect.DeepEqual(got, testCase.want) {
+				t.Errorf("got %v, expected %v", got, testCase.want)
+			}
+		})
+	}
+}
+
 func TestKeyValueTagsIgnoreElasticbeanstalk(t *testing.T) {
 	testCases := []struct {
 		name string
@@ -2268,6 +2651,16 @@ func TestNew(t *testing.T) {
 			source: []interface{}{},
 			want:   map[string]string{},
 		},
+		{
+			name:   "empty_types_Map",
+			source: types.Map{ElemType: types.StringType, Elems: map[string]attr.Value{}},
+			want:   map[string]string{},
+		},
+		{
+			name:   "null_types_Map",
+			source: types.Map{ElemType: types.StringType, Null: true},
+			want:   map[string]string{},
+		},
 		{
 			name: "non_empty_KeyValueTags",
 			source: KeyValueTags{
@@ -2306,6 +2699,19 @@ func TestNew(t *testing.T) {
 				"key3": "value3",
 			},
 		},
+		{
+			name: "non_empty_types_Map",
+			source: types.Map{ElemType: types.StringType, Elems: map[string]attr.Value{
+				"key1": types.String{Null: true},
+				"key2": types.String{Value: ""},
+				"key3": types.String{Value: "value3"},
+			}},
+			want: map[string]string{
+				"key1": "",
+				"key2": "",
+				"key3": "value3",
+			},
+		},
 		{
 			name: "non_empty_map_string_interface",
 			source: map[string]interface{}{
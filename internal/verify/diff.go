@@ -30,7 +30,16 @@ func SetTagsDiff(_ context.Context, diff *schema.ResourceDiff, meta interface{})
 		return fmt.Errorf(`"tags" are identical to those in the "default_tags" configuration block of the provider: please de-duplicate and try again`)
 	}
 
-	allTags := defaultTagsConfig.MergeTags(resourceTags).IgnoreConfig(ignoreTagsConfig)
+	mergedTags := defaultTagsConfig.MergeTags(resourceTags)
+
+	// Tags can only be verified once they are known.
+	if diff.NewValueKnown("tags") {
+		if keys := defaultTagsConfig.MissingRequiredTagKeys(mergedTags); len(keys) > 0 {
+			return fmt.Errorf(`"tags" are missing keys required by the "default_tags" configuration block of the provider: %s`, strings.Join(keys, ", "))
+		}
+	}
+
+	allTags := mergedTags.IgnoreConfig(ignoreTagsConfig)
 
 	// To ensure "tags_all" is correctly computed, we explicitly set the attribute diff
 	// when the merger of resource-level tags onto provider-level tags results in n > 0 tags,
@@ -55,6 +64,46 @@ func SetTagsDiff(_ context.Context, diff *schema.ResourceDiff, meta interface{})
 	return nil
 }
 
+// SetPolicyChangesDiff returns a CustomizeDiffFunc that sets the "policy_changes" attribute
+// to a statement-level summary of the planned changes to the policy document in the specified attribute.
+// The summary is only part of the plan: resources using it should clear "policy_changes" on read,
+// so that it is not kept in state and a plan without a policy change is empty.
+func SetPolicyChangesDiff(key string) schema.CustomizeDiffFunc {
+	return func(_ context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+		if !diff.HasChange(key) {
+			return nil
+		}
+
+		if !diff.NewValueKnown(key) {
+			if err := diff.SetNewComputed("policy_changes"); err != nil {
+				return fmt.Errorf("error setting policy_changes to computed: %w", err)
+			}
+
+			return nil
+		}
+
+		o, n := diff.GetChange(key)
+		changes, err := PolicyStatementChanges(o.(string), n.(string))
+
+		// Invalid policies are reported by validation or by the API.
+		if err != nil {
+			return nil
+		}
+
+		summary := make([]string, len(changes))
+
+		for i, change := range changes {
+			summary[i] = change.String()
+		}
+
+		if err := diff.SetNew("policy_changes", summary); err != nil {
+			return fmt.Errorf("error setting new policy_changes diff: %w", err)
+		}
+
+		return nil
+	}
+}
+
 // SuppressEquivalentStringCaseInsensitive provides custom difference suppression
 // for strings that are equal under case-insensitivity.
 func SuppressEquivalentStringCaseInsensitive(k, old, new string, d *schema.ResourceData) bool {
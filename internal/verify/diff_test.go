@@ -1,10 +1,16 @@
 package verify
 
 import (
+	"context"
 	"reflect"
+	"strconv"
+	"strings"
 	"testing"
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	"github.com/hashicorp/terraform-provider-aws/internal/flex"
 )
 
@@ -196,3 +202,170 @@ func TestDiffStringMaps(t *testing.T) {
 		}
 	}
 }
+
+func TestSetPolicyChangesDiff(t *testing.T) {
+	r := &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"policy": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"policy_changes": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+		},
+		CustomizeDiff: SetPolicyChangesDiff("policy"),
+	}
+
+	oldPolicy := `{"Version":"2012-10-17","Statement":[{"Sid":"Read","Effect":"Allow","Action":"s3:GetObject","Resource":"*"}]}`
+	newPolicy := `{"Version":"2012-10-17","Statement":[{"Sid":"Read","Effect":"Allow","Action":["s3:GetObject","s3:ListBucket"],"Resource":"*"}]}`
+
+	testCases := []struct {
+		Name          string
+		State         map[string]string
+		Policy        string
+		ExpectedDiff  bool
+		ExpectedValue []string
+	}{
+		{
+			Name: "change",
+			State: map[string]string{
+				"policy":           oldPolicy,
+				"policy_changes.#": "0",
+			},
+			Policy:        newPolicy,
+			ExpectedDiff:  true,
+			ExpectedValue: []string{`~ statement "Read": Action +s3:ListBucket`},
+		},
+		{
+			Name: "no change",
+			State: map[string]string{
+				"policy":           newPolicy,
+				"policy_changes.#": "0",
+			},
+			Policy: newPolicy,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			state := &terraform.InstanceState{
+				ID:         "test",
+				Attributes: testCase.State,
+			}
+			config := terraform.NewResourceConfigRaw(map[string]interface{}{
+				"policy": testCase.Policy,
+			})
+
+			diff, err := r.Diff(context.Background(), state, config, nil)
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if !testCase.ExpectedDiff {
+				if diff != nil && !diff.Empty() {
+					t.Fatalf("expected no diff, got %#v", diff.Attributes)
+				}
+
+				return
+			}
+
+			if diff == nil || diff.Empty() {
+				t.Fatal("expected diff, got none")
+			}
+
+			got := make([]string, 0)
+
+			for k, v := range diff.Attributes {
+				if k == "policy_changes.#" || !strings.HasPrefix(k, "policy_changes.") || v.NewRemoved {
+					continue
+				}
+
+				got = append(got, v.New)
+			}
+
+			if !reflect.DeepEqual(got, testCase.ExpectedValue) {
+				t.Errorf("got policy_changes %v, expected %v", got, testCase.ExpectedValue)
+			}
+
+			if v := diff.Attributes["policy_changes.#"]; v == nil || v.New != strconv.Itoa(len(testCase.ExpectedValue)) {
+				t.Errorf("got policy_changes.# diff %#v, expected %d", v, len(testCase.ExpectedValue))
+			}
+		})
+	}
+}
+
+func TestSetPolicyChangesDiff_emptyPlanAfterApply(t *testing.T) {
+	read := func(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+		d.Set("policy_changes", nil)
+
+		return nil
+	}
+	r := &schema.Resource{
+		ReadContext: read,
+		UpdateContext: func(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+			return read(ctx, d, meta)
+		},
+		Schema: map[string]*schema.Schema{
+			"policy": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"policy_changes": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+		},
+		CustomizeDiff: SetPolicyChangesDiff("policy"),
+	}
+
+	oldPolicy := `{"Version":"2012-10-17","Statement":[{"Sid":"Read","Effect":"Allow","Action":"s3:GetObject","Resource":"*"}]}`
+	newPolicy := `{"Version":"2012-10-17","Statement":[{"Sid":"Read","Effect":"Allow","Action":["s3:GetObject","s3:ListBucket"],"Resource":"*"}]}`
+
+	ctx := context.Background()
+	state := &terraform.InstanceState{
+		ID: "test",
+		Attributes: map[string]string{
+			"id":               "test",
+			"policy":           oldPolicy,
+			"policy_changes.#": "0",
+		},
+	}
+	config := terraform.NewResourceConfigRaw(map[string]interface{}{
+		"policy": newPolicy,
+	})
+
+	diff, err := r.Diff(ctx, state, config, nil)
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if diff == nil || diff.Attributes["policy_changes.#"] == nil {
+		t.Fatal("expected policy_changes diff, got none")
+	}
+
+	state, diags := r.Apply(ctx, state, diff, nil)
+
+	if diags.HasError() {
+		t.Fatalf("unexpected error: %v", diags)
+	}
+
+	if v := state.Attributes["policy_changes.#"]; v != "0" {
+		t.Errorf("got policy_changes.# %q in state, expected 0", v)
+	}
+
+	diff, err = r.Diff(ctx, state, config, nil)
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if diff != nil && !diff.Empty() {
+		t.Errorf("expected no diff, got %#v", diff.Attributes)
+	}
+}
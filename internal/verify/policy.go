@@ -0,0 +1,513 @@
+package verify
+
+import (
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+)
+
+// Statement elements in the order in which they are described.
+var policyStatementElements = []string{
+	"Sid",
+	"Effect",
+	"Principal",
+	"NotPrincipal",
+	"Action",
+	"NotAction",
+	"Resource",
+	"NotResource",
+	"Condition",
+}
+
+var policyRootPrincipalRegexp = regexp.MustCompile(`^arn:[^:]+:iam::(\d{12}):root$`)
+
+// policyStatement is a policy statement in canonical form.
+type policyStatement map[string]interface{}
+
+// NormalizePolicy returns a policy document in canonical form.
+// Statements are sorted, single values become lists, lists are sorted and de-duplicated,
+// AWS account root principals become account IDs and condition values become strings.
+// Equivalent policies that differ only in these respects have the same canonical form.
+func NormalizePolicy(policy string) (string, error) {
+	doc, statements, err := normalizePolicy(policy)
+
+	if err != nil {
+		return "", err
+	}
+
+	sorted := make([]string, len(statements))
+
+	for i, statement := range statements {
+		b, err := json.Marshal(statement)
+
+		if err != nil {
+			return "", err
+		}
+
+		sorted[i] = string(b)
+	}
+
+	sort.Strings(sorted)
+
+	raw := make([]json.RawMessage, len(sorted))
+
+	for i, v := range sorted {
+		raw[i] = json.RawMessage(v)
+	}
+
+	doc["Statement"] = raw
+
+	b, err := json.Marshal(doc)
+
+	if err != nil {
+		return "", err
+	}
+
+	return string(b), nil
+}
+
+// normalizePolicy decodes a policy document, returning the document's other elements and its statements in canonical form and original order.
+func normalizePolicy(policy string) (map[string]interface{}, []policyStatement, error) {
+	var doc map[string]interface{}
+
+	decoder := json.NewDecoder(strings.NewReader(policy))
+	decoder.UseNumber()
+
+	if err := decoder.Decode(&doc); err != nil {
+		return nil, nil, fmt.Errorf("decoding policy: %w", err)
+	}
+
+	if doc == nil {
+		return nil, nil, fmt.Errorf("decoding policy: not a JSON object")
+	}
+
+	var raw []interface{}
+
+	switch v := doc["Statement"].(type) {
+	case nil:
+	case map[string]interface{}:
+		raw = []interface{}{v}
+	case []interface{}:
+		raw = v
+	default:
+		return nil, nil, fmt.Errorf("decoding policy: Statement is %T, not an object or an array", v)
+	}
+
+	statements := make([]policyStatement, 0, len(raw))
+
+	for i, v := range raw {
+		m, ok := v.(map[string]interface{})
+
+		if !ok {
+			return nil, nil, fmt.Errorf("decoding policy: statement %d is %T, not an object", i, v)
+		}
+
+		statement := make(policyStatement, len(m))
+
+		for k, v := range m {
+			switch k {
+			case "Action", "NotAction", "Resource", "NotResource":
+				statement[k] = normalizePolicyStrings(v)
+			case "Principal", "NotPrincipal":
+				statement[k] = normalizePolicyPrincipal(v)
+			case "Condition":
+				statement[k] = normalizePolicyCondition(v)
+			default:
+				statement[k] = v
+			}
+		}
+
+		statements = append(statements, statement)
+	}
+
+	delete(doc, "Statement")
+
+	return doc, statements, nil
+}
+
+// normalizePolicyStrings returns a sorted list of the unique string forms of a value or list of values.
+func normalizePolicyStrings(v interface{}) []string {
+	var values []string
+
+	switch v := v.(type) {
+	case []interface{}:
+		for _, v := range v {
+			values = append(values, policyString(v))
+		}
+	case nil:
+	default:
+		values = append(values, policyString(v))
+	}
+
+	sort.Strings(values)
+
+	unique := values[:0]
+
+	for i, v := range values {
+		if i == 0 || v != values[i-1] {
+			unique = append(unique, v)
+		}
+	}
+
+	return unique
+}
+
+func normalizePolicyPrincipal(v interface{}) interface{} {
+	m, ok := v.(map[string]interface{})
+
+	if !ok {
+		return v
+	}
+
+	principal := make(map[string][]string, len(m))
+
+	for k, v := range m {
+		values := normalizePolicyStrings(v)
+
+		if k == "AWS" {
+			for i, v := range values {
+				if m := policyRootPrincipalRegexp.FindStringSubmatch(v); m != nil {
+					values[i] = m[1]
+				}
+			}
+
+			values = normalizePolicyStrings(stringsToInterfaces(values))
+		}
+
+		principal[k] = values
+	}
+
+	return principal
+}
+
+func normalizePolicyCondition(v interface{}) interface{} {
+	m, ok := v.(map[string]interface{})
+
+	if !ok {
+		return v
+	}
+
+	condition := make(map[string]map[string][]string, len(m))
+
+	for operator, keys := range m {
+		keys, ok := keys.(map[string]interface{})
+
+		if !ok {
+			return m
+		}
+
+		condition[operator] = make(map[string][]string, len(keys))
+
+		for key, v := range keys {
+			condition[operator][key] = normalizePolicyStrings(v)
+		}
+	}
+
+	return condition
+}
+
+func policyString(v interface{}) string {
+	switch v := v.(type) {
+	case string:
+		return v
+	case json.Number:
+		return v.String()
+	case bool:
+		return fmt.Sprintf("%t", v)
+	default:
+		b, _ := json.Marshal(v)
+
+		return string(b)
+	}
+}
+
+func stringsToInterfaces(ss []string) []interface{} {
+	vs := make([]interface{}, len(ss))
+
+	for i, s := range ss {
+		vs[i] = s
+	}
+
+	return vs
+}
+
+// PolicyStatementChange describes how a policy statement changed.
+type PolicyStatementChange struct {
+	// Type is "added", "removed" or "modified".
+	Type string
+	// Statement identifies the statement by Sid or, if it has none, by its zero-based index in the new (or, if removed, the old) policy.
+	Statement string
+	// Details describes the statement if added or removed, or each changed element if modified.
+	Details []string
+}
+
+func (c PolicyStatementChange) String() string {
+	var symbol string
+
+	switch c.Type {
+	case "added":
+		symbol = "+"
+	case "removed":
+		symbol = "-"
+	default:
+		symbol = "~"
+	}
+
+	return fmt.Sprintf("%s %s: %s", symbol, c.Statement, strings.Join(c.Details, "; "))
+}
+
+// PolicyStatementChanges returns the statement-level changes between two policy documents.
+// Statements are matched by Sid, then by equivalence, then to a statement that differs in one element only.
+// An empty policy has no statements.
+func PolicyStatementChanges(old, new string) ([]PolicyStatementChange, error) {
+	oldStatements, err := policyStatementsForChanges(old)
+
+	if err != nil {
+		return nil, err
+	}
+
+	newStatements, err := policyStatementsForChanges(new)
+
+	if err != nil {
+		return nil, err
+	}
+
+	oldElements := make([]map[string][]string, len(oldStatements))
+	for i, statement := range oldStatements {
+		oldElements[i] = policyStatementElementValues(statement)
+	}
+
+	newElements := make([]map[string][]string, len(newStatements))
+	for i, statement := range newStatements {
+		newElements[i] = policyStatementElementValues(statement)
+	}
+
+	// pairs maps the index of each matched new statement to the index of its old statement.
+	pairs := make(map[int]int)
+	matched := make(map[int]bool)
+
+	match := func(accept func(o, n int) bool) {
+		for n := range newElements {
+			if _, ok := pairs[n]; ok {
+				continue
+			}
+
+			for o := range oldElements {
+				if !matched[o] && accept(o, n) {
+					pairs[n] = o
+					matched[o] = true
+
+					break
+				}
+			}
+		}
+	}
+
+	match(func(o, n int) bool {
+		sid := policyElementString(newElements[n], "Sid")
+
+		return sid != "" && sid == policyElementString(oldElements[o], "Sid")
+	})
+	match(func(o, n int) bool {
+		return len(policyElementsChanged(oldElements[o], newElements[n])) == 0
+	})
+	match(func(o, n int) bool {
+		return len(policyElementsChanged(oldElements[o], newElements[n])) == 1
+	})
+
+	var changes []PolicyStatementChange
+
+	for n, elements := range newElements {
+		o, ok := pairs[n]
+
+		if !ok {
+			changes = append(changes, PolicyStatementChange{
+				Type:      "added",
+				Statement: policyStatementLabel(elements, n),
+				Details:   policyStatementDescription(elements),
+			})
+
+			continue
+		}
+
+		var details []string
+
+		for _, k := range policyElementsChanged(oldElements[o], elements) {
+			added, removed := policyElementValuesDiff(oldElements[o][k], elements[k])
+			detail := k
+
+			for _, v := range added {
+				detail += " +" + v
+			}
+
+			for _, v := range removed {
+				detail += " -" + v
+			}
+
+			details = append(details, detail)
+		}
+
+		if len(details) > 0 {
+			changes = append(changes, PolicyStatementChange{
+				Type:      "modified",
+				Statement: policyStatementLabel(elements, n),
+				Details:   details,
+			})
+		}
+	}
+
+	for o, elements := range oldElements {
+		if !matched[o] {
+			changes = append(changes, PolicyStatementChange{
+				Type:      "removed",
+				Statement: policyStatementLabel(elements, o),
+				Details:   policyStatementDescription(elements),
+			})
+		}
+	}
+
+	return changes, nil
+}
+
+func policyStatementsForChanges(policy string) ([]policyStatement, error) {
+	if v := strings.TrimSpace(policy); v == "" || v == "{}" {
+		return nil, nil
+	}
+
+	_, statements, err := normalizePolicy(policy)
+
+	return statements, err
+}
+
+// policyStatementElementValues flattens each element of a statement in canonical form to a list of strings.
+func policyStatementElementValues(statement policyStatement) map[string][]string {
+	elements := make(map[string][]string, len(statement))
+
+	for k, v := range statement {
+		if k == "Sid" && v == "" {
+			continue
+		}
+
+		switch v := v.(type) {
+		case []string:
+			elements[k] = v
+		case map[string][]string:
+			for typ, values := range v {
+				for _, value := range values {
+					elements[k] = append(elements[k], typ+":"+value)
+				}
+			}
+		case map[string]map[string][]string:
+			for operator, keys := range v {
+				for key, values := range keys {
+					for _, value := range values {
+						elements[k] = append(elements[k], fmt.Sprintf("%s %s=%s", operator, key, value))
+					}
+				}
+			}
+		default:
+			elements[k] = []string{policyString(v)}
+		}
+
+		sort.Strings(elements[k])
+	}
+
+	return elements
+}
+
+// policyElementsChanged returns the names of the elements that differ between two statements, in description order.
+func policyElementsChanged(old, new map[string][]string) []string {
+	var changed []string
+
+	for _, k := range policyElementNames(old, new) {
+		if strings.Join(old[k], "\x00") != strings.Join(new[k], "\x00") || (old[k] == nil) != (new[k] == nil) {
+			changed = append(changed, k)
+		}
+	}
+
+	return changed
+}
+
+// policyElementNames returns the names of the elements of the statements, known elements first.
+func policyElementNames(statements ...map[string][]string) []string {
+	var names, others []string
+
+	for _, k := range policyStatementElements {
+		for _, statement := range statements {
+			if _, ok := statement[k]; ok {
+				names = append(names, k)
+
+				break
+			}
+		}
+	}
+
+	seen := make(map[string]bool)
+
+	for _, statement := range statements {
+		for k := range statement {
+			if !seen[k] && !stringInSlice(policyStatementElements, k) {
+				seen[k] = true
+				others = append(others, k)
+			}
+		}
+	}
+
+	sort.Strings(others)
+
+	return append(names, others...)
+}
+
+func policyElementValuesDiff(old, new []string) (added, removed []string) {
+	for _, v := range new {
+		if !stringInSlice(old, v) {
+			added = append(added, v)
+		}
+	}
+
+	for _, v := range old {
+		if !stringInSlice(new, v) {
+			removed = append(removed, v)
+		}
+	}
+
+	return added, removed
+}
+
+func policyElementString(elements map[string][]string, k string) string {
+	return strings.Join(elements[k], ",")
+}
+
+func policyStatementLabel(elements map[string][]string, i int) string {
+	if sid := policyElementString(elements, "Sid"); sid != "" {
+		return fmt.Sprintf("statement %q", sid)
+	}
+
+	return fmt.Sprintf("statement %d", i)
+}
+
+func policyStatementDescription(elements map[string][]string) []string {
+	var description []string
+
+	for _, k := range policyElementNames(elements) {
+		if k == "Sid" {
+			continue
+		}
+
+		description = append(description, fmt.Sprintf("%s %s", k, strings.Join(elements[k], ", ")))
+	}
+
+	return description
+}
+
+func stringInSlice(ss []string, s string) bool {
+	for _, v := range ss {
+		if v == s {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,135 @@
+package verify
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestNormalizePolicy(t *testing.T) {
+	testCases := []struct {
+		Name          string
+		Policy1       string
+		Policy2       string
+		ExpectedEqual bool
+	}{
+		{
+			Name: "statement order, single values and principals",
+			Policy1: `{
+  "Version": "2012-10-17",
+  "Statement": [
+    {"Effect": "Allow", "Action": "s3:GetObject", "Resource": "arn:aws:s3:::b/*", "Principal": {"AWS": "123456789012"}},
+    {"Sid": "Deny", "Effect": "Deny", "Action": ["s3:DeleteObject", "s3:PutObject"], "Resource": "*", "Principal": "*"}
+  ]
+}`,
+			Policy2:       `{"Statement": [{"Sid": "Deny", "Effect": "Deny", "Action": ["s3:PutObject", "s3:DeleteObject", "s3:PutObject"], "Resource": ["*"], "Principal": "*"}, {"Effect": "Allow", "Action": ["s3:GetObject"], "Resource": ["arn:aws:s3:::b/*"], "Principal": {"AWS": ["arn:aws:iam::123456789012:root"]}}], "Version": "2012-10-17"}`,
+			ExpectedEqual: true,
+		},
+		{
+			Name:          "condition values",
+			Policy1:       `{"Version": "2012-10-17", "Statement": {"Effect": "Allow", "Action": "s3:*", "Resource": "*", "Condition": {"Bool": {"aws:SecureTransport": true}, "NumericLessThan": {"s3:max-keys": 10}}}}`,
+			Policy2:       `{"Version": "2012-10-17", "Statement": [{"Effect": "Allow", "Action": "s3:*", "Resource": "*", "Condition": {"NumericLessThan": {"s3:max-keys": ["10"]}, "Bool": {"aws:SecureTransport": "true"}}}]}`,
+			ExpectedEqual: true,
+		},
+		{
+			Name:    "different actions",
+			Policy1: `{"Version": "2012-10-17", "Statement": {"Effect": "Allow", "Action": "s3:GetObject", "Resource": "*"}}`,
+			Policy2: `{"Version": "2012-10-17", "Statement": {"Effect": "Allow", "Action": "s3:PutObject", "Resource": "*"}}`,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			n1, err := NormalizePolicy(testCase.Policy1)
+
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			n2, err := NormalizePolicy(testCase.Policy2)
+
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if equal := n1 == n2; equal != testCase.ExpectedEqual {
+				t.Errorf("got equal %t, expected %t:\n%s\n%s", equal, testCase.ExpectedEqual, n1, n2)
+			}
+		})
+	}
+}
+
+func TestNormalizePolicyInvalid(t *testing.T) {
+	for _, policy := range []string{`[]`, `{`, `{"Statement": "x"}`, `{"Statement": ["x"]}`} {
+		if _, err := NormalizePolicy(policy); err == nil {
+			t.Errorf("%s: expected error", policy)
+		}
+	}
+}
+
+func TestPolicyStatementChanges(t *testing.T) {
+	testCases := []struct {
+		Name     string
+		Old      string
+		New      string
+		Expected []string
+	}{
+		{
+			Name: "equivalent",
+			Old:  `{"Version": "2012-10-17", "Statement": [{"Effect": "Allow", "Action": "s3:GetObject", "Resource": "*"}, {"Effect": "Deny", "Action": "s3:*", "Resource": "arn:aws:s3:::b"}]}`,
+			New:  `{"Version": "2012-10-17", "Statement": [{"Effect": "Deny", "Action": ["s3:*"], "Resource": "arn:aws:s3:::b"}, {"Effect": "Allow", "Action": ["s3:GetObject"], "Resource": "*"}]}`,
+		},
+		{
+			Name: "create",
+			Old:  "",
+			New:  `{"Version": "2012-10-17", "Statement": [{"Sid": "Read", "Effect": "Allow", "Action": "s3:GetObject", "Resource": "*"}]}`,
+			Expected: []string{
+				`+ statement "Read": Effect Allow; Action s3:GetObject; Resource *`,
+			},
+		},
+		{
+			Name: "modified by Sid",
+			Old:  `{"Version": "2012-10-17", "Statement": [{"Sid": "Read", "Effect": "Allow", "Action": ["s3:GetObject", "s3:ListBucket"], "Resource": "*"}]}`,
+			New:  `{"Version": "2012-10-17", "Statement": [{"Sid": "Read", "Effect": "Allow", "Action": ["s3:GetObject", "s3:GetObjectVersion"], "Resource": "*", "Condition": {"Bool": {"aws:SecureTransport": "true"}}}]}`,
+			Expected: []string{
+				`~ statement "Read": Action +s3:GetObjectVersion -s3:ListBucket; Condition +Bool aws:SecureTransport=true`,
+			},
+		},
+		{
+			Name: "modified without Sid",
+			Old:  `{"Version": "2012-10-17", "Statement": [{"Effect": "Allow", "Action": "s3:GetObject", "Resource": "*"}, {"Effect": "Allow", "Action": "sqs:SendMessage", "Resource": "*", "Principal": {"Service": "sns.amazonaws.com"}}]}`,
+			New:  `{"Version": "2012-10-17", "Statement": [{"Effect": "Allow", "Action": "sqs:SendMessage", "Resource": "*", "Principal": {"Service": ["sns.amazonaws.com", "events.amazonaws.com"]}}, {"Effect": "Allow", "Action": "s3:GetObject", "Resource": "*"}]}`,
+			Expected: []string{
+				`~ statement 0: Principal +Service:events.amazonaws.com`,
+			},
+		},
+		{
+			Name: "added and removed",
+			Old:  `{"Version": "2012-10-17", "Statement": [{"Effect": "Allow", "Action": "s3:GetObject", "Resource": "*"}]}`,
+			New:  `{"Version": "2012-10-17", "Statement": [{"Effect": "Deny", "Action": "s3:PutObject", "Resource": "arn:aws:s3:::b/*"}]}`,
+			Expected: []string{
+				`+ statement 0: Effect Deny; Action s3:PutObject; Resource arn:aws:s3:::b/*`,
+				`- statement 0: Effect Allow; Action s3:GetObject; Resource *`,
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			changes, err := PolicyStatementChanges(testCase.Old, testCase.New)
+
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			var got []string
+
+			for _, change := range changes {
+				got = append(got, change.String())
+			}
+
+			if strings.Join(got, "\n") != strings.Join(testCase.Expected, "\n") {
+				t.Errorf("got changes:\n%s\nexpected:\n%s", strings.Join(got, "\n"), strings.Join(testCase.Expected, "\n"))
+			}
+		})
+	}
+}
@@ -1,6 +1,8 @@
 package verify
 
 import (
+	"regexp"
+
 	"github.com/aws/aws-sdk-go/aws/endpoints"
 	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
 	"gopkg.in/yaml.v2"
@@ -46,6 +48,7 @@ const (
 	ErrCodeInvalidRequest              = "InvalidRequest"
 	ErrCodeOperationDisabledException  = "OperationDisabledException"
 	ErrCodeOperationNotPermitted       = "OperationNotPermitted"
+	ErrCodeUnauthorizedOperation       = "UnauthorizedOperation"
 	ErrCodeUnknownOperationException   = "UnknownOperationException"
 	ErrCodeUnsupportedFeatureException = "UnsupportedFeatureException"
 	ErrCodeUnsupportedOperation        = "UnsupportedOperation"
@@ -130,3 +133,25 @@ func ErrorISOUnsupported(partition string, err error) bool {
 
 	return false
 }
+
+// tagAuthorizationErrorRegexp matches authorization error messages that name a tagging action, e.g. "sns:TagResource",
+// "iam:TagRole", "ec2:CreateTags" or "elasticloadbalancing:AddTags", or a tag condition key.
+var tagAuthorizationErrorRegexp = regexp.MustCompile(`[a-z0-9-]+:(AddTags|CreateTags|Tag)[A-Za-z]*|aws:(RequestTag|TagKeys)`)
+
+// ErrorCreateWithTagsDenied checks whether an error returned by a Create call that included tags
+// suggests that the call was denied because of the tags, so that the resource should be created
+// without tags and tagged after creation.
+// Besides the errors returned by partitions that don't support tag-on-create, the call is denied in any
+// partition if the caller isn't authorized to tag on create. Only authorization errors whose message names
+// a tagging action or tag condition key are matched, so that other authorization errors aren't retried without tags.
+func ErrorCreateWithTagsDenied(partition string, err error) bool {
+	if ErrorISOUnsupported(partition, err) {
+		return true
+	}
+
+	if !tfawserr.ErrCodeContains(err, ErrCodeAccessDenied) && !tfawserr.ErrCodeContains(err, ErrCodeUnauthorizedOperation) {
+		return false
+	}
+
+	return tagAuthorizationErrorRegexp.MatchString(err.Error())
+}
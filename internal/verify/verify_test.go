@@ -1,7 +1,11 @@
 package verify
 
 import (
+	"errors"
 	"testing"
+
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/endpoints"
 )
 
 func TestCheckYAMLString(t *testing.T) {
@@ -39,3 +43,134 @@ abc:
 		t.Fatalf("Got:\n\n%s\n\nExpected:\n\n%s\n", actual, invalidYaml)
 	}
 }
+
+func TestErrorISOUnsupported(t *testing.T) {
+	testCases := []struct {
+		Name      string
+		Partition string
+		Err       error
+		Expected  bool
+	}{
+		{
+			Name:      "no error",
+			Partition: endpoints.AwsIsoPartitionID,
+		},
+		{
+			Name:      "commercial partition",
+			Partition: endpoints.AwsPartitionID,
+			Err:       awserr.New(ErrCodeAccessDenied, "not authorized to tag on create", nil),
+		},
+		{
+			Name:      "access denied",
+			Partition: endpoints.AwsIsoPartitionID,
+			Err:       awserr.New(ErrCodeAccessDenied, "not authorized to tag on create", nil),
+			Expected:  true,
+		},
+		{
+			Name:      "tagging not supported",
+			Partition: endpoints.AwsIsoBPartitionID,
+			Err:       awserr.New(ErrCodeValidationError, "The service does not support tagging", nil),
+			Expected:  true,
+		},
+		{
+			Name:      "unrelated AWS error",
+			Partition: endpoints.AwsIsoPartitionID,
+			Err:       awserr.New("ResourceNotFoundException", "not found", nil),
+		},
+		{
+			Name:      "non-AWS error",
+			Partition: endpoints.AwsIsoPartitionID,
+			Err:       errors.New("connection reset"),
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			if got := ErrorISOUnsupported(testCase.Partition, testCase.Err); got != testCase.Expected {
+				t.Errorf("got %t, expected %t", got, testCase.Expected)
+			}
+		})
+	}
+}
+
+func TestErrorCreateWithTagsDenied(t *testing.T) {
+	testCases := []struct {
+		Name      string
+		Partition string
+		Err       error
+		Expected  bool
+	}{
+		{
+			Name:      "no error",
+			Partition: endpoints.AwsPartitionID,
+		},
+		{
+			Name:      "access denied",
+			Partition: endpoints.AwsPartitionID,
+			Err:       awserr.New(ErrCodeAccessDenied, "not authorized to perform: route53-recovery-readiness:TagResource", nil),
+			Expected:  true,
+		},
+		{
+			Name:      "access denied exception",
+			Partition: endpoints.AwsPartitionID,
+			Err:       awserr.New("AccessDeniedException", "not authorized to perform: sns:TagResource", nil),
+			Expected:  true,
+		},
+		{
+			Name:      "access denied to create",
+			Partition: endpoints.AwsPartitionID,
+			Err:       awserr.New(ErrCodeAccessDenied, "User: arn:aws:iam::123456789012:user/test is not authorized to perform: sns:CreateTopic", nil),
+		},
+		{
+			Name:      "access denied by service control policy",
+			Partition: endpoints.AwsPartitionID,
+			Err:       awserr.New("AccessDeniedException", "User is not authorized to perform: sqs:createqueue with an explicit deny in a service control policy", nil),
+		},
+		{
+			Name:      "access denied tag condition key",
+			Partition: endpoints.AwsPartitionID,
+			Err:       awserr.New(ErrCodeAccessDenied, "not authorized because no identity-based policy allows aws:RequestTag/Owner", nil),
+			Expected:  true,
+		},
+		{
+			Name:      "unauthorized operation",
+			Partition: endpoints.AwsPartitionID,
+			Err:       awserr.New(ErrCodeUnauthorizedOperation, "You are not authorized to perform this operation.", nil),
+		},
+		{
+			Name:      "unauthorized tagging operation",
+			Partition: endpoints.AwsPartitionID,
+			Err:       awserr.New(ErrCodeUnauthorizedOperation, "You are not authorized to perform: ec2:CreateTags", nil),
+			Expected:  true,
+		},
+		{
+			Name:      "tagging not supported in ISO partition",
+			Partition: endpoints.AwsIsoBPartitionID,
+			Err:       awserr.New(ErrCodeValidationError, "The service does not support tagging", nil),
+			Expected:  true,
+		},
+		{
+			Name:      "validation error in commercial partition",
+			Partition: endpoints.AwsPartitionID,
+			Err:       awserr.New(ErrCodeValidationException, "invalid name", nil),
+		},
+		{
+			Name:      "unrelated AWS error",
+			Partition: endpoints.AwsPartitionID,
+			Err:       awserr.New("ResourceNotFoundException", "not found", nil),
+		},
+		{
+			Name:      "non-AWS error",
+			Partition: endpoints.AwsPartitionID,
+			Err:       errors.New("connection reset"),
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			if got := ErrorCreateWithTagsDenied(testCase.Partition, testCase.Err); got != testCase.Expected {
+				t.Errorf("got %t, expected %t", got, testCase.Expected)
+			}
+		})
+	}
+}
@@ -6,6 +6,7 @@ import (
 	"log"
 
 	"github.com/hashicorp/terraform-plugin-go/tfprotov5/tf5server"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/provider"
 )
 
@@ -35,6 +36,11 @@ func main() {
 		serveOpts...,
 	)
 
+	// Serve returns once Terraform has finished with the provider.
+	// The summary is only logged if Terraform shuts the plugin down gracefully;
+	// it is lost if the plugin process is killed, for example when Terraform is interrupted.
+	conns.LogThrottlingSummary()
+
 	if err != nil {
 		log.Fatal(err)
 	}
@@ -11,13 +11,14 @@ var (
 	name          string
 	force         bool
 	v1            bool
+	framework     bool
 )
 
 var resourceCmd = &cobra.Command{
 	Use:   "resource",
 	Short: "Create scaffolding for a resource",
 	RunE: func(cmd *cobra.Command, args []string) error {
-		return resource.Create(name, snakeName, !clearComments, force, !v1)
+		return resource.Create(name, snakeName, !clearComments, force, !v1, framework)
 	},
 }
 
@@ -28,4 +29,5 @@ func init() {
 	resourceCmd.Flags().StringVarP(&name, "name", "n", "", "name of the entity")
 	resourceCmd.Flags().BoolVarP(&force, "force", "f", false, "force creation, overwriting existing files")
 	resourceCmd.Flags().BoolVarP(&v1, "v1", "o", false, "generate for AWS Go SDK v1 (some existing services)")
+	resourceCmd.Flags().BoolVarP(&framework, "framework", "w", false, "generate a Terraform Plugin Framework resource, with its sweeper and registration")
 }
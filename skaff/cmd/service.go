@@ -0,0 +1,38 @@
+package cmd
+
+import (
+	"github.com/hashicorp/terraform-provider-aws/skaff/service"
+	"github.com/spf13/cobra"
+)
+
+var serviceData service.TemplateData
+
+var serviceCmd = &cobra.Command{
+	Use:   "service",
+	Short: "Create scaffolding for a service package",
+	RunE: func(cmd *cobra.Command, args []string) error {
+		serviceData.AWSGoSDKV2 = !v1
+		if serviceData.GoV2Package == "" && serviceData.AWSGoSDKV2 {
+			serviceData.GoV2Package = serviceData.ProviderPackage
+		}
+		if serviceData.GoV1Package == "" && !serviceData.AWSGoSDKV2 {
+			serviceData.GoV1Package = serviceData.ProviderPackage
+		}
+
+		return service.Create(serviceData, force)
+	},
+}
+
+func init() {
+	rootCmd.AddCommand(serviceCmd)
+	serviceCmd.Flags().StringVarP(&serviceData.ProviderPackage, "package", "p", "", "service identifier and name of the service package (e.g., sesv2)")
+	serviceCmd.Flags().StringVarP(&serviceData.ProviderNameUpper, "name", "n", "", "correctly capitalized service identifier (e.g., SESV2)")
+	serviceCmd.Flags().StringVarP(&serviceData.HumanFriendly, "human-friendly", "u", "", "human-friendly name of the service as used by AWS (e.g., \"SESv2 (Simple Email V2)\")")
+	serviceCmd.Flags().StringVarP(&serviceData.Brand, "brand", "b", "AWS", "either AWS, Amazon or blank, as used by AWS")
+	serviceCmd.Flags().StringVarP(&serviceData.AWSCLIV2Command, "cli", "l", "", "AWS CLI v2 service command, if not the package (e.g., ses-v2)")
+	serviceCmd.Flags().StringVar(&serviceData.GoV1Package, "go-v1-package", "", "AWS SDK for Go v1 package name, if not the package")
+	serviceCmd.Flags().StringVar(&serviceData.GoV2Package, "go-v2-package", "", "AWS SDK for Go v2 package name, if not the package")
+	serviceCmd.Flags().StringVar(&serviceData.GoV1ClientTypeName, "go-v1-client-type", "", "exact name of the AWS SDK for Go v1 client type (e.g., SESV2)")
+	serviceCmd.Flags().BoolVarP(&force, "force", "f", false, "force creation, overwriting existing files")
+	serviceCmd.Flags().BoolVarP(&v1, "v1", "o", false, "generate for AWS Go SDK v1 (some existing services)")
+}
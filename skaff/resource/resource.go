@@ -5,6 +5,7 @@ import (
 	_ "embed"
 	"errors"
 	"fmt"
+	"io"
 	"io/fs"
 	"os"
 	"path/filepath"
@@ -24,6 +25,20 @@ var resourceTestTmpl string
 //go:embed websitedoc.tmpl
 var websiteTmpl string
 
+//go:embed frameworkresource.tmpl
+var frameworkResourceTmpl string
+
+//go:embed sweepfile.tmpl
+var sweepFileTmpl string
+
+//go:embed sweeper.tmpl
+var sweeperTmpl string
+
+const (
+	// servicePackageDataDirective is the generate directive for the service package data with which Plugin Framework resources are registered.
+	servicePackageDataDirective = "//go:generate go run ../../generate/servicepackagedata/main.go"
+)
+
 type TemplateData struct {
 	Resource             string
 	ResourceLower        string
@@ -35,6 +50,7 @@ type TemplateData struct {
 	ServiceLower         string
 	AWSServiceName       string
 	AWSGoSDKV2           bool
+	Framework            bool
 	HumanResourceName    string
 }
 
@@ -58,7 +74,7 @@ func HumanResName(upper string) string {
 	return strings.TrimPrefix(re2.ReplaceAllString(upper, ` $1`), " ")
 }
 
-func Create(resName, snakeName string, comments, force, v2 bool) error {
+func Create(resName, snakeName string, comments, force, v2, framework bool) error {
 	wd, err := os.Getwd() // os.Getenv("GOPACKAGE") not available since this is not run with go generate
 	if err != nil {
 		return fmt.Errorf("error reading working directory: %s", err)
@@ -106,11 +122,17 @@ func Create(resName, snakeName string, comments, force, v2 bool) error {
 		ServiceLower:         strings.ToLower(s),
 		AWSServiceName:       sn,
 		AWSGoSDKV2:           v2,
+		Framework:            framework,
 		HumanResourceName:    HumanResName(resName),
 	}
 
+	tmpl := resourceTmpl
+	if framework {
+		tmpl = frameworkResourceTmpl
+	}
+
 	f := fmt.Sprintf("%s.go", snakeName)
-	if err = writeTemplate("newres", f, resourceTmpl, force, templateData); err != nil {
+	if err = writeTemplate("newres", f, tmpl, force, templateData); err != nil {
 		return fmt.Errorf("writing resource template: %w", err)
 	}
 
@@ -125,6 +147,99 @@ func Create(resName, snakeName string, comments, force, v2 bool) error {
 		return fmt.Errorf("writing resource website doc template: %w", err)
 	}
 
+	if framework {
+		if err = createFrameworkRegistration(templateData); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// createFrameworkRegistration adds a Plugin Framework resource's sweeper and test exports to the service package
+// and ensures that the service package data, with which the resource registers itself, is generated.
+func createFrameworkRegistration(td TemplateData) error {
+	var sweeper bytes.Buffer
+
+	if _, err := os.Stat("sweep.go"); errors.Is(err, fs.ErrNotExist) {
+		if err := executeTemplate(&sweeper, "sweepfile", sweepFileTmpl, td); err != nil {
+			return fmt.Errorf("writing sweeper template: %w", err)
+		}
+	} else {
+		fmt.Println(`Added the resource's sweeper to the existing sweep.go. Check its imports, e.g. with "goimports -w sweep.go".`)
+	}
+
+	if err := executeTemplate(&sweeper, "sweeper", sweeperTmpl, td); err != nil {
+		return fmt.Errorf("writing sweeper template: %w", err)
+	}
+
+	if err := appendToFile("sweep.go", sweeper.String()); err != nil {
+		return fmt.Errorf("writing sweeper: %w", err)
+	}
+
+	exports := fmt.Sprintf("var Resource%[1]s = newResource%[1]s\n", td.Resource)
+
+	if _, err := os.Stat("exports_test.go"); errors.Is(err, fs.ErrNotExist) {
+		exports = fmt.Sprintf("package %s\n\n// Exports for use in tests only.\n", td.ServicePackage) + exports
+	}
+
+	if err := appendToFile("exports_test.go", exports); err != nil {
+		return fmt.Errorf("writing test exports: %w", err)
+	}
+
+	b, err := os.ReadFile("generate.go")
+
+	if err != nil && !errors.Is(err, fs.ErrNotExist) {
+		return fmt.Errorf("reading generate.go: %w", err)
+	}
+
+	if !strings.Contains(string(b), servicePackageDataDirective) {
+		contents := servicePackageDataDirective + "\n"
+
+		if len(b) == 0 {
+			contents += fmt.Sprintf("// ONLY generate directives and package declaration! Do not add anything else to this file.\n\npackage %s\n", td.ServicePackage)
+		} else {
+			contents += string(b)
+		}
+
+		if err := os.WriteFile("generate.go", []byte(contents), 0644); err != nil {
+			return fmt.Errorf("writing generate.go: %w", err)
+		}
+
+		fmt.Println(`Added the service package data generate directive to generate.go. Run "make gen" to register the resource with the provider.`)
+	}
+
+	return nil
+}
+
+func appendToFile(filename, contents string) error {
+	f, err := os.OpenFile(filename, os.O_WRONLY|os.O_CREATE|os.O_APPEND, 0644)
+	if err != nil {
+		return fmt.Errorf("error opening file (%s): %s", filename, err)
+	}
+
+	if _, err := f.WriteString(contents); err != nil {
+		f.Close() // ignore error; Write error takes precedence
+		return fmt.Errorf("error writing to file (%s): %s", filename, err)
+	}
+
+	if err := f.Close(); err != nil {
+		return fmt.Errorf("error closing file (%s): %s", filename, err)
+	}
+
+	return nil
+}
+
+func executeTemplate(w io.Writer, templateName, tmpl string, td TemplateData) error {
+	tplate, err := template.New(templateName).Parse(tmpl)
+	if err != nil {
+		return fmt.Errorf("error parsing template: %s", err)
+	}
+
+	if err := tplate.Execute(w, td); err != nil {
+		return fmt.Errorf("error executing template: %s", err)
+	}
+
 	return nil
 }
 
@@ -1,6 +1,11 @@
 package resource
 
 import (
+	"go/parser"
+	"go/token"
+	"os"
+	"path/filepath"
+	"strings"
 	"testing"
 )
 
@@ -101,3 +106,88 @@ func TestHumanResName(t *testing.T) {
 		})
 	}
 }
+
+func TestCreateFramework(t *testing.T) {
+	testCases := []struct {
+		TestName       string
+		ServicePackage string
+		V2             bool
+	}{
+		{
+			TestName:       "AWS SDK for Go v1",
+			ServicePackage: "mq",
+			V2:             false,
+		},
+		{
+			TestName:       "AWS SDK for Go v2",
+			ServicePackage: "comprehend",
+			V2:             true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.TestName, func(t *testing.T) {
+			root := t.TempDir()
+			dir := filepath.Join(root, "internal", "service", testCase.ServicePackage)
+
+			for _, d := range []string{dir, filepath.Join(root, "website", "docs", "r")} {
+				if err := os.MkdirAll(d, 0755); err != nil {
+					t.Fatal(err)
+				}
+			}
+
+			chdir(t, dir)
+
+			for _, name := range []string{"Widget", "Gadget"} {
+				if err := Create(name, "", true, false, testCase.V2, true); err != nil {
+					t.Fatalf("creating %s: %s", name, err)
+				}
+			}
+
+			for _, filename := range []string{"widget.go", "widget_test.go", "gadget.go", "gadget_test.go", "sweep.go", "exports_test.go", "generate.go"} {
+				if _, err := parser.ParseFile(token.NewFileSet(), filename, nil, parser.AllErrors); err != nil {
+					t.Errorf("parsing generated %s: %s", filename, err)
+				}
+			}
+
+			b, err := os.ReadFile("generate.go")
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if got := strings.Count(string(b), servicePackageDataDirective); got != 1 {
+				t.Errorf("got %d service package data generate directives, expected 1", got)
+			}
+
+			b, err = os.ReadFile("sweep.go")
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			for _, expected := range []string{"//go:build sweep", "func sweepWidgets(", "func sweepGadgets("} {
+				if !strings.Contains(string(b), expected) {
+					t.Errorf("sweep.go does not contain %q", expected)
+				}
+			}
+		})
+	}
+}
+
+func chdir(t *testing.T, dir string) {
+	t.Helper()
+
+	wd, err := os.Getwd()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if err := os.Chdir(dir); err != nil {
+		t.Fatal(err)
+	}
+
+	t.Cleanup(func() {
+		if err := os.Chdir(wd); err != nil {
+			t.Fatal(err)
+		}
+	})
+}
@@ -0,0 +1,242 @@
+package service
+
+import (
+	"bytes"
+	_ "embed"
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+	"text/template"
+)
+
+//go:embed generate.tmpl
+var generateTmpl string
+
+//go:embed readme.tmpl
+var readmeTmpl string
+
+const (
+	namesDataFile = "names/names_data.csv"
+
+	// Indexes of names_data.csv columns. See names/README.md.
+	colAWSCLIV2Command         = 0
+	colAWSCLIV2CommandNoDashes = 1
+	colGoV1Package             = 2
+	colGoV2Package             = 3
+	colProviderPackageActual   = 4
+	colProviderPackageCorrect  = 5
+	colProviderNameUpper       = 8
+	colGoV1ClientTypeName      = 9
+	colSDKVersion              = 11
+	colResourcePrefixCorrect   = 13
+	colDocPrefix               = 15
+	colHumanFriendly           = 16
+	colBrand                   = 17
+	colNote                    = 22
+)
+
+type TemplateData struct {
+	AWSCLIV2Command    string
+	AWSGoSDKV2         bool
+	Brand              string
+	GoV1ClientTypeName string
+	GoV1Package        string
+	GoV2Package        string
+	HumanFriendly      string
+	ProviderNameUpper  string
+	ProviderPackage    string
+}
+
+func Create(td TemplateData, force bool) error {
+	if err := check(td); err != nil {
+		return fmt.Errorf("error checking: %w", err)
+	}
+
+	wd, err := os.Getwd()
+	if err != nil {
+		return fmt.Errorf("error reading working directory: %s", err)
+	}
+
+	root, err := findRoot(wd)
+	if err != nil {
+		return err
+	}
+
+	if err := addServiceDatum(filepath.Join(root, namesDataFile), td); err != nil {
+		return fmt.Errorf("adding service to %s: %w", namesDataFile, err)
+	}
+
+	dir := filepath.Join(root, "internal", "service", td.ProviderPackage)
+
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return fmt.Errorf("error creating service package directory (%s): %s", dir, err)
+	}
+
+	if err := writeTemplate("generate", filepath.Join(dir, "generate.go"), generateTmpl, force, td); err != nil {
+		return fmt.Errorf("writing generate template: %w", err)
+	}
+
+	if err := writeTemplate("readme", filepath.Join(dir, "README.md"), readmeTmpl, force, td); err != nil {
+		return fmt.Errorf("writing README template: %w", err)
+	}
+
+	fmt.Printf("Created service package %s. Run \"make gen\" from %s to generate its client and registration, then add resources with \"skaff resource\".\n", dir, root)
+
+	return nil
+}
+
+func check(td TemplateData) error {
+	if td.ProviderPackage == "" {
+		return errors.New("no package given")
+	}
+
+	if !regexp.MustCompile(`^[a-z][a-z0-9]*$`).MatchString(td.ProviderPackage) {
+		return errors.New("package should be all lower case letters and digits (e.g., sesv2)")
+	}
+
+	if td.ProviderNameUpper == "" {
+		return errors.New("no name given")
+	}
+
+	if !strings.EqualFold(td.ProviderNameUpper, td.ProviderPackage) {
+		return errors.New("name should be the properly capitalized package (e.g., SESV2)")
+	}
+
+	if td.HumanFriendly == "" {
+		return errors.New("no human-friendly name given")
+	}
+
+	if td.Brand != "" && td.Brand != "AWS" && td.Brand != "Amazon" {
+		return errors.New(`brand should be "AWS", "Amazon" or blank`)
+	}
+
+	if !td.AWSGoSDKV2 && td.GoV1ClientTypeName == "" {
+		return errors.New("no AWS SDK for Go v1 client type name given")
+	}
+
+	return nil
+}
+
+// findRoot returns the root directory of the provider repository containing dir.
+func findRoot(dir string) (string, error) {
+	for d := dir; ; d = filepath.Dir(d) {
+		if _, err := os.Stat(filepath.Join(d, namesDataFile)); err == nil {
+			return d, nil
+		}
+
+		if d == filepath.Dir(d) {
+			return "", fmt.Errorf("error finding provider repository: %s not found in %s or any parent directory", namesDataFile, dir)
+		}
+	}
+}
+
+// addServiceDatum adds a line for the service to names_data.csv, in order, unless the service's package is already present.
+func addServiceDatum(filename string, td TemplateData) error {
+	b, err := os.ReadFile(filename)
+	if err != nil {
+		return err
+	}
+
+	records, err := csv.NewReader(bytes.NewReader(b)).ReadAll()
+	if err != nil {
+		return err
+	}
+
+	if len(records) < 1 || len(records[0]) != colNote+1 {
+		return errors.New("unexpected header")
+	}
+
+	for _, r := range records[1:] {
+		if r[colProviderPackageActual] == td.ProviderPackage || (r[colProviderPackageActual] == "" && r[colProviderPackageCorrect] == td.ProviderPackage) {
+			fmt.Printf("Service package %s is already in %s.\n", td.ProviderPackage, namesDataFile)
+
+			return nil
+		}
+	}
+
+	line, err := serviceDatumLine(td)
+	if err != nil {
+		return err
+	}
+
+	// Existing lines are kept verbatim. The new line is inserted before the first line that sorts after it.
+	lines := strings.SplitAfter(string(b), "\n")
+	i := 1
+
+	for ; i < len(lines); i++ {
+		if lines[i] == "" || strings.SplitN(lines[i], ",", 2)[0] > td.AWSCLIV2Command {
+			break
+		}
+	}
+
+	if i > 0 && !strings.HasSuffix(lines[i-1], "\n") {
+		lines[i-1] += "\n"
+	}
+
+	lines = append(lines[:i], append([]string{line}, lines[i:]...)...)
+
+	return os.WriteFile(filename, []byte(strings.Join(lines, "")), 0644)
+}
+
+func serviceDatumLine(td TemplateData) (string, error) {
+	record := make([]string, colNote+1)
+
+	cli := td.AWSCLIV2Command
+	if cli == "" {
+		cli = td.ProviderPackage
+	}
+
+	record[colAWSCLIV2Command] = cli
+	record[colAWSCLIV2CommandNoDashes] = strings.ReplaceAll(cli, "-", "")
+	record[colGoV1Package] = td.GoV1Package
+	record[colGoV2Package] = td.GoV2Package
+	record[colProviderPackageCorrect] = td.ProviderPackage
+	record[colProviderNameUpper] = td.ProviderNameUpper
+	record[colGoV1ClientTypeName] = td.GoV1ClientTypeName
+	record[colSDKVersion] = "1"
+	if td.AWSGoSDKV2 {
+		record[colSDKVersion] = "2"
+	}
+	record[colResourcePrefixCorrect] = fmt.Sprintf("aws_%s_", td.ProviderPackage)
+	record[colDocPrefix] = fmt.Sprintf("%s_", td.ProviderPackage)
+	record[colHumanFriendly] = td.HumanFriendly
+	record[colBrand] = td.Brand
+
+	var buf bytes.Buffer
+
+	w := csv.NewWriter(&buf)
+	if err := w.Write(record); err != nil {
+		return "", err
+	}
+
+	w.Flush()
+
+	return buf.String(), w.Error()
+}
+
+func writeTemplate(templateName, filename, tmpl string, force bool, td TemplateData) error {
+	if _, err := os.Stat(filename); !errors.Is(err, fs.ErrNotExist) && !force {
+		return fmt.Errorf("file (%s) already exists and force is not set", filename)
+	}
+
+	tplate, err := template.New(templateName).Parse(tmpl)
+	if err != nil {
+		return fmt.Errorf("error parsing template: %s", err)
+	}
+
+	var buffer bytes.Buffer
+	if err := tplate.Execute(&buffer, td); err != nil {
+		return fmt.Errorf("error executing template: %s", err)
+	}
+
+	if err := os.WriteFile(filename, buffer.Bytes(), 0644); err != nil {
+		return fmt.Errorf("error writing file (%s): %s", filename, err)
+	}
+
+	return nil
+}
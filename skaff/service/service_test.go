@@ -0,0 +1,164 @@
+package service
+
+import (
+	"go/parser"
+	"go/token"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+const testNamesData = `AWSCLIV2Command,AWSCLIV2CommandNoDashes,GoV1Package,GoV2Package,ProviderPackageActual,ProviderPackageCorrect,SplitPackageRealPackage,Aliases,ProviderNameUpper,GoV1ClientTypeName,SkipClientGenerate,SDKVersion,ResourcePrefixActual,ResourcePrefixCorrect,FilePrefix,DocPrefix,HumanFriendly,Brand,Exclude,AllowedSubcategory,DeprecatedEnvVar,EnvVar,Note
+acm,acm,acm,acm,,acm,,,ACM,ACM,,1,,aws_acm_,,acm_,ACM (Certificate Manager),AWS,,,,,
+mq,mq,mq,mq,,mq,,,MQ,MQ,,1,,aws_mq_,,mq_,MQ,Amazon,,,,,
+`
+
+func TestCreate(t *testing.T) {
+	root := t.TempDir()
+	filename := filepath.Join(root, namesDataFile)
+
+	if err := os.MkdirAll(filepath.Dir(filename), 0755); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := os.WriteFile(filename, []byte(testNamesData), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	wd, err := os.Getwd()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if err := os.Chdir(filepath.Dir(filename)); err != nil {
+		t.Fatal(err)
+	}
+
+	t.Cleanup(func() {
+		if err := os.Chdir(wd); err != nil {
+			t.Fatal(err)
+		}
+	})
+
+	td := TemplateData{
+		AWSCLIV2Command:   "ivs-realtime",
+		AWSGoSDKV2:        true,
+		Brand:             "Amazon",
+		GoV2Package:       "ivsrealtime",
+		HumanFriendly:     "IVS (Interactive Video) Real-Time",
+		ProviderNameUpper: "IVSRealTime",
+		ProviderPackage:   "ivsrealtime",
+	}
+
+	// Creating the service again doesn't add it to names_data.csv again.
+	for _, force := range []bool{false, true} {
+		if err := Create(td, force); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	b, err := os.ReadFile(filename)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	got := strings.Split(string(b), "\n")
+	expected := strings.Split(testNamesData, "\n")
+	expected = append(expected[:2], append([]string{"ivs-realtime,ivsrealtime,,ivsrealtime,,ivsrealtime,,,IVSRealTime,,,2,,aws_ivsrealtime_,,ivsrealtime_,IVS (Interactive Video) Real-Time,Amazon,,,,,"}, expected[2:]...)...)
+
+	if len(got) != len(expected) {
+		t.Fatalf("got %d lines, expected %d:\n%s", len(got), len(expected), b)
+	}
+
+	for i := range got {
+		if got[i] != expected[i] {
+			t.Errorf("line %d: got %q, expected %q", i, got[i], expected[i])
+		}
+	}
+
+	dir := filepath.Join(root, "internal", "service", td.ProviderPackage)
+
+	f, err := parser.ParseFile(token.NewFileSet(), filepath.Join(dir, "generate.go"), nil, parser.ParseComments)
+	if err != nil {
+		t.Fatalf("parsing generated generate.go: %s", err)
+	}
+
+	if f.Name.Name != td.ProviderPackage {
+		t.Errorf("got package %s, expected %s", f.Name.Name, td.ProviderPackage)
+	}
+
+	if _, err := os.Stat(filepath.Join(dir, "README.md")); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestCheck(t *testing.T) {
+	testCases := []struct {
+		TestName    string
+		Input       TemplateData
+		ExpectError bool
+	}{
+		{
+			TestName: "valid",
+			Input: TemplateData{
+				AWSGoSDKV2:        true,
+				HumanFriendly:     "SESv2 (Simple Email V2)",
+				ProviderNameUpper: "SESV2",
+				ProviderPackage:   "sesv2",
+			},
+		},
+		{
+			TestName: "no package",
+			Input: TemplateData{
+				AWSGoSDKV2:        true,
+				HumanFriendly:     "SESv2 (Simple Email V2)",
+				ProviderNameUpper: "SESV2",
+			},
+			ExpectError: true,
+		},
+		{
+			TestName: "package with dash",
+			Input: TemplateData{
+				AWSGoSDKV2:        true,
+				HumanFriendly:     "SESv2 (Simple Email V2)",
+				ProviderNameUpper: "SESV2",
+				ProviderPackage:   "ses-v2",
+			},
+			ExpectError: true,
+		},
+		{
+			TestName: "name not package",
+			Input: TemplateData{
+				AWSGoSDKV2:        true,
+				HumanFriendly:     "SESv2 (Simple Email V2)",
+				ProviderNameUpper: "SES",
+				ProviderPackage:   "sesv2",
+			},
+			ExpectError: true,
+		},
+		{
+			TestName: "no v1 client type",
+			Input: TemplateData{
+				HumanFriendly:     "SESv2 (Simple Email V2)",
+				ProviderNameUpper: "SESV2",
+				ProviderPackage:   "sesv2",
+			},
+			ExpectError: true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.TestName, func(t *testing.T) {
+			err := check(testCase.Input)
+
+			if err == nil && testCase.ExpectError {
+				t.Error("expected error, got none")
+			}
+
+			if err != nil && !testCase.ExpectError {
+				t.Errorf("got unexpected error: %s", err)
+			}
+		})
+	}
+}
@@ -0,0 +1,132 @@
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"go/ast"
+	"go/parser"
+	"go/printer"
+	"go/token"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// packageFunction is a function declared in a service package.
+type packageFunction struct {
+	Name       string
+	NumResults int
+	ParamTypes []string
+}
+
+// packageFunctions returns the top-level functions (not methods) declared in the non-test Go source files in the specified directory.
+func packageFunctions(dirname string) (map[string]*packageFunction, error) {
+	fset := token.NewFileSet()
+	pkgs, err := parser.ParseDir(fset, dirname, func(fi os.FileInfo) bool {
+		return !strings.HasSuffix(fi.Name(), "_test.go")
+	}, parser.SkipObjectResolution)
+
+	if err != nil {
+		return nil, fmt.Errorf("parsing directory (%s): %w", dirname, err)
+	}
+
+	functions := make(map[string]*packageFunction)
+
+	for _, pkg := range pkgs {
+		for _, file := range pkg.Files {
+			for _, decl := range file.Decls {
+				funcDecl, ok := decl.(*ast.FuncDecl)
+
+				if !ok || funcDecl.Recv != nil {
+					continue
+				}
+
+				function := &packageFunction{
+					Name: funcDecl.Name.Name,
+				}
+
+				if results := funcDecl.Type.Results; results != nil {
+					for _, field := range results.List {
+						if n := len(field.Names); n > 0 {
+							function.NumResults += n
+						} else {
+							function.NumResults++
+						}
+					}
+				}
+
+				for _, field := range funcDecl.Type.Params.List {
+					typ := exprString(fset, field.Type)
+
+					// Parameters declared together, e.g. `name, id string`, share a type.
+					n := len(field.Names)
+					if n == 0 {
+						n = 1
+					}
+
+					for i := 0; i < n; i++ {
+						function.ParamTypes = append(function.ParamTypes, typ)
+					}
+				}
+
+				functions[function.Name] = function
+			}
+		}
+	}
+
+	return functions, nil
+}
+
+// callArgs returns the arguments for a call to the function
+// by matching each of the function's parameters to an available value, e.g. "ctx", "conn", "data.ID.Value".
+// Returns false if any parameter cannot be matched.
+func (f *packageFunction) callArgs(timeout string) ([]string, bool) {
+	var args []string
+	usedID := false
+
+	for i, typ := range f.ParamTypes {
+		switch {
+		case typ == "context.Context":
+			args = append(args, "ctx")
+		case i <= 1 && strings.HasPrefix(typ, "*"):
+			args = append(args, "conn")
+		case typ == "string" && !usedID:
+			args = append(args, "data.ID.Value")
+			usedID = true
+		case typ == "time.Duration" && timeout != "":
+			args = append(args, timeout)
+		default:
+			return nil, false
+		}
+	}
+
+	return args, true
+}
+
+// findFunction returns the first function in the package with one of the specified names.
+func findFunction(functions map[string]*packageFunction, names ...string) *packageFunction {
+	for _, name := range names {
+		if function, ok := functions[name]; ok {
+			return function
+		}
+	}
+
+	return nil
+}
+
+// exprString returns the source code for an expression.
+func exprString(fset *token.FileSet, expr ast.Expr) string {
+	var buffer bytes.Buffer
+
+	if err := printer.Fprint(&buffer, fset, expr); err != nil {
+		return ""
+	}
+
+	return buffer.String()
+}
+
+// defaultPackageDir returns the default source directory for a service package,
+// relative to the root of the repository.
+func defaultPackageDir(packageName string) string {
+	return filepath.Join("internal", "service", packageName)
+}
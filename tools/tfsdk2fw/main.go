@@ -10,24 +10,38 @@ import (
 	"io"
 	"os"
 	"path"
+	"reflect"
+	"runtime"
 	"sort"
+	"strconv"
 	"strings"
 	"text/template"
+	"time"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-provider-aws/internal/provider"
+	"github.com/hashicorp/terraform-provider-aws/names"
 	"github.com/hashicorp/terraform-provider-aws/tools/tfsdk2fw/naming"
 	"github.com/mitchellh/cli"
 )
 
+const (
+	// sdkDefaultTimeout is the Plugin SDK's default timeout for resource operations.
+	sdkDefaultTimeout        = 20 * time.Minute
+	servicePackagePathPrefix = "github.com/hashicorp/terraform-provider-aws/internal/service/"
+)
+
 var (
 	dataSourceType = flag.String("data-source", "", "Data Source type")
+	packageDir     = flag.String("package-dir", "", "Service package source directory, used to find existing finder and waiter functions (default internal/service/<package-name>)")
 	resourceType   = flag.String("resource", "", "Resource type")
 )
 
 func usage() {
 	fmt.Fprintf(os.Stderr, "Usage:\n")
-	fmt.Fprintf(os.Stderr, "\ttfsdk2fw [-resource <resource-type>|-data-source <data-source-type>] <package-name> <name> <generated-file>\n\n")
+	fmt.Fprintf(os.Stderr, "\ttfsdk2fw [-resource <resource-type>|-data-source <data-source-type>] [flags] <package-name> <name> <generated-file>\n\n")
+	fmt.Fprintf(os.Stderr, "Flags:\n")
+	flag.PrintDefaults()
 }
 
 func main() {
@@ -52,10 +66,15 @@ func main() {
 	}
 	migrator := &migrator{
 		Name:        name,
+		PackageDir:  *packageDir,
 		PackageName: packageName,
 		Ui:          ui,
 	}
 
+	if migrator.PackageDir == "" {
+		migrator.PackageDir = defaultPackageDir(packageName)
+	}
+
 	p, err := provider.New(context.Background())
 
 	if err != nil {
@@ -97,6 +116,7 @@ func main() {
 type migrator struct {
 	IsDataSource bool
 	Name         string
+	PackageDir   string
 	PackageName  string
 	Resource     *schema.Resource
 	Template     string
@@ -164,13 +184,19 @@ func (m *migrator) applyTemplate(filename string, templateData *templateData) er
 }
 
 func (m *migrator) generateTemplateData() (*templateData, error) {
+	structNamePrefix := "resource" + m.Name
+	if m.IsDataSource {
+		structNamePrefix = "dataSource" + m.Name
+	}
+
 	sbSchema := strings.Builder{}
 	sbStruct := strings.Builder{}
 	emitter := &emitter{
-		IsDataSource: m.IsDataSource,
-		SchemaWriter: &sbSchema,
-		StructWriter: &sbStruct,
-		Ui:           m.Ui,
+		IsDataSource:     m.IsDataSource,
+		SchemaWriter:     &sbSchema,
+		StructNamePrefix: structNamePrefix,
+		StructWriter:     &sbStruct,
+		Ui:               m.Ui,
 	}
 
 	err := emitter.emitSchemaForResource(m.Resource)
@@ -180,31 +206,199 @@ func (m *migrator) generateTemplateData() (*templateData, error) {
 	}
 
 	templateData := &templateData{
-		EmitResourceImportState:      m.Resource.Importer != nil,
-		EmitResourceUpdateSkeleton:   m.Resource.Update != nil || m.Resource.UpdateContext != nil || m.Resource.UpdateWithoutTimeout != nil,
-		ImportFrameworkAttr:          emitter.ImportFrameworkAttr,
-		ImportProviderFrameworkTypes: emitter.ImportProviderFrameworkTypes,
-		Name:                         m.Name,
-		PackageName:                  m.PackageName,
-		Schema:                       sbSchema.String(),
-		Struct:                       sbStruct.String(),
-		TFTypeName:                   m.TFTypeName,
+		EmitResourceImportState:        m.Resource.Importer != nil,
+		EmitResourceUpdateSkeleton:     m.Resource.Update != nil || m.Resource.UpdateContext != nil || m.Resource.UpdateWithoutTimeout != nil,
+		HumanName:                      m.humanName(),
+		ImportFrameworkAttr:            emitter.ImportFrameworkAttr,
+		ImportFrameworkListValidator:   emitter.ImportFrameworkListValidator,
+		ImportFrameworkPath:            emitter.ImportFrameworkPath,
+		ImportFrameworkSchemaValidator: emitter.ImportFrameworkSchemaValidator,
+		ImportFrameworkSetValidator:    emitter.ImportFrameworkSetValidator,
+		ImportProviderFrameworkTypes:   emitter.ImportProviderFrameworkTypes,
+		ImportProviderPlanModifiers:    emitter.ImportProviderPlanModifiers,
+		Name:                           m.Name,
+		NestedStructs:                  emitter.NestedStructs.String(),
+		PackageName:                    m.PackageName,
+		Schema:                         sbSchema.String(),
+		Struct:                         sbStruct.String(),
+		TFTypeName:                     m.TFTypeName,
+	}
+
+	if !m.IsDataSource {
+		if err := m.generateCRUDTemplateData(templateData); err != nil {
+			return nil, err
+		}
+
+		m.generateStateUpgradeTemplateData(templateData)
 	}
 
 	return templateData, nil
 }
 
+// generateCRUDTemplateData adds the data used to wire the resource's CRUD handlers
+// to the service package's existing finder and waiter functions.
+func (m *migrator) generateCRUDTemplateData(templateData *templateData) error {
+	if v, err := names.ProviderNameUpper(m.PackageName); err == nil {
+		templateData.ConnMethod = v + "Conn"
+	} else {
+		m.Ui.Warn(fmt.Sprintf("service package %s not found: %s", m.PackageName, err))
+		templateData.ConnMethod = "TODOConn"
+	}
+
+	if _, err := os.Stat(m.PackageDir); err != nil {
+		m.Ui.Warn(fmt.Sprintf("service package directory %s not found, skipping finder and waiter functions", m.PackageDir))
+
+		return nil
+	}
+
+	functions, err := packageFunctions(m.PackageDir)
+
+	if err != nil {
+		return err
+	}
+
+	var timeouts schema.ResourceTimeout
+	if m.Resource.Timeouts != nil {
+		timeouts = *m.Resource.Timeouts
+	}
+
+	findNames := []string{
+		fmt.Sprintf("Find%sByID", m.Name),
+		fmt.Sprintf("find%sByID", m.Name),
+		fmt.Sprintf("Find%sByARN", m.Name),
+		fmt.Sprintf("find%sByARN", m.Name),
+		fmt.Sprintf("Find%sByName", m.Name),
+		fmt.Sprintf("find%sByName", m.Name),
+		fmt.Sprintf("Find%s", m.Name),
+		fmt.Sprintf("find%s", m.Name),
+	}
+
+	templateData.FindNames = strings.Join(findNames, ", ")
+
+	if function := findFunction(functions, findNames...); function != nil {
+		templateData.Find = m.functionCall(function, "", 2)
+	}
+
+	for _, v := range []struct {
+		target  **functionCall
+		name    string
+		timeout *time.Duration
+	}{
+		{&templateData.WaitCreated, fmt.Sprintf("wait%sCreated", m.Name), timeouts.Create},
+		{&templateData.WaitUpdated, fmt.Sprintf("wait%sUpdated", m.Name), timeouts.Update},
+		{&templateData.WaitDeleted, fmt.Sprintf("wait%sDeleted", m.Name), timeouts.Delete},
+	} {
+		function := findFunction(functions, v.name)
+
+		if function == nil {
+			continue
+		}
+
+		timeout := sdkDefaultTimeout
+		if v.timeout != nil {
+			timeout = *v.timeout
+		}
+
+		call := m.functionCall(function, durationLiteral(timeout), 0)
+
+		if call != nil && strings.Contains(call.Call, "time.") {
+			templateData.ImportTime = true
+		}
+
+		*v.target = call
+	}
+
+	if templateData.Find != nil {
+		templateData.ImportErrs = true
+		templateData.ImportTFResource = true
+	}
+
+	return nil
+}
+
+// functionCall returns the call to an existing service package function, or nil if the function's parameters cannot be matched.
+// If numResults is non-zero the function must return exactly that many results.
+func (m *migrator) functionCall(function *packageFunction, timeout string, numResults int) *functionCall {
+	if function.NumResults == 0 || (numResults > 0 && function.NumResults != numResults) {
+		m.Ui.Warn(fmt.Sprintf("function %s has unexpected results, skipping", function.Name))
+
+		return nil
+	}
+
+	args, ok := function.callArgs(timeout)
+
+	if !ok {
+		m.Ui.Warn(fmt.Sprintf("function %s has unexpected parameters (%s), skipping", function.Name, strings.Join(function.ParamTypes, ", ")))
+
+		return nil
+	}
+
+	return &functionCall{
+		Call:       fmt.Sprintf("%s(%s)", function.Name, strings.Join(args, ", ")),
+		Name:       function.Name,
+		NumResults: function.NumResults,
+	}
+}
+
+// generateStateUpgradeTemplateData adds the data used to generate a state upgrade shim
+// that applies the resource's Plugin SDK state upgrade functions to state from prior schema versions.
+func (m *migrator) generateStateUpgradeTemplateData(templateData *templateData) {
+	upgraders := make([]schema.StateUpgrader, len(m.Resource.StateUpgraders))
+	copy(upgraders, m.Resource.StateUpgraders)
+	sort.SliceStable(upgraders, func(i, j int) bool {
+		return upgraders[i].Version < upgraders[j].Version
+	})
+
+	for i, upgrader := range upgraders {
+		stateUpgrader := &stateUpgrader{
+			Version: upgrader.Version,
+		}
+
+		for _, upgrader := range upgraders[i:] {
+			name := funcName(upgrader.Upgrade)
+
+			// Only named functions in the same service package can be called from the generated code.
+			if v := strings.TrimPrefix(name, servicePackagePathPrefix+m.PackageName+"."); v != name && !strings.Contains(v, ".") {
+				stateUpgrader.Functions = append(stateUpgrader.Functions, v)
+			} else {
+				m.Ui.Warn(fmt.Sprintf("state upgrade function %s (version %d) cannot be called from the generated code", name, upgrader.Version))
+				stateUpgrader.Unresolved = append(stateUpgrader.Unresolved, name)
+			}
+		}
+
+		templateData.StateUpgraders = append(templateData.StateUpgraders, stateUpgrader)
+	}
+}
+
+// humanName returns the human-friendly name of the resource, e.g. "CloudWatch Logs Log Group".
+func (m *migrator) humanName() string {
+	name := naming.ToHumanName(m.Name)
+
+	if v, err := names.FullHumanFriendly(m.PackageName); err == nil {
+		return v + " " + name
+	}
+
+	return name
+}
+
 func (m *migrator) infof(format string, a ...interface{}) {
 	m.Ui.Info(fmt.Sprintf(format, a...))
 }
 
 type emitter struct {
-	ImportFrameworkAttr          bool
-	ImportProviderFrameworkTypes bool
-	IsDataSource                 bool
-	SchemaWriter                 io.Writer
-	StructWriter                 io.Writer
-	Ui                           cli.Ui
+	ImportFrameworkAttr            bool
+	ImportFrameworkListValidator   bool
+	ImportFrameworkPath            bool
+	ImportFrameworkSchemaValidator bool
+	ImportFrameworkSetValidator    bool
+	ImportProviderFrameworkTypes   bool
+	ImportProviderPlanModifiers    bool
+	IsDataSource                   bool
+	NestedStructs                  strings.Builder
+	SchemaWriter                   io.Writer
+	StructNamePrefix               string
+	StructWriter                   io.Writer
+	Ui                             cli.Ui
 }
 
 // emitSchemaForResource generates the Plugin Framework code for a Plugin SDK Resource and emits the generated code to the emitter's Writer.
@@ -248,8 +442,6 @@ func (e *emitter) emitSchemaForResource(resource *schema.Resource) error {
 // and emits the generated code to the emitter's Writer.
 // Property names are sorted prior to code generation to reduce diffs.
 func (e *emitter) emitAttributesAndBlocks(path []string, schema map[string]*schema.Schema) error {
-	isTopLevelAttribute := len(path) == 0
-
 	// At this point we are emitting code for a tfsdk.Block or Schema.
 	names := make([]string, 0)
 	for name := range schema {
@@ -271,10 +463,7 @@ func (e *emitter) emitAttributesAndBlocks(path []string, schema map[string]*sche
 		}
 
 		fprintf(e.SchemaWriter, "%q:", name)
-
-		if isTopLevelAttribute {
-			fprintf(e.StructWriter, "%s ", naming.ToCamelCase(name))
-		}
+		fprintf(e.StructWriter, "%s ", naming.ToCamelCase(name))
 
 		err := e.emitAttributeProperty(append(path, name), property)
 
@@ -282,9 +471,7 @@ func (e *emitter) emitAttributesAndBlocks(path []string, schema map[string]*sche
 			return err
 		}
 
-		if isTopLevelAttribute {
-			fprintf(e.StructWriter, " `tfsdk:%q`\n", name)
-		}
+		fprintf(e.StructWriter, " `tfsdk:%q`\n", name)
 
 		fprintf(e.SchemaWriter, ",\n")
 	}
@@ -307,6 +494,10 @@ func (e *emitter) emitAttributesAndBlocks(path []string, schema map[string]*sche
 
 		fprintf(e.SchemaWriter, "%q:", name)
 
+		if typ, ok := blockStructFieldType(property); ok {
+			fprintf(e.StructWriter, "%s %s `tfsdk:%q`\n", naming.ToCamelCase(name), typ, name)
+		}
+
 		err := e.emitBlockProperty(append(path, name), property)
 
 		if err != nil {
@@ -327,8 +518,7 @@ func (e *emitter) emitAttributesAndBlocks(path []string, schema map[string]*sche
 func (e *emitter) emitAttributeProperty(path []string, property *schema.Schema) error {
 	attributeName := path[len(path)-1]
 	isComputedOnly := property.Computed && !property.Optional
-	isTopLevelAttribute := len(path) == 1
-	var planModifiers []string
+	var planModifiers, validators []string
 
 	// At this point we are emitting code for the values of a tfsdk.Schema's Attributes (map[string]tfsdk.Attribute).
 	fprintf(e.SchemaWriter, "{\n")
@@ -340,23 +530,17 @@ func (e *emitter) emitAttributeProperty(path []string, property *schema.Schema)
 	case schema.TypeBool:
 		fprintf(e.SchemaWriter, "Type:types.BoolType,\n")
 
-		if isTopLevelAttribute {
-			fprintf(e.StructWriter, "types.Bool")
-		}
+		fprintf(e.StructWriter, "types.Bool")
 
 	case schema.TypeFloat:
 		fprintf(e.SchemaWriter, "Type:types.Float64Type,\n")
 
-		if isTopLevelAttribute {
-			fprintf(e.StructWriter, "types.Float64")
-		}
+		fprintf(e.StructWriter, "types.Float64")
 
 	case schema.TypeInt:
 		fprintf(e.SchemaWriter, "Type:types.Int64Type,\n")
 
-		if isTopLevelAttribute {
-			fprintf(e.StructWriter, "types.Int64")
-		}
+		fprintf(e.StructWriter, "types.Int64")
 
 	case schema.TypeString:
 		// Computed-only ARN attributes are easiest handled as strings.
@@ -365,15 +549,11 @@ func (e *emitter) emitAttributeProperty(path []string, property *schema.Schema)
 
 			fprintf(e.SchemaWriter, "Type:fwtypes.ARNType,\n")
 
-			if isTopLevelAttribute {
-				fprintf(e.StructWriter, "fwtypes.ARN")
-			}
+			fprintf(e.StructWriter, "fwtypes.ARN")
 		} else {
 			fprintf(e.SchemaWriter, "Type:types.StringType,\n")
 
-			if isTopLevelAttribute {
-				fprintf(e.StructWriter, "types.String")
-			}
+			fprintf(e.StructWriter, "types.String")
 		}
 
 	//
@@ -386,21 +566,15 @@ func (e *emitter) emitAttributeProperty(path []string, property *schema.Schema)
 		case schema.TypeList:
 			aggregateType = "types.ListType"
 			typeName = "list"
-			if isTopLevelAttribute {
-				fprintf(e.StructWriter, "types.List")
-			}
+			fprintf(e.StructWriter, "types.List")
 		case schema.TypeMap:
 			aggregateType = "types.MapType"
 			typeName = "map"
-			if isTopLevelAttribute {
-				fprintf(e.StructWriter, "types.Map")
-			}
+			fprintf(e.StructWriter, "types.Map")
 		case schema.TypeSet:
 			aggregateType = "types.SetType"
 			typeName = "set"
-			if isTopLevelAttribute {
-				fprintf(e.StructWriter, "types.Set")
-			}
+			fprintf(e.StructWriter, "types.Set")
 		}
 
 		switch v := property.Elem.(type) {
@@ -454,6 +628,9 @@ func (e *emitter) emitAttributeProperty(path []string, property *schema.Schema)
 
 	if property.Computed {
 		fprintf(e.SchemaWriter, "Computed:true,\n")
+	} else if property.Default != nil && !e.IsDataSource {
+		// The default value plan modifier can only set the planned value of a Computed attribute.
+		fprintf(e.SchemaWriter, "Computed:true,\n")
 	}
 
 	if property.Sensitive {
@@ -468,8 +645,23 @@ func (e *emitter) emitAttributeProperty(path []string, property *schema.Schema)
 		fprintf(e.SchemaWriter, "DeprecationMessage:%q,\n", deprecationMessage)
 	}
 
-	if property.ForceNew {
-		planModifiers = append(planModifiers, "resource.RequiresReplace()")
+	if !e.IsDataSource {
+		if def := property.Default; def != nil {
+			if planModifier, ok := e.defaultValuePlanModifier(def); ok {
+				planModifiers = append(planModifiers, planModifier)
+			} else {
+				fprintf(e.SchemaWriter, "// TODO Default:%#v,\n", def)
+			}
+		}
+
+		if property.ForceNew {
+			planModifiers = append(planModifiers, "resource.RequiresReplace()")
+		}
+
+		// Plugin SDK Computed attributes keep their prior state value unless changed by the resource.
+		if property.Computed && attributeName != "tags_all" {
+			planModifiers = append(planModifiers, "resource.UseStateForUnknown()")
+		}
 	}
 
 	if len(planModifiers) > 0 {
@@ -480,37 +672,134 @@ func (e *emitter) emitAttributeProperty(path []string, property *schema.Schema)
 		fprintf(e.SchemaWriter, "},\n")
 	}
 
-	// Features that we can't (yet) migrate:
-
-	if def := property.Default; def != nil {
-		switch def.(type) {
-		case bool:
-			fprintf(e.SchemaWriter, "// TODO Default:%#v,\n", def)
-		case int:
-			fprintf(e.SchemaWriter, "// TODO Default:%#v,\n", def)
-		case float64:
-			fprintf(e.SchemaWriter, "// TODO Default:%#v,\n", def)
-		case string:
-			fprintf(e.SchemaWriter, "// TODO Default:%#v,\n", def)
-		default:
+	switch property.Type {
+	case schema.TypeList:
+		if v, ok := sizeValidator("listvalidator", property.MinItems, property.MaxItems); ok {
+			e.ImportFrameworkListValidator = true
+			validators = append(validators, v)
+		}
+	case schema.TypeSet:
+		if v, ok := sizeValidator("setvalidator", property.MinItems, property.MaxItems); ok {
+			e.ImportFrameworkSetValidator = true
+			validators = append(validators, v)
 		}
 	}
 
-	if property.ValidateFunc != nil || property.ValidateDiagFunc != nil {
-		fprintf(e.SchemaWriter, "// TODO Validate,\n")
+	validators = append(validators, e.schemaValidators(property)...)
+
+	if len(validators) > 0 {
+		fprintf(e.SchemaWriter, "Validators:[]tfsdk.AttributeValidator{\n")
+		for _, validator := range validators {
+			fprintf(e.SchemaWriter, "%s,\n", validator)
+		}
+		fprintf(e.SchemaWriter, "},\n")
 	}
 
+	// Features that can't be inferred:
+
+	e.emitTODOs(path, property)
+
 	fprintf(e.SchemaWriter, "}")
 
 	return nil
 }
 
+// defaultValuePlanModifier returns the plan modifier for a Plugin SDK Default value.
+func (e *emitter) defaultValuePlanModifier(def interface{}) (string, bool) {
+	var planModifier string
+
+	switch v := def.(type) {
+	case bool:
+		planModifier = fmt.Sprintf("fwplanmodifiers.DefaultValue(types.Bool{Value:%t})", v)
+	case int:
+		planModifier = fmt.Sprintf("fwplanmodifiers.DefaultValue(types.Int64{Value:%d})", v)
+	case float64:
+		planModifier = fmt.Sprintf("fwplanmodifiers.DefaultValue(types.Float64{Value:%s})", strconv.FormatFloat(v, 'g', -1, 64))
+	case string:
+		planModifier = fmt.Sprintf("fwplanmodifiers.DefaultStringValue(%q)", v)
+	default:
+		return "", false
+	}
+
+	e.ImportProviderPlanModifiers = true
+
+	return planModifier, true
+}
+
+// schemaValidators returns the validators for a Plugin SDK property's cross-attribute constraints.
+func (e *emitter) schemaValidators(property *schema.Schema) []string {
+	var validators []string
+
+	for _, v := range []struct {
+		keys      []string
+		validator string
+	}{
+		{property.AtLeastOneOf, "AtLeastOneOf"},
+		{property.ConflictsWith, "ConflictsWith"},
+		{property.ExactlyOneOf, "ExactlyOneOf"},
+		{property.RequiredWith, "AlsoRequires"},
+	} {
+		if len(v.keys) == 0 {
+			continue
+		}
+
+		expressions := make([]string, len(v.keys))
+		for i, key := range v.keys {
+			expressions[i] = pathExpression(key)
+		}
+
+		e.ImportFrameworkPath = true
+		e.ImportFrameworkSchemaValidator = true
+		validators = append(validators, fmt.Sprintf("schemavalidator.%s(%s)", v.validator, strings.Join(expressions, ", ")))
+	}
+
+	return validators
+}
+
+// emitTODOs emits TODO markers for Plugin SDK property features that can't be inferred.
+func (e *emitter) emitTODOs(path []string, property *schema.Schema) {
+	for _, v := range []struct {
+		f       interface{}
+		feature string
+	}{
+		{property.ValidateFunc, "Validate"},
+		{property.ValidateDiagFunc, "Validate"},
+		{property.DiffSuppressFunc, "DiffSuppressFunc"},
+		{property.StateFunc, "StateFunc"},
+		{property.Set, "Set"},
+	} {
+		name := funcName(v.f)
+
+		if name == "" {
+			continue
+		}
+
+		// Plugin Framework sets compare whole elements, so the default Plugin SDK hash functions need no migration.
+		if v.feature == "Set" && strings.HasPrefix(name, "github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema.") {
+			continue
+		}
+
+		name = shortFuncName(name)
+
+		e.warnf("%s has %s %s", strings.Join(path, "/"), v.feature, name)
+		fprintf(e.SchemaWriter, "// TODO %s: %s\n", v.feature, name)
+	}
+}
+
 // emitBlockProperty generates the Plugin Framework code for a Plugin SDK Block's property
 // and emits the generated code to the emitter's Writer.
 func (e *emitter) emitBlockProperty(path []string, property *schema.Schema) error {
 	// At this point we are emitting code for the values of a tfsdk.Block or Schema's Blocks (map[string]tfsdk.Block).
 	fprintf(e.SchemaWriter, "{\n")
 
+	// Each block's attributes and nested blocks are emitted into their own model struct.
+	structWriter := e.StructWriter
+	sbStruct := strings.Builder{}
+	e.StructWriter = &sbStruct
+	defer func() {
+		e.StructWriter = structWriter
+	}()
+
 	switch v := property.Type; v {
 	//
 	// Complex types.
@@ -578,15 +867,38 @@ func (e *emitter) emitBlockProperty(path []string, property *schema.Schema) erro
 		fprintf(e.SchemaWriter, "DeprecationMessage:%q,\n", deprecationMessage)
 	}
 
+	if validators := e.schemaValidators(property); len(validators) > 0 {
+		fprintf(e.SchemaWriter, "Validators:[]tfsdk.AttributeValidator{\n")
+		for _, validator := range validators {
+			fprintf(e.SchemaWriter, "%s,\n", validator)
+		}
+		fprintf(e.SchemaWriter, "},\n")
+	}
+
 	if def := property.Default; def != nil {
 		e.warnf("Block %s has non-nil Default: %v", strings.Join(path, "/"), def)
 	}
 
+	e.emitTODOs(path, property)
+
 	fprintf(e.SchemaWriter, "}")
 
+	fprintf(&e.NestedStructs, "\ntype %s struct {\n%s}\n", e.nestedStructName(path), sbStruct.String())
+
 	return nil
 }
 
+// nestedStructName returns the name of the model struct for the nested block at the specified path.
+func (e *emitter) nestedStructName(path []string) string {
+	name := e.StructNamePrefix
+
+	for _, v := range path {
+		name += naming.ToCamelCase(v)
+	}
+
+	return name + "Data"
+}
+
 // emitComputedOnlyBlock generates the Plugin Framework code for a Plugin SDK Computed-only nested block
 // and emits the generated code to the emitter's Writer.
 // See https://github.com/hashicorp/terraform-plugin-sdk/blob/6ffc92796f0716c07502e4d36aaafa5fd85e94cf/internal/configs/configschema/implied_type.go#L12.
@@ -753,20 +1065,142 @@ func isAttribute(property *schema.Schema) bool {
 	return false
 }
 
+// blockStructFieldType returns the model struct field type for a Block.
+func blockStructFieldType(property *schema.Schema) (string, bool) {
+	switch property.Type {
+	case schema.TypeList:
+		return "types.List", true
+	case schema.TypeSet:
+		return "types.Set", true
+	default:
+		return "", false
+	}
+}
+
 func unsupportedTypeError(path []string, typ string) error {
 	return fmt.Errorf("%s is of unsupported type: %s", strings.Join(path, "/"), typ)
 }
 
+// funcName returns the fully qualified name of a function, or "" if f is nil.
+func funcName(f interface{}) string {
+	v := reflect.ValueOf(f)
+
+	if v.Kind() != reflect.Func || v.IsNil() {
+		return ""
+	}
+
+	if f := runtime.FuncForPC(v.Pointer()); f != nil {
+		return f.Name()
+	}
+
+	return ""
+}
+
+// shortFuncName returns a function name without its package path and any closure suffixes,
+// e.g. "validation.StringLenBetween" for "github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation.StringLenBetween.func1".
+func shortFuncName(name string) string {
+	if i := strings.LastIndex(name, "/"); i >= 0 {
+		name = name[i+1:]
+	}
+
+	parts := strings.Split(name, ".")
+
+	for len(parts) > 2 && strings.HasPrefix(parts[len(parts)-1], "func") {
+		parts = parts[:len(parts)-1]
+	}
+
+	return strings.Join(parts, ".")
+}
+
+// pathExpression returns the Plugin Framework path expression for a Plugin SDK attribute key, e.g. "a.0.b".
+func pathExpression(key string) string {
+	parts := strings.Split(key, ".")
+	sb := strings.Builder{}
+
+	fprintf(&sb, "path.MatchRoot(%q)", parts[0])
+
+	for _, part := range parts[1:] {
+		if i, err := strconv.Atoi(part); err == nil {
+			fprintf(&sb, ".AtListIndex(%d)", i)
+		} else {
+			fprintf(&sb, ".AtName(%q)", part)
+		}
+	}
+
+	return sb.String()
+}
+
+// sizeValidator returns the size validator from the specified validator package for Plugin SDK MinItems and MaxItems.
+func sizeValidator(pkg string, minItems, maxItems int) (string, bool) {
+	switch {
+	case minItems > 0 && maxItems > 0:
+		return fmt.Sprintf("%s.SizeBetween(%d, %d)", pkg, minItems, maxItems), true
+	case minItems > 0:
+		return fmt.Sprintf("%s.SizeAtLeast(%d)", pkg, minItems), true
+	case maxItems > 0:
+		return fmt.Sprintf("%s.SizeAtMost(%d)", pkg, maxItems), true
+	default:
+		return "", false
+	}
+}
+
+// durationLiteral returns the Go source code for a duration, e.g. "30 * time.Minute".
+func durationLiteral(d time.Duration) string {
+	switch {
+	case d == 0:
+		return "0"
+	case d%time.Hour == 0:
+		return fmt.Sprintf("%d * time.Hour", d/time.Hour)
+	case d%time.Minute == 0:
+		return fmt.Sprintf("%d * time.Minute", d/time.Minute)
+	case d%time.Second == 0:
+		return fmt.Sprintf("%d * time.Second", d/time.Second)
+	default:
+		return fmt.Sprintf("time.Duration(%d)", d)
+	}
+}
+
+// functionCall is a call to an existing service package function.
+type functionCall struct {
+	Call       string // e.g. FindClusterByARN(ctx, conn, data.ID.Value)
+	Name       string // e.g. FindClusterByARN
+	NumResults int
+}
+
+// stateUpgrader is a state upgrader from a prior Plugin SDK schema version.
+type stateUpgrader struct {
+	Functions  []string // Plugin SDK state upgrade functions, applied in order
+	Unresolved []string // Plugin SDK state upgrade functions that can't be called from the generated code
+	Version    int
+}
+
 type templateData struct {
-	EmitResourceImportState      bool
-	EmitResourceUpdateSkeleton   bool
-	ImportFrameworkAttr          bool
-	ImportProviderFrameworkTypes bool
-	Name                         string // e.g. Instance
-	PackageName                  string // e.g. ec2
-	Schema                       string
-	Struct                       string
-	TFTypeName                   string // e.g. aws_instance
+	ConnMethod                     string // e.g. EC2Conn
+	EmitResourceImportState        bool
+	EmitResourceUpdateSkeleton     bool
+	Find                           *functionCall
+	FindNames                      string
+	HumanName                      string // e.g. EC2 Instance
+	ImportErrs                     bool
+	ImportFrameworkAttr            bool
+	ImportFrameworkListValidator   bool
+	ImportFrameworkPath            bool
+	ImportFrameworkSchemaValidator bool
+	ImportFrameworkSetValidator    bool
+	ImportProviderFrameworkTypes   bool
+	ImportProviderPlanModifiers    bool
+	ImportTFResource               bool
+	ImportTime                     bool
+	Name                           string // e.g. Instance
+	NestedStructs                  string
+	PackageName                    string // e.g. ec2
+	Schema                         string
+	StateUpgraders                 []*stateUpgrader
+	Struct                         string
+	TFTypeName                     string // e.g. aws_instance
+	WaitCreated                    *functionCall
+	WaitDeleted                    *functionCall
+	WaitUpdated                    *functionCall
 }
 
 //go:embed datasource.tmpl
@@ -0,0 +1,217 @@
+package main
+
+import (
+	"testing"
+	"time"
+)
+
+func TestPathExpression(t *testing.T) {
+	testCases := []struct {
+		TestName      string
+		Value         string
+		ExpectedValue string
+	}{
+		{
+			TestName:      "top-level attribute",
+			Value:         "name",
+			ExpectedValue: `path.MatchRoot("name")`,
+		},
+		{
+			TestName:      "nested attribute",
+			Value:         "settings.0.engine_version",
+			ExpectedValue: `path.MatchRoot("settings").AtListIndex(0).AtName("engine_version")`,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.TestName, func(t *testing.T) {
+			got := pathExpression(testCase.Value)
+
+			if got != testCase.ExpectedValue {
+				t.Errorf("expected: %s, got: %s", testCase.ExpectedValue, got)
+			}
+		})
+	}
+}
+
+func TestShortFuncName(t *testing.T) {
+	testCases := []struct {
+		TestName      string
+		Value         string
+		ExpectedValue string
+	}{
+		{
+			TestName:      "named function",
+			Value:         "github.com/hashicorp/terraform-provider-aws/internal/verify.ValidARN",
+			ExpectedValue: "verify.ValidARN",
+		},
+		{
+			TestName:      "closure",
+			Value:         "github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation.StringLenBetween.func1",
+			ExpectedValue: "validation.StringLenBetween",
+		},
+		{
+			TestName:      "nested closure",
+			Value:         "github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation.All.func1.1",
+			ExpectedValue: "validation.All.func1.1",
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.TestName, func(t *testing.T) {
+			got := shortFuncName(testCase.Value)
+
+			if got != testCase.ExpectedValue {
+				t.Errorf("expected: %s, got: %s", testCase.ExpectedValue, got)
+			}
+		})
+	}
+}
+
+func TestSizeValidator(t *testing.T) {
+	testCases := []struct {
+		TestName      string
+		MinItems      int
+		MaxItems      int
+		ExpectedValue string
+		ExpectedOK    bool
+	}{
+		{
+			TestName: "no limits",
+		},
+		{
+			TestName:      "min items",
+			MinItems:      1,
+			ExpectedValue: "listvalidator.SizeAtLeast(1)",
+			ExpectedOK:    true,
+		},
+		{
+			TestName:      "max items",
+			MaxItems:      5,
+			ExpectedValue: "listvalidator.SizeAtMost(5)",
+			ExpectedOK:    true,
+		},
+		{
+			TestName:      "min and max items",
+			MinItems:      1,
+			MaxItems:      5,
+			ExpectedValue: "listvalidator.SizeBetween(1, 5)",
+			ExpectedOK:    true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.TestName, func(t *testing.T) {
+			got, ok := sizeValidator("listvalidator", testCase.MinItems, testCase.MaxItems)
+
+			if ok != testCase.ExpectedOK {
+				t.Fatalf("expected ok: %t, got: %t", testCase.ExpectedOK, ok)
+			}
+
+			if got != testCase.ExpectedValue {
+				t.Errorf("expected: %s, got: %s", testCase.ExpectedValue, got)
+			}
+		})
+	}
+}
+
+func TestDurationLiteral(t *testing.T) {
+	testCases := []struct {
+		TestName      string
+		Value         time.Duration
+		ExpectedValue string
+	}{
+		{
+			TestName:      "zero",
+			Value:         0,
+			ExpectedValue: "0",
+		},
+		{
+			TestName:      "hours",
+			Value:         2 * time.Hour,
+			ExpectedValue: "2 * time.Hour",
+		},
+		{
+			TestName:      "minutes",
+			Value:         90 * time.Minute,
+			ExpectedValue: "90 * time.Minute",
+		},
+		{
+			TestName:      "seconds",
+			Value:         45 * time.Second,
+			ExpectedValue: "45 * time.Second",
+		},
+		{
+			TestName:      "nanoseconds",
+			Value:         1500 * time.Millisecond,
+			ExpectedValue: "time.Duration(1500000000)",
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.TestName, func(t *testing.T) {
+			got := durationLiteral(testCase.Value)
+
+			if got != testCase.ExpectedValue {
+				t.Errorf("expected: %s, got: %s", testCase.ExpectedValue, got)
+			}
+		})
+	}
+}
+
+func TestPackageFunctionCallArgs(t *testing.T) {
+	testCases := []struct {
+		TestName      string
+		ParamTypes    []string
+		Timeout       string
+		ExpectedValue []string
+		ExpectedOK    bool
+	}{
+		{
+			TestName:      "finder",
+			ParamTypes:    []string{"context.Context", "*kafka.Kafka", "string"},
+			ExpectedValue: []string{"ctx", "conn", "data.ID.Value"},
+			ExpectedOK:    true,
+		},
+		{
+			TestName:      "finder without context",
+			ParamTypes:    []string{"*ec2.EC2", "string"},
+			ExpectedValue: []string{"conn", "data.ID.Value"},
+			ExpectedOK:    true,
+		},
+		{
+			TestName:      "waiter",
+			ParamTypes:    []string{"context.Context", "*kafka.Kafka", "string", "time.Duration"},
+			Timeout:       "20 * time.Minute",
+			ExpectedValue: []string{"ctx", "conn", "data.ID.Value", "20 * time.Minute"},
+			ExpectedOK:    true,
+		},
+		{
+			TestName:   "multiple identifiers",
+			ParamTypes: []string{"context.Context", "*logs.CloudWatchLogs", "string", "string"},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.TestName, func(t *testing.T) {
+			function := &packageFunction{
+				ParamTypes: testCase.ParamTypes,
+			}
+			got, ok := function.callArgs(testCase.Timeout)
+
+			if ok != testCase.ExpectedOK {
+				t.Fatalf("expected ok: %t, got: %t", testCase.ExpectedOK, ok)
+			}
+
+			if len(got) != len(testCase.ExpectedValue) {
+				t.Fatalf("expected: %v, got: %v", testCase.ExpectedValue, got)
+			}
+
+			for i := range got {
+				if got[i] != testCase.ExpectedValue[i] {
+					t.Errorf("expected: %v, got: %v", testCase.ExpectedValue, got)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,28 @@
+package naming
+
+import (
+	"strings"
+)
+
+// ToHumanName converts a CamelCase string to space-separated words, e.g. "VPCEndpointService" to "VPC Endpoint Service".
+func ToHumanName(s string) string {
+	s = strings.TrimSpace(s)
+	c := strings.Builder{}
+
+	for i := 0; i < len(s); i++ {
+		ch := s[i]
+
+		if i > 0 && isCapitalLetter(ch) {
+			prev := s[i-1]
+			nextIsLow := i+1 < len(s) && isLowercaseLetter(s[i+1])
+
+			if isLowercaseLetter(prev) || isNumeric(prev) || (isCapitalLetter(prev) && nextIsLow) {
+				c.WriteByte(' ')
+			}
+		}
+
+		c.WriteByte(ch)
+	}
+
+	return c.String()
+}
@@ -0,0 +1,56 @@
+package naming_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-aws/tools/tfsdk2fw/naming"
+)
+
+func TestToHumanName(t *testing.T) {
+	testCases := []struct {
+		TestName      string
+		Value         string
+		ExpectedValue string
+	}{
+		{
+			TestName:      "empty string",
+			Value:         "",
+			ExpectedValue: "",
+		},
+		{
+			TestName:      "single word",
+			Value:         "Domain",
+			ExpectedValue: "Domain",
+		},
+		{
+			TestName:      "multiple words",
+			Value:         "LogGroup",
+			ExpectedValue: "Log Group",
+		},
+		{
+			TestName:      "leading acronym",
+			Value:         "VPCEndpointService",
+			ExpectedValue: "VPC Endpoint Service",
+		},
+		{
+			TestName:      "trailing acronym",
+			Value:         "ClusterARN",
+			ExpectedValue: "Cluster ARN",
+		},
+		{
+			TestName:      "digits",
+			Value:         "S3Bucket",
+			ExpectedValue: "S3 Bucket",
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.TestName, func(t *testing.T) {
+			got := naming.ToHumanName(testCase.Value)
+
+			if got != testCase.ExpectedValue {
+				t.Errorf("expected: %s, got: %s", testCase.ExpectedValue, got)
+			}
+		})
+	}
+}